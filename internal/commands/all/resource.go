@@ -164,7 +164,7 @@ func ListResources(exec commands.Executor, include, exclude []string) ([]Resourc
 	findResources(exec, &wg, returnChan, &resolver.CachingServerGroup{}, include, exclude)
 	findResources(exec, &wg, returnChan, &resolver.CachingStorage{Access: "private"}, include, exclude)
 	findResources(exec, &wg, returnChan, &cachingTag{}, include, exclude)
-	findResources(exec, &wg, returnChan, &cachingCertificateBundle{}, include, exclude)
+	findResources(exec, &wg, returnChan, &resolver.CachingLoadBalancerCertificateBundle{}, include, exclude)
 	findResources(exec, &wg, returnChan, &resolver.CachingFileStorage{}, include, exclude)
 	wg.Wait()
 	close(returnChan)
@@ -8,23 +8,40 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/all"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/auditlog"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database"
+	databasebackup "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/backup"
+	databaseconnectionpool "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/connectionpool"
 	databaseindex "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/index"
+	databaselogicaldatabase "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/logicaldatabase"
 	databaseproperties "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/properties"
 	databasesession "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/session"
+	databaseuser "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/database/user"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/filestorage"
+	filestorageshare "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/filestorage/share"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway"
+	gatewayconnection "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway/connection"
+	gatewaytunnel "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/gateway/connection/tunnel"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/host"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/ipaddress"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/kubernetes"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/kubernetes/nodegroup"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer"
+	loadbalancerbackend "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/backend"
+	loadbalancercertificatebundle "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/certificatebundle"
+	loadbalancerfrontend "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/frontend"
+	loadbalancermember "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/member"
+	loadbalancerresolver "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/resolver"
+	loadbalancerrule "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/rule"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/network"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/networkpeering"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage"
 	objectstorageAccesskey "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/accesskey"
 	objectstoragebucket "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/bucket"
+	objectstoragecustomdomain "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/customdomain"
 	objectstoragelabel "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/label"
 	objectstoragenetwork "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/network"
+	objectstorageobject "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/object"
+	objectstoragepolicy "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/policy"
+	objectstoragepolicyversion "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/policy/version"
 	objectstorageuser "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/user"
 	objectstorageuserpolicy "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/user/policy"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/partner"
@@ -65,6 +82,8 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	commands.BuildCommand(server.EjectCommand(), serverCommand.Cobra(), conf)
 	commands.BuildCommand(server.DeleteCommand(), serverCommand.Cobra(), conf)
 	commands.BuildCommand(server.RelocateCommand(), serverCommand.Cobra(), conf)
+	commands.BuildCommand(server.SSHCommand(), serverCommand.Cobra(), conf)
+	commands.BuildCommand(server.ConsoleCommand(), serverCommand.Cobra(), conf)
 
 	// Server Network Interfaces
 	networkInterfaceCommand := commands.BuildCommand(networkinterface.BaseNetworkInterfaceCommand(), serverCommand.Cobra(), conf)
@@ -88,15 +107,21 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	commands.BuildCommand(storage.ListCommand(), storageCommand.Cobra(), conf)
 	commands.BuildCommand(storage.CreateCommand(), storageCommand.Cobra(), conf)
 	commands.BuildCommand(storage.ModifyCommand(), storageCommand.Cobra(), conf)
+	commands.BuildCommand(storage.ResizeCommand(), storageCommand.Cobra(), conf)
 	commands.BuildCommand(storage.CloneCommand(), storageCommand.Cobra(), conf)
 	commands.BuildCommand(storage.TemplatizeCommand(), storageCommand.Cobra(), conf)
+	commands.BuildCommand(storage.CopyCommand(), storageCommand.Cobra(), conf)
 	commands.BuildCommand(storage.DeleteCommand(), storageCommand.Cobra(), conf)
 	commands.BuildCommand(storage.ImportCommand(), storageCommand.Cobra(), conf)
+	commands.BuildCommand(storage.ExportCommand(), storageCommand.Cobra(), conf)
 	commands.BuildCommand(storage.ShowCommand(), storageCommand.Cobra(), conf)
 
 	backupCommand := commands.BuildCommand(storagebackup.BackupCommand(), storageCommand.Cobra(), conf)
 	commands.BuildCommand(storagebackup.CreateBackupCommand(), backupCommand.Cobra(), conf)
 	commands.BuildCommand(storagebackup.RestoreBackupCommand(), backupCommand.Cobra(), conf)
+	commands.BuildCommand(storagebackup.ListBackupsCommand(), backupCommand.Cobra(), conf)
+	commands.BuildCommand(storagebackup.DeleteBackupCommand(), backupCommand.Cobra(), conf)
+	commands.BuildCommand(storagebackup.AuditCommand(), backupCommand.Cobra(), conf)
 
 	// IP Addresses
 	ipAddressCommand := commands.BuildCommand(ipaddress.BaseIPAddressCommand(), rootCmd, conf)
@@ -117,8 +142,10 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	// Network peerings
 	networkPeeringCommand := commands.BuildCommand(networkpeering.BaseNetworkPeeringCommand(), rootCmd, conf)
 	commands.BuildCommand(networkpeering.ListCommand(), networkPeeringCommand.Cobra(), conf)
+	commands.BuildCommand(networkpeering.CreateCommand(), networkPeeringCommand.Cobra(), conf)
 	commands.BuildCommand(networkpeering.DeleteCommand(), networkPeeringCommand.Cobra(), conf)
 	commands.BuildCommand(networkpeering.DisableCommand(), networkPeeringCommand.Cobra(), conf)
+	commands.BuildCommand(networkpeering.EnableCommand(), networkPeeringCommand.Cobra(), conf)
 
 	// Routers
 	routerCommand := commands.BuildCommand(router.BaseRouterCommand(), rootCmd, conf)
@@ -159,6 +186,10 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	commands.BuildCommand(database.CreateCommand(), databaseCommand.Cobra(), conf)
 	commands.BuildCommand(database.ListCommand(), databaseCommand.Cobra(), conf)
 	commands.BuildCommand(database.ShowCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(database.ModifyCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(database.UpgradeCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(database.ConnectCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(database.ForkCommand(), databaseCommand.Cobra(), conf)
 	commands.BuildCommand(database.TypesCommand(), databaseCommand.Cobra(), conf)
 	commands.BuildCommand(database.PlansCommand(), databaseCommand.Cobra(), conf)
 	commands.BuildCommand(database.StartCommand(), databaseCommand.Cobra(), conf)
@@ -191,18 +222,96 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	commands.BuildCommand(databaseindex.DeleteCommand(), indexCommand.Cobra(), conf)
 	commands.BuildCommand(databaseindex.ListCommand(), indexCommand.Cobra(), conf)
 
+	// Database backups
+	databaseBackupCommand := commands.BuildCommand(databasebackup.BaseBackupCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(databasebackup.ListCommand(), databaseBackupCommand.Cobra(), conf)
+
+	// Database users
+	databaseUserCommand := commands.BuildCommand(databaseuser.BaseUserCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(databaseuser.ListCommand(), databaseUserCommand.Cobra(), conf)
+	commands.BuildCommand(databaseuser.ShowCommand(), databaseUserCommand.Cobra(), conf)
+	commands.BuildCommand(databaseuser.CreateCommand(), databaseUserCommand.Cobra(), conf)
+	commands.BuildCommand(databaseuser.ModifyCommand(), databaseUserCommand.Cobra(), conf)
+	commands.BuildCommand(databaseuser.DeleteCommand(), databaseUserCommand.Cobra(), conf)
+
+	// Database logical databases
+	logicalDatabaseCommand := commands.BuildCommand(databaselogicaldatabase.BaseLogicalDatabaseCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(databaselogicaldatabase.ListCommand(), logicalDatabaseCommand.Cobra(), conf)
+	commands.BuildCommand(databaselogicaldatabase.CreateCommand(), logicalDatabaseCommand.Cobra(), conf)
+	commands.BuildCommand(databaselogicaldatabase.DeleteCommand(), logicalDatabaseCommand.Cobra(), conf)
+
+	// Database connection pools
+	connectionPoolCommand := commands.BuildCommand(databaseconnectionpool.BaseConnectionPoolCommand(), databaseCommand.Cobra(), conf)
+	commands.BuildCommand(databaseconnectionpool.ListCommand(), connectionPoolCommand.Cobra(), conf)
+	commands.BuildCommand(databaseconnectionpool.CreateCommand(), connectionPoolCommand.Cobra(), conf)
+	commands.BuildCommand(databaseconnectionpool.ModifyCommand(), connectionPoolCommand.Cobra(), conf)
+	commands.BuildCommand(databaseconnectionpool.DeleteCommand(), connectionPoolCommand.Cobra(), conf)
+
 	// FileStorage
 	filestorageCommand := commands.BuildCommand(filestorage.BaseFileStorageCommand(), rootCmd, conf)
 	commands.BuildCommand(filestorage.ListCommand(), filestorageCommand.Cobra(), conf)
+	commands.BuildCommand(filestorage.ShowCommand(), filestorageCommand.Cobra(), conf)
+	commands.BuildCommand(filestorage.CreateCommand(), filestorageCommand.Cobra(), conf)
+	commands.BuildCommand(filestorage.ModifyCommand(), filestorageCommand.Cobra(), conf)
 	commands.BuildCommand(filestorage.DeleteCommand(), filestorageCommand.Cobra(), conf)
 
+	// FileStorage shares
+	filestorageShareCommand := commands.BuildCommand(filestorageshare.BaseShareCommand(), filestorageCommand.Cobra(), conf)
+	commands.BuildCommand(filestorageshare.ListCommand(), filestorageShareCommand.Cobra(), conf)
+	commands.BuildCommand(filestorageshare.CreateCommand(), filestorageShareCommand.Cobra(), conf)
+	commands.BuildCommand(filestorageshare.ModifyCommand(), filestorageShareCommand.Cobra(), conf)
+	commands.BuildCommand(filestorageshare.DeleteCommand(), filestorageShareCommand.Cobra(), conf)
+
 	// LoadBalancers
 	loadbalancerCommand := commands.BuildCommand(loadbalancer.BaseLoadBalancerCommand(), rootCmd, conf)
 	commands.BuildCommand(loadbalancer.ListCommand(), loadbalancerCommand.Cobra(), conf)
 	commands.BuildCommand(loadbalancer.ShowCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancer.CreateCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancer.ModifyCommand(), loadbalancerCommand.Cobra(), conf)
 	commands.BuildCommand(loadbalancer.DeleteCommand(), loadbalancerCommand.Cobra(), conf)
 	commands.BuildCommand(loadbalancer.PlansCommand(), loadbalancerCommand.Cobra(), conf)
 
+	// LoadBalancer frontends
+	lbFrontendCommand := commands.BuildCommand(loadbalancerfrontend.BaseFrontendCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontend.CreateCommand(), lbFrontendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontend.ModifyCommand(), lbFrontendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerfrontend.DeleteCommand(), lbFrontendCommand.Cobra(), conf)
+
+	// LoadBalancer frontend rules
+	lbRuleCommand := commands.BuildCommand(loadbalancerrule.BaseRuleCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerrule.ListCommand(), lbRuleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerrule.CreateCommand(), lbRuleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerrule.ModifyCommand(), lbRuleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerrule.DeleteCommand(), lbRuleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerrule.ReorderCommand(), lbRuleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerrule.ReplaceCommand(), lbRuleCommand.Cobra(), conf)
+
+	// LoadBalancer backends
+	lbBackendCommand := commands.BuildCommand(loadbalancerbackend.BaseBackendCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackend.CreateCommand(), lbBackendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackend.ModifyCommand(), lbBackendCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerbackend.DeleteCommand(), lbBackendCommand.Cobra(), conf)
+
+	// LoadBalancer backend members
+	lbMemberCommand := commands.BuildCommand(loadbalancermember.BaseMemberCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancermember.CreateCommand(), lbMemberCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancermember.ModifyCommand(), lbMemberCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancermember.DeleteCommand(), lbMemberCommand.Cobra(), conf)
+
+	// LoadBalancer resolvers
+	lbResolverCommand := commands.BuildCommand(loadbalancerresolver.BaseResolverCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerresolver.CreateCommand(), lbResolverCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerresolver.ModifyCommand(), lbResolverCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancerresolver.DeleteCommand(), lbResolverCommand.Cobra(), conf)
+
+	// LoadBalancer certificate bundles
+	lbCertificateBundleCommand := commands.BuildCommand(loadbalancercertificatebundle.BaseCertificateBundleCommand(), loadbalancerCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancercertificatebundle.ListCommand(), lbCertificateBundleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancercertificatebundle.ShowCommand(), lbCertificateBundleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancercertificatebundle.CreateCommand(), lbCertificateBundleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancercertificatebundle.ModifyCommand(), lbCertificateBundleCommand.Cobra(), conf)
+	commands.BuildCommand(loadbalancercertificatebundle.DeleteCommand(), lbCertificateBundleCommand.Cobra(), conf)
+
 	// Kubernetes
 	kubernetesCommand := commands.BuildCommand(kubernetes.BaseKubernetesCommand(), rootCmd, conf)
 	commands.BuildCommand(kubernetes.CreateCommand(), kubernetesCommand.Cobra(), conf)
@@ -249,6 +358,19 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	commands.BuildCommand(objectstorageuserpolicy.DetachCommand(), userPolicyCommand.Cobra(), conf)
 	commands.BuildCommand(objectstorageuserpolicy.ListCommand(), userPolicyCommand.Cobra(), conf)
 
+	// Object storage policy management
+	policyCommand := commands.BuildCommand(objectstoragepolicy.BasePolicyCommand(), objectStorageCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragepolicy.ListCommand(), policyCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragepolicy.ShowCommand(), policyCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragepolicy.CreateCommand(), policyCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragepolicy.DeleteCommand(), policyCommand.Cobra(), conf)
+
+	// Object storage policy version management
+	policyVersionCommand := commands.BuildCommand(objectstoragepolicyversion.BaseVersionCommand(), policyCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragepolicyversion.ListCommand(), policyVersionCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragepolicyversion.CreateCommand(), policyVersionCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragepolicyversion.SetDefaultCommand(), policyVersionCommand.Cobra(), conf)
+
 	// Object storage access key management
 	accessKeyCommand := commands.BuildCommand(objectstorageAccesskey.BaseAccessKeyCommand(), objectStorageCommand.Cobra(), conf)
 	commands.BuildCommand(objectstorageAccesskey.CreateCommand(), accessKeyCommand.Cobra(), conf)
@@ -266,6 +388,8 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	commands.BuildCommand(objectstoragebucket.CreateCommand(), bucketCommand.Cobra(), conf)
 	commands.BuildCommand(objectstoragebucket.DeleteCommand(), bucketCommand.Cobra(), conf)
 	commands.BuildCommand(objectstoragebucket.ListCommand(), bucketCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragebucket.ShowCommand(), bucketCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragebucket.ConfigureCommand(), bucketCommand.Cobra(), conf)
 
 	// Object storage label management
 	labelCommand := commands.BuildCommand(objectstoragelabel.BaseLabelCommand(), objectStorageCommand.Cobra(), conf)
@@ -273,11 +397,43 @@ func BuildCommands(rootCmd *cobra.Command, conf *config.Config) {
 	commands.BuildCommand(objectstoragelabel.RemoveCommand(), labelCommand.Cobra(), conf)
 	commands.BuildCommand(objectstoragelabel.ListCommand(), labelCommand.Cobra(), conf)
 
+	// Object storage custom domain management
+	customDomainCommand := commands.BuildCommand(objectstoragecustomdomain.BaseCustomDomainCommand(), objectStorageCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragecustomdomain.ListCommand(), customDomainCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragecustomdomain.AddCommand(), customDomainCommand.Cobra(), conf)
+	commands.BuildCommand(objectstoragecustomdomain.RemoveCommand(), customDomainCommand.Cobra(), conf)
+
+	// Object storage object management
+	objectCommand := commands.BuildCommand(objectstorageobject.BaseObjectCommand(), objectStorageCommand.Cobra(), conf)
+	commands.BuildCommand(objectstorageobject.ListCommand(), objectCommand.Cobra(), conf)
+	commands.BuildCommand(objectstorageobject.CopyCommand(), objectCommand.Cobra(), conf)
+	commands.BuildCommand(objectstorageobject.RemoveCommand(), objectCommand.Cobra(), conf)
+	commands.BuildCommand(objectstorageobject.SyncCommand(), objectCommand.Cobra(), conf)
+	commands.BuildCommand(objectstorageobject.PresignCommand(), objectCommand.Cobra(), conf)
+
 	// Network Gateway operations
 	gatewayCommand := commands.BuildCommand(gateway.BaseGatewayCommand(), rootCmd, conf)
+	commands.BuildCommand(gateway.CreateCommand(), gatewayCommand.Cobra(), conf)
 	commands.BuildCommand(gateway.DeleteCommand(), gatewayCommand.Cobra(), conf)
 	commands.BuildCommand(gateway.ListCommand(), gatewayCommand.Cobra(), conf)
+	commands.BuildCommand(gateway.ModifyCommand(), gatewayCommand.Cobra(), conf)
 	commands.BuildCommand(gateway.PlansCommand(), gatewayCommand.Cobra(), conf)
+	commands.BuildCommand(gateway.ShowCommand(), gatewayCommand.Cobra(), conf)
+
+	// Gateway connections
+	gatewayConnectionCommand := commands.BuildCommand(gatewayconnection.BaseConnectionCommand(), gatewayCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnection.ListCommand(), gatewayConnectionCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnection.ShowCommand(), gatewayConnectionCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnection.CreateCommand(), gatewayConnectionCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnection.ModifyCommand(), gatewayConnectionCommand.Cobra(), conf)
+	commands.BuildCommand(gatewayconnection.DeleteCommand(), gatewayConnectionCommand.Cobra(), conf)
+
+	// Gateway connection tunnels
+	gatewayTunnelCommand := commands.BuildCommand(gatewaytunnel.BaseTunnelCommand(), gatewayConnectionCommand.Cobra(), conf)
+	commands.BuildCommand(gatewaytunnel.ListCommand(), gatewayTunnelCommand.Cobra(), conf)
+	commands.BuildCommand(gatewaytunnel.ShowCommand(), gatewayTunnelCommand.Cobra(), conf)
+	commands.BuildCommand(gatewaytunnel.CreateCommand(), gatewayTunnelCommand.Cobra(), conf)
+	commands.BuildCommand(gatewaytunnel.DeleteCommand(), gatewayTunnelCommand.Cobra(), conf)
 
 	// Host operations
 	hostCommand := commands.BuildCommand(host.BaseHostCommand(), rootCmd, conf)
@@ -51,6 +51,11 @@ type OfflineCommand interface {
 	DoesNotUseServices()
 }
 
+// SpecOutputCommand is a command that supports the spec output format, e.g. upctl server show.
+type SpecOutputCommand interface {
+	SupportsSpecOutput()
+}
+
 // NoArgumentCommand is a command that does not care about the positional arguments.
 type NoArgumentCommand interface {
 	Command
@@ -76,6 +81,15 @@ type CobraCommand interface {
 	Cobra() *cobra.Command
 }
 
+// checkOutputFormat returns an error if the configured output format is not supported by the command.
+func checkOutputFormat(command Command, cfg *config.Config) error {
+	if _, ok := command.(SpecOutputCommand); !ok && cfg.Output() == config.ValueOutputSpec {
+		command.Cobra().SilenceUsage = true
+		return fmt.Errorf("output format %s is not supported by this command", cfg.Output())
+	}
+	return nil
+}
+
 // BuildCommand sets up a Command with the specified config and adds it to Cobra
 func BuildCommand(child Command, parent *cobra.Command, config *config.Config) Command {
 	child.Cobra().Flags().SortFlags = false
@@ -119,6 +133,11 @@ func BuildCommand(child Command, parent *cobra.Command, config *config.Config) C
 		child.Cobra().Use = child.Cobra().Name()
 	}
 
+	// Reject spec output before running commands that do not support it, as the output is only rendered after the command has been executed.
+	child.Cobra().PreRunE = func(_ *cobra.Command, _ []string) error {
+		return checkOutputFormat(child, config)
+	}
+
 	// Set run
 	child.Cobra().RunE = func(_ *cobra.Command, args []string) error {
 		// Do not create service for offline commands, e.g. upctl version
@@ -0,0 +1,21 @@
+package databasebackup
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+)
+
+// BaseBackupCommand creates the base "database backup" command
+func BaseBackupCommand() commands.Command {
+	return &databaseBackupCommand{
+		commands.New("backup", "Manage database backups"),
+	}
+}
+
+type databaseBackupCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (k *databaseBackupCommand) InitCommand() {
+	k.Cobra().Aliases = []string{"backups"}
+}
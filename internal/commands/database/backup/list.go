@@ -0,0 +1,66 @@
+package databasebackup
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListCommand creates the "database backup list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List backups of the specified databases",
+			"upctl database backup list 55199a44-4751-4e27-9394-7c7661910be3",
+			"upctl database backup list my-pg-database",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *listCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	db, err := exec.All().GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	backups := slices.Clone(db.Backups)
+	slices.SortFunc(backups, func(a, b upcloud.ManagedDatabaseBackup) int {
+		return b.BackupTime.Compare(a.BackupTime)
+	})
+
+	rows := []output.TableRow{}
+	for _, backup := range backups {
+		rows = append(rows, output.TableRow{
+			backup.BackupName,
+			backup.BackupTime,
+			fmt.Sprintf("%d bytes", backup.DataSize),
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: backups,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "backup_name", Header: "Name"},
+				{Key: "backup_time", Header: "Time"},
+				{Key: "data_size", Header: "Size"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No backups found for this database.",
+		},
+	}, nil
+}
@@ -0,0 +1,38 @@
+package databasebackup
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCommand(t *testing.T) {
+	uuid := "0fa980c4-0e4f-460b-9869-11b7bd62b832"
+	db := upcloud.ManagedDatabase{
+		UUID: uuid,
+		Backups: []upcloud.ManagedDatabaseBackup{
+			{BackupName: "backup-older", BackupTime: time.Date(2026, 9, 1, 3, 0, 0, 0, time.UTC), DataSize: 1024},
+			{BackupName: "backup-newer", BackupTime: time.Date(2026, 9, 2, 3, 0, 0, 0, time.UTC), DataSize: 2048},
+		},
+	}
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	mService := new(smock.Service)
+	mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: uuid}).Return(&db, nil)
+
+	c := commands.BuildCommand(ListCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{uuid})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+
+	assert.NoError(t, err)
+	assert.Less(t, strings.Index(out, "backup-newer"), strings.Index(out, "backup-older"))
+}
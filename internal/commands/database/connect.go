@@ -0,0 +1,258 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	osexec "os/exec"
+	"os/signal"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+const (
+	envFormatDotenv = "dotenv"
+	envFormatExport = "export"
+)
+
+var envFormats = []string{envFormatDotenv, envFormatExport}
+
+// lookPath and runClient are variables to allow replacing them in tests.
+var (
+	lookPath  = osexec.LookPath
+	runClient = func(cmd *osexec.Cmd) error { return cmd.Run() }
+)
+
+// clientExitError is returned when the database client exits with a non-zero exit code, so that the exit code is passed on to the caller.
+type clientExitError struct {
+	Client   string
+	ExitCode int
+}
+
+var _ clierrors.ClientError = clientExitError{}
+
+func (err clientExitError) ErrorCode() int {
+	return err.ExitCode
+}
+
+func (err clientExitError) Error() string {
+	return fmt.Sprintf("%s exited with exit code %d", err.Client, err.ExitCode)
+}
+
+// ConnectCommand creates the "database connect" command
+func ConnectCommand() commands.Command {
+	return &connectCommand{
+		BaseCommand: commands.New(
+			"connect",
+			"Connect to a managed database with its native client",
+			"upctl database connect my-pg-database",
+			"upctl database connect my-mysql-database --client /usr/local/bin/mysql",
+			"upctl database connect my-pg-database --print-uri",
+			"upctl database connect my-pg-database --env dotenv > .env",
+			`eval "$(upctl database connect my-pg-database --env export)"`,
+		),
+	}
+}
+
+type connectCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	client   string
+	env      string
+	printURI config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *connectCommand) InitCommand() {
+	flags := &pflag.FlagSet{}
+	flags.StringVar(&s.client, "client", "", "Path to the client binary to use. By default, `psql` is used for PostgreSQL, `mysql` for MySQL, `valkey-cli` or `redis-cli` for Valkey, and `curl` for OpenSearch databases.")
+	flags.StringVar(&s.env, "env", "", "Instead of launching a client, print the connection details as environment variables in given format: "+strings.Join(envFormats, ", ")+".")
+	config.AddToggleFlag(flags, &s.printURI, "print-uri", false, "Instead of launching a client, print the service URI of the database.")
+	commands.Must(flags.SetAnnotation("env", commands.FlagAnnotationFixedCompletions, envFormats))
+	s.AddFlags(flags)
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *connectCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	if s.env != "" && !slices.Contains(envFormats, s.env) {
+		return nil, fmt.Errorf("invalid env format %q, must be one of: %s", s.env, strings.Join(envFormats, ", "))
+	}
+
+	if s.env != "" && s.printURI.Value() {
+		return nil, fmt.Errorf("--env and --print-uri are mutually exclusive")
+	}
+
+	db, err := exec.All().GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	if db.State != upcloud.ManagedDatabaseStateRunning {
+		return nil, fmt.Errorf("database %s is in %s state, it must be running to connect to it", uuid, db.State)
+	}
+
+	if s.printURI.Value() {
+		return output.Raw{Source: io.NopCloser(strings.NewReader(db.ServiceURI + "\n"))}, nil
+	}
+
+	if s.env != "" {
+		return output.Raw{Source: io.NopCloser(strings.NewReader(formatEnv(connectionEnv(db), s.env)))}, nil
+	}
+
+	client, err := newClientCommand(db, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := osexec.CommandContext(exec.Context(), client.path, client.args...) //gosec:disable G204 // client binary is either chosen by the user or looked up from PATH
+	cmd.Env = append(os.Environ(), client.env...)
+	cmd.Stdin = s.Cobra().InOrStdin()
+	if client.stdin != "" {
+		cmd.Stdin = strings.NewReader(client.stdin)
+	}
+	cmd.Stdout = s.Cobra().OutOrStdout()
+	cmd.Stderr = s.Cobra().ErrOrStderr()
+
+	exec.StopProgressLog()
+
+	// Stopping the progress log also stops the interrupt handler of the executor. Catch interrupts while the client is running, so that Ctrl+C is handled by the client, e.g. to cancel a query, instead of terminating upctl. Ignoring the signal instead would make the client inherit the ignored signal.
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	exec.Debug("launching database client", "client", client.path, "args", client.args)
+	if err := runClient(cmd); err != nil {
+		var exitErr *osexec.ExitError
+		if errors.As(err, &exitErr) {
+			return output.WithError{Output: output.None{}, Err: clientExitError{Client: client.path, ExitCode: exitErr.ExitCode()}}, nil
+		}
+		return nil, fmt.Errorf("%s exited with error: %w", client.path, err)
+	}
+
+	return output.None{}, nil
+}
+
+type envVariable struct {
+	name  string
+	value string
+}
+
+// connectionEnv returns client agnostic environment variables describing how to connect to the given database.
+func connectionEnv(db *upcloud.ManagedDatabase) []envVariable {
+	params := db.ServiceURIParams
+	env := []envVariable{
+		{name: "DATABASE_URL", value: db.ServiceURI},
+		{name: "DATABASE_HOST", value: params.Host},
+		{name: "DATABASE_PORT", value: fmt.Sprint(params.Port)},
+		{name: "DATABASE_USER", value: params.User},
+		{name: "DATABASE_PASSWORD", value: params.Password},
+	}
+
+	if params.DatabaseName != "" {
+		env = append(env, envVariable{name: "DATABASE_NAME", value: params.DatabaseName})
+	}
+	if params.SSLMode != "" {
+		env = append(env, envVariable{name: "DATABASE_SSLMODE", value: params.SSLMode})
+	}
+
+	return env
+}
+
+func formatEnv(env []envVariable, format string) string {
+	var b strings.Builder
+	for _, v := range env {
+		switch format {
+		case envFormatExport:
+			fmt.Fprintf(&b, "export %s='%s'\n", v.name, strings.ReplaceAll(v.value, "'", `'\''`))
+		default:
+			fmt.Fprintf(&b, "%s=%s\n", v.name, dotenvValue(v.value))
+		}
+	}
+	return b.String()
+}
+
+// dotenvValue quotes the value if it contains characters that would otherwise be interpreted by dotenv parsers.
+func dotenvValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, " \t\n\"'`$#\\") {
+		return value
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+type clientCommand struct {
+	path  string
+	args  []string
+	env   []string
+	stdin string
+}
+
+// newClientCommand builds the client invocation for the given database. Secrets are passed to the client via environment variables, or for curl, via configuration read from stdin, so that those are not visible in the process list.
+func newClientCommand(db *upcloud.ManagedDatabase, clientPath string) (*clientCommand, error) {
+	params := db.ServiceURIParams
+	port := fmt.Sprint(params.Port)
+
+	var candidates []string
+	client := &clientCommand{}
+	switch db.Type {
+	case upcloud.ManagedDatabaseServiceTypePostgreSQL:
+		candidates = []string{"psql"}
+		client.env = []string{
+			"PGHOST=" + params.Host,
+			"PGPORT=" + port,
+			"PGUSER=" + params.User,
+			"PGPASSWORD=" + params.Password,
+			"PGDATABASE=" + params.DatabaseName,
+			"PGSSLMODE=" + params.SSLMode,
+		}
+	case upcloud.ManagedDatabaseServiceTypeMySQL:
+		candidates = []string{"mysql"}
+		client.args = []string{"--host", params.Host, "--port", port, "--user", params.User, "--ssl-mode=REQUIRED"}
+		if params.DatabaseName != "" {
+			client.args = append(client.args, params.DatabaseName)
+		}
+		client.env = []string{"MYSQL_PWD=" + params.Password}
+	case upcloud.ManagedDatabaseServiceTypeValkey, "redis":
+		candidates = []string{"valkey-cli", "redis-cli"}
+		client.args = []string{"-h", params.Host, "-p", port, "--user", params.User, "--tls"}
+		client.env = []string{"VALKEYCLI_AUTH=" + params.Password, "REDISCLI_AUTH=" + params.Password}
+	case upcloud.ManagedDatabaseServiceTypeOpenSearch:
+		// curl does not read credentials from environment, read those from stdin as a config file instead.
+		candidates = []string{"curl"}
+		client.args = []string{"--config", "-", "--silent", "--show-error", "https://" + net.JoinHostPort(params.Host, port) + "/"}
+		client.stdin = fmt.Sprintf("user = \"%s:%s\"\n", curlConfigEscape(params.User), curlConfigEscape(params.Password))
+	default:
+		return nil, fmt.Errorf("connecting to %s databases is not supported", prettyDatabaseType(db.Type))
+	}
+
+	if clientPath != "" {
+		candidates = []string{clientPath}
+	}
+
+	for _, candidate := range candidates {
+		if path, err := lookPath(candidate); err == nil {
+			client.path = path
+			return client, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find %s client from PATH, install it or define the client with --client", strings.Join(candidates, " or "))
+}
+
+func curlConfigEscape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+}
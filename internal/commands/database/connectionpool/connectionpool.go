@@ -0,0 +1,54 @@
+package databaseconnectionpool
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+var poolModes = []string{
+	string(upcloud.ManagedDatabaseConnectionPoolModeSession),
+	string(upcloud.ManagedDatabaseConnectionPoolModeStatement),
+	string(upcloud.ManagedDatabaseConnectionPoolModeTransaction),
+}
+
+// BaseConnectionPoolCommand creates the base "database connection-pool" command
+func BaseConnectionPoolCommand() commands.Command {
+	return &databaseConnectionPoolCommand{
+		commands.New("connection-pool", "Manage connection pools of PostgreSQL databases"),
+	}
+}
+
+type databaseConnectionPoolCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (k *databaseConnectionPoolCommand) InitCommand() {
+	k.Cobra().Aliases = []string{"pool"}
+}
+
+func validatePoolMode(mode string) error {
+	if !slices.Contains(poolModes, mode) {
+		return fmt.Errorf("invalid pool mode %q, must be one of: %s", mode, strings.Join(poolModes, ", "))
+	}
+	return nil
+}
+
+// requirePostgreSQL returns an error if the database with given UUID is not a PostgreSQL database
+func requirePostgreSQL(exec commands.Executor, uuid string) error {
+	db, err := exec.All().GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return err
+	}
+
+	if db.Type != upcloud.ManagedDatabaseServiceTypePostgreSQL {
+		return fmt.Errorf("connection pools are not supported for database type %s", db.Type)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package databaseconnectionpool
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	name     string
+	database string
+	username string
+	mode     string
+	size     int
+}
+
+// CreateCommand creates the "database connection-pool create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a connection pool into the specified PostgreSQL database",
+			"upctl database connection-pool create pg-1 --name app-pool --database app --size 20",
+			"upctl database connection-pool create pg-1 --name app-pool --database app --username app --mode session --size 10",
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringVar(&s.name, "name", "", "Name of the connection pool.")
+	flagSet.StringVar(&s.database, "database", "", "Name of the logical database the pool connects to.")
+	flagSet.StringVar(&s.username, "username", "", "Username the pool uses to connect to the database. If not set, the pool uses the credentials of the connecting client.")
+	flagSet.StringVar(&s.mode, "mode", string(upcloud.ManagedDatabaseConnectionPoolModeTransaction), "Pool mode: "+strings.Join(poolModes, ", ")+".")
+	flagSet.IntVar(&s.size, "size", 10, "Number of connections the pool may open to the database.")
+	commands.Must(flagSet.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(flagSet.SetAnnotation("mode", commands.FlagAnnotationFixedCompletions, poolModes))
+	commands.Must(flagSet.SetAnnotation("size", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(flagSet)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("database"))
+}
+
+func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("database", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.DatabaseLogicalDatabase{Database: args[0]}
+	}, cfg)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("username", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.DatabaseUser{Database: args[0]}
+	}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *createCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	if err := validatePoolMode(s.mode); err != nil {
+		return nil, err
+	}
+
+	if err := requirePostgreSQL(exec, uuid); err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Creating connection pool %s into database %v", s.name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateManagedDatabaseConnectionPool(exec.Context(), &request.CreateManagedDatabaseConnectionPoolRequest{
+		ServiceUUID:  uuid,
+		PoolName:     s.name,
+		DatabaseName: s.database,
+		Username:     s.username,
+		PoolMode:     upcloud.ManagedDatabaseConnectionPoolMode(s.mode),
+		PoolSize:     s.size,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,94 @@
+package databaseconnectionpool
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateCommand(t *testing.T) {
+	targetMethod := "CreateManagedDatabaseConnectionPool"
+	uuid := "0fa980c4-0e4f-460b-9869-11b7bd62b833"
+	for _, test := range []struct {
+		name     string
+		dbType   upcloud.ManagedDatabaseServiceType
+		args     []string
+		error    string
+		expected request.CreateManagedDatabaseConnectionPoolRequest
+	}{
+		{
+			name:   "defaults",
+			dbType: upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			args:   []string{"--name", "app-pool", "--database", "app"},
+			expected: request.CreateManagedDatabaseConnectionPoolRequest{
+				ServiceUUID:  uuid,
+				PoolName:     "app-pool",
+				DatabaseName: "app",
+				PoolMode:     upcloud.ManagedDatabaseConnectionPoolModeTransaction,
+				PoolSize:     10,
+			},
+		},
+		{
+			name:   "all flags",
+			dbType: upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			args:   []string{"--name", "app-pool", "--database", "app", "--username", "app", "--mode", "session", "--size", "25"},
+			expected: request.CreateManagedDatabaseConnectionPoolRequest{
+				ServiceUUID:  uuid,
+				PoolName:     "app-pool",
+				DatabaseName: "app",
+				Username:     "app",
+				PoolMode:     upcloud.ManagedDatabaseConnectionPoolModeSession,
+				PoolSize:     25,
+			},
+		},
+		{
+			name:   "invalid mode",
+			dbType: upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			args:   []string{"--name", "app-pool", "--database", "app", "--mode", "query"},
+			error:  `invalid pool mode "query", must be one of: session, statement, transaction`,
+		},
+		{
+			name:   "mysql database",
+			dbType: upcloud.ManagedDatabaseServiceTypeMySQL,
+			args:   []string{"--name", "app-pool", "--database", "app"},
+			error:  "connection pools are not supported for database type mysql",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := CreateCommand()
+			mService := new(smock.Service)
+
+			mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: uuid}).
+				Return(&upcloud.ManagedDatabase{
+					State: upcloud.ManagedDatabaseStateRunning,
+					Type:  test.dbType,
+					UUID:  uuid,
+				}, nil)
+
+			expected := test.expected
+			mService.On(targetMethod, &expected).Return(&upcloud.ManagedDatabaseConnectionPool{PoolName: expected.PoolName}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, config.New())
+
+			c.Cobra().SetArgs(append(test.args, uuid))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				mService.AssertNotCalled(t, targetMethod, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				mService.AssertNumberOfCalls(t, targetMethod, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package databaseconnectionpool
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+}
+
+// ListCommand creates the "database connection-pool list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List connection pools of the specified database",
+			"upctl database connection-pool list 0fa980c4-0e4f-460b-9869-11b7bd62b832",
+			"upctl database connection-pool list pg-1",
+		),
+	}
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *listCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	if err := requirePostgreSQL(exec, uuid); err != nil {
+		return nil, err
+	}
+
+	pools, err := exec.All().GetManagedDatabaseConnectionPools(exec.Context(), &request.GetManagedDatabaseConnectionPoolsRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, pool := range pools {
+		rows = append(rows, output.TableRow{
+			pool.PoolName,
+			pool.DatabaseName,
+			pool.Username,
+			string(pool.PoolMode),
+			pool.PoolSize,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: pools,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "pool_name", Header: "Name"},
+				{Key: "database_name", Header: "Database"},
+				{Key: "username", Header: "Username", Format: format.PossiblyUnknownString},
+				{Key: "pool_mode", Header: "Mode"},
+				{Key: "pool_size", Header: "Size"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No connection pools found for this database.",
+		},
+	}, nil
+}
@@ -0,0 +1,99 @@
+package databaseconnectionpool
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	name     string
+	database string
+	username string
+	mode     string
+	size     int
+}
+
+// ModifyCommand creates the "database connection-pool modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a connection pool of the specified PostgreSQL database",
+			"upctl database connection-pool modify pg-1 --name app-pool --size 40",
+			"upctl database connection-pool modify pg-1 --name app-pool --mode session",
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringVar(&s.name, "name", "", "Name of the connection pool to modify.")
+	flagSet.StringVar(&s.database, "database", "", "Name of the logical database the pool connects to.")
+	flagSet.StringVar(&s.username, "username", "", "Username the pool uses to connect to the database.")
+	flagSet.StringVar(&s.mode, "mode", "", "Pool mode: "+strings.Join(poolModes, ", ")+".")
+	flagSet.IntVar(&s.size, "size", 0, "Number of connections the pool may open to the database.")
+	commands.Must(flagSet.SetAnnotation("mode", commands.FlagAnnotationFixedCompletions, poolModes))
+	commands.Must(flagSet.SetAnnotation("size", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(flagSet)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.DatabaseConnectionPool{Database: args[0]}
+	}, cfg)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("database", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.DatabaseLogicalDatabase{Database: args[0]}
+	}, cfg)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("username", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.DatabaseUser{Database: args[0]}
+	}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	if s.mode != "" {
+		if err := validatePoolMode(s.mode); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.database == "" && s.username == "" && s.mode == "" && s.size == 0 {
+		return nil, fmt.Errorf("nothing to modify, define at least one of --database, --username, --mode, or --size")
+	}
+
+	msg := fmt.Sprintf("Modifying connection pool %s of database %v", s.name, uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().ModifyManagedDatabaseConnectionPool(exec.Context(), &request.ModifyManagedDatabaseConnectionPoolRequest{
+		ServiceUUID:  uuid,
+		PoolName:     s.name,
+		DatabaseName: s.database,
+		Username:     s.username,
+		PoolMode:     upcloud.ManagedDatabaseConnectionPoolMode(s.mode),
+		PoolSize:     s.size,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ForkCommand creates the "database fork" command
+func ForkCommand() commands.Command {
+	return &forkCommand{
+		BaseCommand: commands.New(
+			"fork",
+			"Create a new database from a backup or a point in time of an existing database",
+			"upctl database fork my-pg-database --title my-pg-fork --hostname-prefix my-pg-fork",
+			"upctl database fork my-pg-database --title my-pg-fork --hostname-prefix my-pg-fork --recovery-time 2026-10-01T12:00:00Z",
+			"upctl database fork my-pg-database --title my-pg-fork --hostname-prefix my-pg-fork --backup-name backup-2026-10-01 --zone de-fra1 --plan 2x2xCPU-4GB-50GB --wait",
+		),
+	}
+}
+
+type forkCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	params       request.CloneManagedDatabaseRequest
+	recoveryTime string
+	wait         config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *forkCommand) InitCommand() {
+	flags := &pflag.FlagSet{}
+	flags.StringVar(&s.params.Title, "title", "", "A short, informational description for the new database.")
+	flags.StringVar(&s.params.HostNamePrefix, "hostname-prefix", "", "A host name prefix for the new database.")
+	flags.StringVar(&s.params.Plan, "plan", "", "Plan to use for the new database. Defaults to the plan of the source database.")
+	flags.StringVar(&s.params.Zone, "zone", "", "Zone for the new database. Defaults to the zone of the source database.")
+	flags.StringVar(&s.params.BackupName, "backup-name", "", "Name of the backup to create the new database from. Run `upctl database backup list <database>` to list available backups.")
+	flags.StringVar(&s.recoveryTime, "recovery-time", "", "Point in time to create the new database from in RFC3339 format, e.g. `2026-10-01T12:00:00Z`. Defaults to the latest available point in time.")
+	config.AddToggleFlag(flags, &s.wait, "wait", false, "Wait for the new database to be in running state before returning.")
+
+	s.AddFlags(flags)
+
+	commands.Must(s.Cobra().MarkFlagRequired("title"))
+	commands.Must(s.Cobra().MarkFlagRequired("hostname-prefix"))
+	s.Cobra().MarkFlagsMutuallyExclusive("backup-name", "recovery-time")
+	for _, flag := range []string{"title", "hostname-prefix", "plan", "backup-name", "recovery-time"} {
+		commands.Must(s.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+func (s *forkCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("zone", namedargs.CompletionFunc(completion.Zone{}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *forkCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.All()
+	msg := fmt.Sprintf("Forking database %v as %s", uuid, s.params.Title)
+	exec.PushProgressStarted(msg)
+
+	req := s.params
+	req.UUID = uuid
+
+	if s.recoveryTime != "" {
+		cloneTime, err := time.Parse(time.RFC3339, s.recoveryTime)
+		if err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("invalid recovery time %q, expected RFC3339 format: %w", s.recoveryTime, err))
+		}
+		req.CloneTime = cloneTime
+	}
+
+	if req.Plan == "" || req.Zone == "" {
+		source, err := svc.GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+		if req.Plan == "" {
+			req.Plan = source.Plan
+		}
+		if req.Zone == "" {
+			req.Zone = source.Zone
+		}
+	}
+
+	res, err := svc.CloneManagedDatabase(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if s.wait.Value() {
+		WaitForManagedDatabaseState(res.UUID, upcloud.ManagedDatabaseStateRunning, exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "UUID", Value: res.UUID, Colour: ui.DefaultUUUIDColours},
+	}}, nil
+}
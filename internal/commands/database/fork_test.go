@@ -0,0 +1,99 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestForkCommand(t *testing.T) {
+	targetMethod := "CloneManagedDatabase"
+	source := upcloud.ManagedDatabase{
+		Plan:  "1x1xCPU-2GB-25GB",
+		State: upcloud.ManagedDatabaseStateRunning,
+		Type:  upcloud.ManagedDatabaseServiceTypePostgreSQL,
+		UUID:  "1fdfda29-ead1-4855-b71f-1e33eb2ca9de",
+		Zone:  "fi-hel1",
+	}
+	fork := upcloud.ManagedDatabase{
+		UUID: "09a5b1b3-7e4c-4d3b-9a43-2e9fcd7b1a16",
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected func(r *request.CloneManagedDatabaseRequest) bool
+		error    string
+	}{
+		{
+			name: "defaults from source database",
+			args: []string{"--title", "fork", "--hostname-prefix", "fork"},
+			expected: func(r *request.CloneManagedDatabaseRequest) bool {
+				return r.UUID == source.UUID &&
+					r.Title == "fork" &&
+					r.HostNamePrefix == "fork" &&
+					r.Plan == source.Plan &&
+					r.Zone == source.Zone &&
+					r.BackupName == "" &&
+					r.CloneTime.IsZero()
+			},
+		},
+		{
+			name: "recovery time",
+			args: []string{"--title", "fork", "--hostname-prefix", "fork", "--recovery-time", "2026-10-01T12:00:00Z", "--plan", "2x2xCPU-4GB-50GB", "--zone", "de-fra1"},
+			expected: func(r *request.CloneManagedDatabaseRequest) bool {
+				return r.Plan == "2x2xCPU-4GB-50GB" &&
+					r.Zone == "de-fra1" &&
+					r.CloneTime.Equal(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+			},
+		},
+		{
+			name: "backup name",
+			args: []string{"--title", "fork", "--hostname-prefix", "fork", "--backup-name", "backup-1"},
+			expected: func(r *request.CloneManagedDatabaseRequest) bool {
+				return r.BackupName == "backup-1" && r.CloneTime.IsZero()
+			},
+		},
+		{
+			name:  "invalid recovery time",
+			args:  []string{"--title", "fork", "--hostname-prefix", "fork", "--recovery-time", "yesterday"},
+			error: `invalid recovery time "yesterday", expected RFC3339 format: parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`,
+		},
+		{
+			name:  "backup name and recovery time",
+			args:  []string{"--title", "fork", "--hostname-prefix", "fork", "--backup-name", "backup-1", "--recovery-time", "2026-10-01T12:00:00Z"},
+			error: "if any flags in the group [backup-name recovery-time] are set none of the others can be; [backup-name recovery-time] were all set",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: source.UUID}).Return(&source, nil)
+			if test.expected != nil {
+				mService.On(targetMethod, mock.MatchedBy(test.expected)).Return(&fork, nil)
+			}
+
+			c := commands.BuildCommand(ForkCommand(), nil, conf)
+
+			c.Cobra().SetArgs(append(test.args, source.UUID))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				mService.AssertNotCalled(t, targetMethod, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				mService.AssertNumberOfCalls(t, targetMethod, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package databaselogicaldatabase
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	params request.CreateManagedDatabaseLogicalDatabaseRequest
+}
+
+// CreateCommand creates the "database logical-database create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a logical database into the specified database",
+			"upctl database logical-database create pg-1 --name app",
+			"upctl database logical-database create pg-1 --name app --lc-collate fi_FI.UTF-8 --lc-ctype fi_FI.UTF-8",
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringVar(&s.params.Name, "name", "", "Name of the logical database.")
+	flagSet.StringVar(&s.params.LCCollate, "lc-collate", "", "Default string sort order (LC_COLLATE) of the logical database. Only supported for PostgreSQL databases.")
+	flagSet.StringVar(&s.params.LCCType, "lc-ctype", "", "Default character classification (LC_CTYPE) of the logical database. Only supported for PostgreSQL databases.")
+	commands.Must(flagSet.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(flagSet.SetAnnotation("lc-collate", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(flagSet.SetAnnotation("lc-ctype", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(flagSet)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *createCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Creating logical database %s into database %v", s.params.Name, uuid)
+	exec.PushProgressStarted(msg)
+
+	req := s.params
+	req.ServiceUUID = uuid
+
+	res, err := exec.All().CreateManagedDatabaseLogicalDatabase(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,70 @@
+package databaselogicaldatabase
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCommand(t *testing.T) {
+	targetMethod := "CreateManagedDatabaseLogicalDatabase"
+	uuid := "0fa980c4-0e4f-460b-9869-11b7bd62b833"
+	for _, test := range []struct {
+		name     string
+		args     []string
+		error    string
+		expected request.CreateManagedDatabaseLogicalDatabaseRequest
+	}{
+		{
+			name:  "no name",
+			args:  []string{},
+			error: `required flag(s) "name" not set`,
+		},
+		{
+			name: "name only",
+			args: []string{"--name", "app"},
+			expected: request.CreateManagedDatabaseLogicalDatabaseRequest{
+				ServiceUUID: uuid,
+				Name:        "app",
+			},
+		},
+		{
+			name: "with locale",
+			args: []string{"--name", "app", "--lc-collate", "fi_FI.UTF-8", "--lc-ctype", "fi_FI.UTF-8"},
+			expected: request.CreateManagedDatabaseLogicalDatabaseRequest{
+				ServiceUUID: uuid,
+				Name:        "app",
+				LCCollate:   "fi_FI.UTF-8",
+				LCCType:     "fi_FI.UTF-8",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := CreateCommand()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On(targetMethod, &expected).Return(&upcloud.ManagedDatabaseLogicalDatabase{Name: expected.Name}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, config.New())
+
+			c.Cobra().SetArgs(append(test.args, uuid))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+			} else {
+				assert.NoError(t, err)
+				mService.AssertNumberOfCalls(t, targetMethod, 1)
+			}
+		})
+	}
+}
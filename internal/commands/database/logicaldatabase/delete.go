@@ -0,0 +1,66 @@
+package databaselogicaldatabase
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	name string
+}
+
+// DeleteCommand creates the "database logical-database delete" command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a logical database from the specified database",
+			"upctl database logical-database delete 0fa980c4-0e4f-460b-9869-11b7bd62b832 --name app",
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *deleteCommand) InitCommand() {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringVar(&s.name, "name", "", "Name of the logical database to delete.")
+	s.AddFlags(flagSet)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *deleteCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.DatabaseLogicalDatabase{Database: args[0]}
+	}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *deleteCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Deleting logical database %s from database %v", s.name, uuid)
+	exec.PushProgressStarted(msg)
+
+	err := exec.All().DeleteManagedDatabaseLogicalDatabase(exec.Context(), &request.DeleteManagedDatabaseLogicalDatabaseRequest{
+		ServiceUUID: uuid,
+		Name:        s.name,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
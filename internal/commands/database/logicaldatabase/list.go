@@ -0,0 +1,59 @@
+package databaselogicaldatabase
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+}
+
+// ListCommand creates the "database logical-database list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List logical databases of the specified database",
+			"upctl database logical-database list 0fa980c4-0e4f-460b-9869-11b7bd62b832",
+			"upctl database logical-database list pg-1",
+		),
+	}
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *listCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	logicalDatabases, err := exec.All().GetManagedDatabaseLogicalDatabases(exec.Context(), &request.GetManagedDatabaseLogicalDatabasesRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, ldb := range logicalDatabases {
+		rows = append(rows, output.TableRow{
+			ldb.Name,
+			ldb.LCCollate,
+			ldb.LCCType,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: logicalDatabases,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "lc_collate", Header: "Collation", Format: format.PossiblyUnknownString},
+				{Key: "lc_ctype", Header: "Character classification", Format: format.PossiblyUnknownString},
+			},
+			Rows:         rows,
+			EmptyMessage: "No logical databases found for this database.",
+		},
+	}, nil
+}
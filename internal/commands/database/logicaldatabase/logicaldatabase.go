@@ -0,0 +1,21 @@
+package databaselogicaldatabase
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+)
+
+// BaseLogicalDatabaseCommand creates the base "database logical-database" command
+func BaseLogicalDatabaseCommand() commands.Command {
+	return &databaseLogicalDatabaseCommand{
+		commands.New("logical-database", "Manage logical databases of MySQL and PostgreSQL databases"),
+	}
+}
+
+type databaseLogicalDatabaseCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (k *databaseLogicalDatabaseCommand) InitCommand() {
+	k.Cobra().Aliases = []string{"ldb"}
+}
@@ -0,0 +1,126 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "database modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify an existing managed database",
+			"upctl database modify mydb --plan 2x4xCPU-8GB-100GB",
+			"upctl database modify 0fa980c4-0e4f-460b-9869-11b7bd62b832 --title my-renamed-db --maintenance-dow sunday --maintenance-time 02:00:00",
+			"upctl database modify mydb --zone de-fra1",
+			"upctl database modify mydb --property max_connections=200 --property sql_mode=ANSI",
+			"upctl database modify mydb --label env=prod --network name=net,family=IPv4,type=private,uuid=030e83d2-d413-4d19-b1c9-af05cdb60c1f",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	params     request.ModifyManagedDatabaseRequest
+	labels     []string
+	networks   []string
+	properties []string
+	wait       config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	flags := &pflag.FlagSet{}
+	flags.StringVar(&s.params.Title, "title", "", "A short, informational description.")
+	flags.StringVar(&s.params.Plan, "plan", "", "Plan to use for the database. Run `upctl database plans [database type]` to list all available plans.")
+	flags.StringVar(&s.params.Zone, "zone", "", "Zone to move the database to.")
+	flags.StringVar(&s.params.Maintenance.DayOfWeek, "maintenance-dow", "", "Full name of weekday in English, lower case(sunday) for automatic maintenance day of the week.")
+	flags.StringVar(&s.params.Maintenance.Time, "maintenance-time", "", "Database time in UTC of automatic maintenance HH:MM:SS.")
+	flags.StringSliceVar(&s.labels, "label", nil, "Labels to describe the database in `key=value` format, multiple can be declared. If set, all the existing labels will be replaced with provided ones.\nUsage: --label env=dev\n\n--label owner=operations")
+	flags.StringArrayVar(&s.networks, "network", nil, "A network interface for the database, multiple can be declared. If set, all the existing networks will be replaced with provided ones.\nUsage: --network name=network-name,family=IPv4,type=private,uuid=030e83d2-d413-4d19-b1c9-af05cdb60c1f")
+	flags.StringArrayVar(&s.properties, "property", nil, "Properties for the database in `key=value` format. Can be specified multiple times.")
+	config.AddToggleFlag(flags, &s.wait, "wait", false, "Wait for database to be in running state before returning.")
+
+	s.AddFlags(flags)
+
+	for _, flag := range []string{"title", "plan", "maintenance-dow", "maintenance-time", "label", "network", "property"} {
+		commands.Must(s.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("zone", namedargs.CompletionFunc(completion.Zone{}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.All()
+	msg := fmt.Sprintf("Modifying database %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	req := s.params
+	req.UUID = uuid
+
+	if len(s.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(s.labels)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+		req.Labels = &labelSlice
+	}
+
+	if len(s.networks) > 0 {
+		networks, err := processNetworks(s.networks)
+		if err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("invalid networks: %w", err))
+		}
+		req.Networks = &networks
+	}
+
+	if len(s.properties) > 0 {
+		db, err := svc.GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		t, err := svc.GetManagedDatabaseServiceType(exec.Context(), &request.GetManagedDatabaseServiceTypeRequest{
+			Type: string(db.Type),
+		})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		props, err := processProperties(s.properties, t)
+		if err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("invalid properties: %w", err))
+		}
+		req.Properties = props
+	}
+
+	res, err := svc.ModifyManagedDatabase(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if s.wait.Value() {
+		WaitForManagedDatabaseState(res.UUID, upcloud.ManagedDatabaseStateRunning, exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
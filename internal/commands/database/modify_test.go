@@ -0,0 +1,119 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestModifyCommand(t *testing.T) {
+	targetMethod := "ModifyManagedDatabase"
+	db := upcloud.ManagedDatabase{
+		State: upcloud.ManagedDatabaseStateRunning,
+		Title: "database-title",
+		Type:  upcloud.ManagedDatabaseServiceTypePostgreSQL,
+		UUID:  "1fdfda29-ead1-4855-b71f-1e33eb2ca9de",
+	}
+	serviceType := upcloud.ManagedDatabaseType{
+		Properties: map[string]upcloud.ManagedDatabaseServiceProperty{
+			"version": {
+				Type: []string{"string", "null"},
+			},
+		},
+	}
+	networkUUID := "030e83d2-d413-4d19-b1c9-af05cdb60c1f"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.ModifyManagedDatabaseRequest
+		error    string
+	}{
+		{
+			name: "plan, title, and zone",
+			args: []string{"--plan", "2x4xCPU-8GB-100GB", "--title", "renamed", "--zone", "de-fra1"},
+			expected: request.ModifyManagedDatabaseRequest{
+				UUID:  db.UUID,
+				Plan:  "2x4xCPU-8GB-100GB",
+				Title: "renamed",
+				Zone:  "de-fra1",
+			},
+		},
+		{
+			name: "maintenance window",
+			args: []string{"--maintenance-dow", "sunday", "--maintenance-time", "02:00:00"},
+			expected: request.ModifyManagedDatabaseRequest{
+				UUID: db.UUID,
+				Maintenance: request.ManagedDatabaseMaintenanceTimeRequest{
+					DayOfWeek: "sunday",
+					Time:      "02:00:00",
+				},
+			},
+		},
+		{
+			name: "labels and networks",
+			args: []string{"--label", "env=prod", "--network", "name=net,family=IPv4,type=private,uuid=" + networkUUID},
+			expected: request.ModifyManagedDatabaseRequest{
+				UUID:   db.UUID,
+				Labels: &[]upcloud.Label{{Key: "env", Value: "prod"}},
+				Networks: &[]upcloud.ManagedDatabaseNetwork{{
+					Name:   "net",
+					Family: "IPv4",
+					Type:   "private",
+					UUID:   &networkUUID,
+				}},
+			},
+		},
+		{
+			name: "properties",
+			args: []string{"--property", "max_connections=200", "--property", "version=16"},
+			expected: request.ModifyManagedDatabaseRequest{
+				UUID: db.UUID,
+				Properties: request.ManagedDatabasePropertiesRequest{
+					"max_connections": float64(200),
+					"version":         "16",
+				},
+			},
+		},
+		{
+			name:  "invalid property",
+			args:  []string{"--property", "max_connections"},
+			error: "invalid properties: invalid property format: max_connections, expected key=value",
+		},
+		{
+			name:  "invalid network",
+			args:  []string{"--network", "name=net,size=1"},
+			error: "invalid networks: unknown network parameter: size",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: db.UUID}).Return(&db, nil)
+			mService.On("GetManagedDatabaseServiceType", &request.GetManagedDatabaseServiceTypeRequest{Type: "pg"}).Return(&serviceType, nil)
+			expected := test.expected
+			mService.On(targetMethod, &expected).Return(&db, nil)
+
+			c := commands.BuildCommand(ModifyCommand(), nil, conf)
+
+			c.Cobra().SetArgs(append(test.args, db.UUID))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				mService.AssertNotCalled(t, targetMethod, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				mService.AssertNumberOfCalls(t, targetMethod, 1)
+			}
+		})
+	}
+}
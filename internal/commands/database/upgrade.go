@@ -0,0 +1,127 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// upgradeWaitPollInterval defines how often the database is polled when waiting for the upgrade to complete
+var upgradeWaitPollInterval = 5 * time.Second
+
+// UpgradeCommand creates the "database upgrade" command
+func UpgradeCommand() commands.Command {
+	return &upgradeCommand{
+		BaseCommand: commands.New(
+			"upgrade",
+			"Upgrade the major version of a managed database",
+			"upctl database upgrade pg-1 --version 16",
+			"upctl database upgrade 0fa980c4-0e4f-460b-9869-11b7bd62b832 --version 16 --wait",
+		),
+	}
+}
+
+type upgradeCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	version string
+	wait    config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *upgradeCommand) InitCommand() {
+	flags := &pflag.FlagSet{}
+	flags.StringVar(&s.version, "version", "", "Target version of the database. Run `upctl database show <database>` to see the current version.")
+	config.AddToggleFlag(flags, &s.wait, "wait", false, "Wait for database to be upgraded to the target version and to be in running state before returning.")
+	commands.Must(flags.SetAnnotation("version", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(flags)
+
+	commands.Must(s.Cobra().MarkFlagRequired("version"))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *upgradeCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.All()
+	msg := fmt.Sprintf("Upgrading database %v to version %s", uuid, s.version)
+	exec.PushProgressStarted(msg)
+
+	versions, err := svc.GetManagedDatabaseVersions(exec.Context(), &request.GetManagedDatabaseVersionsRequest{UUID: uuid})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if len(versions) == 0 {
+		return commands.HandleError(exec, msg, fmt.Errorf("database %s does not have any versions available for upgrade", uuid))
+	}
+
+	if !slices.Contains(versions, s.version) {
+		return commands.HandleError(exec, msg, fmt.Errorf("version %s is not available for database %s, available versions: %s", s.version, uuid, strings.Join(versions, ", ")))
+	}
+
+	res, err := svc.UpgradeManagedDatabaseVersion(exec.Context(), &request.UpgradeManagedDatabaseVersionRequest{
+		UUID:          uuid,
+		TargetVersion: s.version,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if s.wait.Value() {
+		waitForManagedDatabaseVersion(uuid, s.version, exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
+
+// waitForManagedDatabaseVersion waits for database to report given version and to be in running state. The database is still in running state right after the upgrade request, so waiting only for the state would return before the upgrade has started. Finally, progress message is updated back to given msg and either done state or timeout warning.
+func waitForManagedDatabaseVersion(uuid, version string, exec commands.Executor, msg string) {
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for database %s to be upgraded to version %s", uuid, version))
+
+	ctx, cancel := context.WithTimeout(exec.Context(), 15*time.Minute)
+	defer cancel()
+
+	for {
+		db, err := exec.All().GetManagedDatabase(ctx, &request.GetManagedDatabaseRequest{UUID: uuid})
+		if err == nil && db.State == upcloud.ManagedDatabaseStateRunning && isVersion(getVersion(db), version) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			if err == nil {
+				err = ctx.Err()
+			}
+			exec.PushProgressUpdate(messages.Update{
+				Key:     msg,
+				Message: msg,
+				Status:  messages.MessageStatusWarning,
+				Details: "Error: " + err.Error(),
+			})
+			return
+		case <-time.After(upgradeWaitPollInterval):
+		}
+	}
+
+	exec.PushProgressUpdateMessage(msg, msg)
+	exec.PushProgressSuccess(msg)
+}
+
+// isVersion checks if the full version reported in database metadata, e.g. `16.4`, matches the given major version, e.g. `16`.
+func isVersion(current, version string) bool {
+	return current == version || strings.HasPrefix(current, version+".")
+}
@@ -0,0 +1,114 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpgradeCommand(t *testing.T) {
+	targetMethod := "UpgradeManagedDatabaseVersion"
+	db := upcloud.ManagedDatabase{
+		State: upcloud.ManagedDatabaseStateRunning,
+		Title: "database-title",
+		Type:  upcloud.ManagedDatabaseServiceTypePostgreSQL,
+		UUID:  "1fdfda29-ead1-4855-b71f-1e33eb2ca9de",
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		versions []string
+		error    string
+	}{
+		{
+			name:     "available version",
+			args:     []string{"--version", "16"},
+			versions: []string{"15", "16"},
+		},
+		{
+			name:     "unavailable version",
+			args:     []string{"--version", "17"},
+			versions: []string{"15", "16"},
+			error:    "version 17 is not available for database 1fdfda29-ead1-4855-b71f-1e33eb2ca9de, available versions: 15, 16",
+		},
+		{
+			name:     "no available versions",
+			args:     []string{"--version", "16"},
+			versions: []string{},
+			error:    "database 1fdfda29-ead1-4855-b71f-1e33eb2ca9de does not have any versions available for upgrade",
+		},
+		{
+			name:  "missing version",
+			error: "required flag(s) \"version\" not set",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			mService.On("GetManagedDatabaseVersions", &request.GetManagedDatabaseVersionsRequest{UUID: db.UUID}).Return(test.versions, nil)
+			mService.On(targetMethod, &request.UpgradeManagedDatabaseVersionRequest{
+				UUID:          db.UUID,
+				TargetVersion: "16",
+			}).Return(&db, nil)
+
+			c := commands.BuildCommand(UpgradeCommand(), nil, conf)
+
+			c.Cobra().SetArgs(append(test.args, db.UUID))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				mService.AssertNotCalled(t, targetMethod, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				mService.AssertNumberOfCalls(t, targetMethod, 1)
+			}
+		})
+	}
+}
+
+func TestUpgradeCommand_Wait(t *testing.T) {
+	upgradeWaitPollInterval = time.Millisecond
+
+	uuid := "1fdfda29-ead1-4855-b71f-1e33eb2ca9de"
+	dbWithState := func(state upcloud.ManagedDatabaseState, version string) *upcloud.ManagedDatabase {
+		return &upcloud.ManagedDatabase{
+			State:    state,
+			Type:     upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			UUID:     uuid,
+			Metadata: &upcloud.ManagedDatabaseMetadata{PGVersion: version},
+		}
+	}
+
+	conf := config.New()
+	mService := new(smock.Service)
+
+	mService.On("GetManagedDatabaseVersions", &request.GetManagedDatabaseVersionsRequest{UUID: uuid}).Return([]string{"15", "16"}, nil)
+	mService.On("UpgradeManagedDatabaseVersion", &request.UpgradeManagedDatabaseVersionRequest{
+		UUID:          uuid,
+		TargetVersion: "16",
+	}).Return(dbWithState(upcloud.ManagedDatabaseStateRunning, "15.8"), nil)
+
+	getReq := &request.GetManagedDatabaseRequest{UUID: uuid}
+	mService.On("GetManagedDatabase", getReq).Return(dbWithState(upcloud.ManagedDatabaseStateRunning, "15.8"), nil).Once()
+	mService.On("GetManagedDatabase", getReq).Return(dbWithState(upcloud.ManagedDatabaseStateRebuilding, "15.8"), nil).Once()
+	mService.On("GetManagedDatabase", getReq).Return(dbWithState(upcloud.ManagedDatabaseStateRunning, "16.4"), nil).Once()
+
+	c := commands.BuildCommand(UpgradeCommand(), nil, conf)
+
+	c.Cobra().SetArgs([]string{"--version", "16", "--wait", uuid})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+
+	assert.NoError(t, err)
+	mService.AssertNumberOfCalls(t, "GetManagedDatabase", 3)
+}
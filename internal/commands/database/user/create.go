@@ -0,0 +1,107 @@
+package databaseuser
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	accessControlParams
+
+	username       string
+	authentication string
+	passwordStdin  config.OptionalBoolean
+}
+
+// CreateCommand creates the "database user create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a user into the specified database",
+			"upctl database user create pg-1 --username app",
+			"echo $DB_PASSWORD | upctl database user create pg-1 --username replicator --password-stdin --allow-replication",
+			"upctl database user create valkey-1 --username app --valkey-keys 'app:*' --valkey-categories +@read,+@write",
+			"upctl database user create opensearch-1 --username reader --opensearch-rule 'logs-*=read'",
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringVar(&s.username, "username", "", "Username of the user.")
+	config.AddToggleFlag(flagSet, &s.passwordStdin, "password-stdin", false, "Read password of the user from standard input. If not set, a random password is generated.")
+	flagSet.StringVar(&s.authentication, "authentication", "", "Authentication plugin to use for the user: "+strings.Join(authenticationTypes, ", ")+". Only supported for MySQL databases.")
+	s.accessControlParams.addFlags(flagSet)
+	commands.Must(flagSet.SetAnnotation("username", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(flagSet.SetAnnotation("authentication", commands.FlagAnnotationFixedCompletions, authenticationTypes))
+	s.AddFlags(flagSet)
+
+	commands.Must(s.Cobra().MarkFlagRequired("username"))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *createCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.All()
+	db, err := svc.GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	req := request.CreateManagedDatabaseUserRequest{
+		ServiceUUID: uuid,
+		Username:    s.username,
+	}
+
+	if s.authentication != "" {
+		if db.Type != upcloud.ManagedDatabaseServiceTypeMySQL {
+			return nil, fmt.Errorf("--authentication is only supported for MySQL databases")
+		}
+		if !slices.Contains(authenticationTypes, s.authentication) {
+			return nil, fmt.Errorf("invalid authentication %q, must be one of: %s", s.authentication, strings.Join(authenticationTypes, ", "))
+		}
+		req.Authentication = upcloud.ManagedDatabaseUserAuthenticationType(s.authentication)
+	}
+
+	ac, err := s.processAccessControl(s.Cobra().Flags(), db.Type)
+	if err != nil {
+		return nil, err
+	}
+	req.PGAccessControl = ac.pg
+	req.ValkeyAccessControl = ac.valkey
+	req.OpenSearchAccessControl = ac.openSearch
+
+	if s.passwordStdin.Value() {
+		req.Password, err = readPassword(s.Cobra().InOrStdin())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	msg := fmt.Sprintf("Creating user %s into database %v", s.username, uuid)
+	exec.PushProgressStarted(msg)
+
+	user, err := svc.CreateManagedDatabaseUser(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return userOutput(user, !s.passwordStdin.Value()), nil
+}
@@ -0,0 +1,152 @@
+package databaseuser
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateCommand(t *testing.T) {
+	targetMethod := "CreateManagedDatabaseUser"
+	uuid := "0fa980c4-0e4f-460b-9869-11b7bd62b833"
+	readRules := []upcloud.ManagedDatabaseUserOpenSearchAccessControlRule{
+		{Index: "logs-*", Permission: "read"},
+		{Index: "app", Permission: "readwrite"},
+	}
+	valkeyKeys := []string{"app:*"}
+	valkeyCategories := []string{"+@read", "+@write"}
+
+	for _, test := range []struct {
+		name     string
+		dbType   upcloud.ManagedDatabaseServiceType
+		args     []string
+		stdin    string
+		error    string
+		expected request.CreateManagedDatabaseUserRequest
+	}{
+		{
+			name:   "no username",
+			dbType: upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			args:   []string{},
+			error:  `required flag(s) "username" not set`,
+		},
+		{
+			name:   "generated password",
+			dbType: upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			args:   []string{"--username", "app"},
+			expected: request.CreateManagedDatabaseUserRequest{
+				ServiceUUID: uuid,
+				Username:    "app",
+			},
+		},
+		{
+			name:   "password from stdin with replication",
+			dbType: upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			args:   []string{"--username", "replicator", "--password-stdin", "--allow-replication"},
+			stdin:  "s3cr3t-p4ssw0rd\n",
+			expected: request.CreateManagedDatabaseUserRequest{
+				ServiceUUID:     uuid,
+				Username:        "replicator",
+				Password:        "s3cr3t-p4ssw0rd",
+				PGAccessControl: &upcloud.ManagedDatabaseUserPGAccessControl{AllowReplication: upcloud.BoolPtr(true)},
+			},
+		},
+		{
+			name:   "empty password from stdin",
+			dbType: upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			args:   []string{"--username", "app", "--password-stdin"},
+			stdin:  "\n",
+			error:  "password must not be empty",
+		},
+		{
+			name:   "mysql authentication",
+			dbType: upcloud.ManagedDatabaseServiceTypeMySQL,
+			args:   []string{"--username", "app", "--authentication", "mysql_native_password"},
+			expected: request.CreateManagedDatabaseUserRequest{
+				ServiceUUID:    uuid,
+				Username:       "app",
+				Authentication: upcloud.ManagedDatabaseUserAuthenticationMySQLNativePassword,
+			},
+		},
+		{
+			name:   "authentication with postgresql",
+			dbType: upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			args:   []string{"--username", "app", "--authentication", "mysql_native_password"},
+			error:  "--authentication is only supported for MySQL databases",
+		},
+		{
+			name:   "valkey access control",
+			dbType: upcloud.ManagedDatabaseServiceTypeValkey,
+			args:   []string{"--username", "app", "--valkey-keys", "app:*", "--valkey-categories", "+@read,+@write"},
+			expected: request.CreateManagedDatabaseUserRequest{
+				ServiceUUID: uuid,
+				Username:    "app",
+				ValkeyAccessControl: &upcloud.ManagedDatabaseUserValkeyAccessControl{
+					Categories: &valkeyCategories,
+					Keys:       &valkeyKeys,
+				},
+			},
+		},
+		{
+			name:   "valkey access control with postgresql",
+			dbType: upcloud.ManagedDatabaseServiceTypePostgreSQL,
+			args:   []string{"--username", "app", "--valkey-keys", "app:*"},
+			error:  "--valkey-keys is only supported for Valkey databases",
+		},
+		{
+			name:   "opensearch access control",
+			dbType: upcloud.ManagedDatabaseServiceTypeOpenSearch,
+			args:   []string{"--username", "reader", "--opensearch-rule", "logs-*=read", "--opensearch-rule", "app=readwrite"},
+			expected: request.CreateManagedDatabaseUserRequest{
+				ServiceUUID:             uuid,
+				Username:                "reader",
+				OpenSearchAccessControl: &upcloud.ManagedDatabaseUserOpenSearchAccessControl{Rules: &readRules},
+			},
+		},
+		{
+			name:   "invalid opensearch permission",
+			dbType: upcloud.ManagedDatabaseServiceTypeOpenSearch,
+			args:   []string{"--username", "reader", "--opensearch-rule", "logs-*=all"},
+			error:  `invalid permission "all" of OpenSearch rule logs-*, must be one of: admin, deny, read, readwrite, write`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := CreateCommand()
+			mService := new(smock.Service)
+
+			mService.On("GetManagedDatabase", &request.GetManagedDatabaseRequest{UUID: uuid}).
+				Return(&upcloud.ManagedDatabase{
+					State: upcloud.ManagedDatabaseStateRunning,
+					Type:  test.dbType,
+					UUID:  uuid,
+				}, nil)
+
+			expected := test.expected
+			mService.On(targetMethod, &expected).Return(&upcloud.ManagedDatabaseUser{Username: expected.Username}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, config.New())
+
+			c.Cobra().SetArgs(append(test.args, uuid))
+			c.Cobra().SetIn(bytes.NewBufferString(test.stdin))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				mService.AssertNotCalled(t, targetMethod, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				mService.AssertNumberOfCalls(t, targetMethod, 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package databaseuser
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	username string
+}
+
+// DeleteCommand creates the "database user delete" command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a user from the specified database",
+			"upctl database user delete 0fa980c4-0e4f-460b-9869-11b7bd62b832 --username app",
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *deleteCommand) InitCommand() {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringVar(&s.username, "username", "", "Username of the user to delete.")
+	s.AddFlags(flagSet)
+
+	commands.Must(s.Cobra().MarkFlagRequired("username"))
+}
+
+func (s *deleteCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("username", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.DatabaseUser{Database: args[0]}
+	}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *deleteCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Deleting user %s from database %v", s.username, uuid)
+	exec.PushProgressStarted(msg)
+
+	err := exec.All().DeleteManagedDatabaseUser(exec.Context(), &request.DeleteManagedDatabaseUserRequest{
+		ServiceUUID: uuid,
+		Username:    s.username,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
@@ -0,0 +1,59 @@
+package databaseuser
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+}
+
+// ListCommand creates the "database user list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List users of the specified database",
+			"upctl database user list 0fa980c4-0e4f-460b-9869-11b7bd62b832",
+			"upctl database user list pg-1",
+		),
+	}
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *listCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	users, err := exec.All().GetManagedDatabaseUsers(exec.Context(), &request.GetManagedDatabaseUsersRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, user := range users {
+		rows = append(rows, output.TableRow{
+			user.Username,
+			user.Type,
+			string(user.Authentication),
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: users,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "username", Header: "Username"},
+				{Key: "type", Header: "Type"},
+				{Key: "authentication", Header: "Authentication", Format: format.PossiblyUnknownString},
+			},
+			Rows:         rows,
+			EmptyMessage: "No users found for this database.",
+		},
+	}, nil
+}
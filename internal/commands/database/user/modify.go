@@ -0,0 +1,129 @@
+package databaseuser
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	accessControlParams
+
+	username       string
+	authentication string
+	passwordStdin  config.OptionalBoolean
+}
+
+// ModifyCommand creates the "database user modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify password, authentication or access control of a database user",
+			"echo $DB_PASSWORD | upctl database user modify pg-1 --username app --password-stdin",
+			"upctl database user modify mysql-1 --username app --authentication caching_sha2_password",
+			"upctl database user modify pg-1 --username replicator --allow-replication=false",
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringVar(&s.username, "username", "", "Username of the user to modify.")
+	config.AddToggleFlag(flagSet, &s.passwordStdin, "password-stdin", false, "Read new password of the user from standard input.")
+	flagSet.StringVar(&s.authentication, "authentication", "", "Authentication plugin to use for the user: "+strings.Join(authenticationTypes, ", ")+". Only supported for MySQL databases.")
+	s.accessControlParams.addFlags(flagSet)
+	commands.Must(flagSet.SetAnnotation("authentication", commands.FlagAnnotationFixedCompletions, authenticationTypes))
+	s.AddFlags(flagSet)
+
+	commands.Must(s.Cobra().MarkFlagRequired("username"))
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("username", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.DatabaseUser{Database: args[0]}
+	}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.All()
+	db, err := svc.GetManagedDatabase(exec.Context(), &request.GetManagedDatabaseRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	req := request.ModifyManagedDatabaseUserRequest{
+		ServiceUUID: uuid,
+		Username:    s.username,
+	}
+
+	if s.authentication != "" {
+		if db.Type != upcloud.ManagedDatabaseServiceTypeMySQL {
+			return nil, fmt.Errorf("--authentication is only supported for MySQL databases")
+		}
+		if !slices.Contains(authenticationTypes, s.authentication) {
+			return nil, fmt.Errorf("invalid authentication %q, must be one of: %s", s.authentication, strings.Join(authenticationTypes, ", "))
+		}
+		req.Authentication = upcloud.ManagedDatabaseUserAuthenticationType(s.authentication)
+	}
+
+	ac, err := s.processAccessControl(s.Cobra().Flags(), db.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.passwordStdin.Value() {
+		req.Password, err = readPassword(s.Cobra().InOrStdin())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Password == "" && req.Authentication == "" && ac.isEmpty() {
+		return nil, fmt.Errorf("nothing to modify, define at least one of --password-stdin, --authentication, or access control flags")
+	}
+
+	msg := fmt.Sprintf("Modifying user %s of database %v", s.username, uuid)
+	exec.PushProgressStarted(msg)
+
+	var user *upcloud.ManagedDatabaseUser
+	if req.Password != "" || req.Authentication != "" {
+		user, err = svc.ModifyManagedDatabaseUser(exec.Context(), &req)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+
+	if !ac.isEmpty() {
+		user, err = svc.ModifyManagedDatabaseUserAccessControl(exec.Context(), &request.ModifyManagedDatabaseUserAccessControlRequest{
+			ServiceUUID:             uuid,
+			Username:                s.username,
+			PGAccessControl:         ac.pg,
+			ValkeyAccessControl:     ac.valkey,
+			OpenSearchAccessControl: ac.openSearch,
+		})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return userOutput(user, false), nil
+}
@@ -0,0 +1,135 @@
+package databaseuser
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingDatabase
+	completion.Database
+	username string
+}
+
+// ShowCommand creates the "database user show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show details of a database user",
+			"upctl database user show 0fa980c4-0e4f-460b-9869-11b7bd62b832 --username app",
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *showCommand) InitCommand() {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringVar(&s.username, "username", "", "Username of the user to show.")
+	s.AddFlags(flagSet)
+
+	commands.Must(s.Cobra().MarkFlagRequired("username"))
+}
+
+func (s *showCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("username", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.DatabaseUser{Database: args[0]}
+	}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *showCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	user, err := exec.All().GetManagedDatabaseUser(exec.Context(), &request.GetManagedDatabaseUserRequest{
+		ServiceUUID: uuid,
+		Username:    s.username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return userOutput(user, false), nil
+}
+
+// userOutput returns output for given user. Password is included in the human readable output only if showPassword is true.
+func userOutput(user *upcloud.ManagedDatabaseUser, showPassword bool) output.Output {
+	rows := []output.DetailRow{
+		{Title: "Username:", Value: user.Username},
+		{Title: "Type:", Value: user.Type},
+		{Title: "Authentication:", Value: string(user.Authentication), Format: format.PossiblyUnknownString},
+	}
+	if showPassword {
+		rows = append(rows, output.DetailRow{Title: "Password:", Value: user.Password})
+	}
+
+	combined := output.Combined{
+		output.CombinedSection{
+			Contents: output.Details{
+				Sections: []output.DetailSection{
+					{
+						Title: "Overview:",
+						Rows:  rows,
+					},
+				},
+			},
+		},
+	}
+
+	if ac := accessControlRows(user); len(ac) > 0 {
+		combined = append(combined, output.CombinedSection{
+			Key:   "access_control",
+			Title: "Access control:",
+			Contents: output.Details{
+				Sections: []output.DetailSection{{Rows: ac}},
+			},
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value:  user,
+		Output: combined,
+	}
+}
+
+func accessControlRows(user *upcloud.ManagedDatabaseUser) []output.DetailRow {
+	rows := []output.DetailRow{}
+
+	if pg := user.PGAccessControl; pg != nil && pg.AllowReplication != nil {
+		rows = append(rows, output.DetailRow{Title: "Allow replication:", Value: *pg.AllowReplication, Format: format.Boolean})
+	}
+
+	if valkey := user.ValkeyAccessControl; valkey != nil {
+		for _, v := range []struct {
+			title  string
+			values *[]string
+		}{
+			{"Categories:", valkey.Categories},
+			{"Channels:", valkey.Channels},
+			{"Commands:", valkey.Commands},
+			{"Keys:", valkey.Keys},
+		} {
+			if v.values != nil {
+				rows = append(rows, output.DetailRow{Title: v.title, Value: *v.values, Format: format.StringSliceSingleLineAnd})
+			}
+		}
+	}
+
+	if openSearch := user.OpenSearchAccessControl; openSearch != nil && openSearch.Rules != nil {
+		for _, rule := range *openSearch.Rules {
+			rows = append(rows, output.DetailRow{Title: fmt.Sprintf("Index %s:", rule.Index), Value: string(rule.Permission)})
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,152 @@
+package databaseuser
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/pflag"
+)
+
+var (
+	authenticationTypes = []string{
+		string(upcloud.ManagedDatabaseUserAuthenticationCachingSHA2Password),
+		string(upcloud.ManagedDatabaseUserAuthenticationMySQLNativePassword),
+	}
+	openSearchPermissions = []string{"admin", "deny", "read", "readwrite", "write"}
+)
+
+// BaseUserCommand creates the base "database user" command
+func BaseUserCommand() commands.Command {
+	return &databaseUserCommand{
+		commands.New("user", "Manage database users"),
+	}
+}
+
+type databaseUserCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (k *databaseUserCommand) InitCommand() {
+	k.Cobra().Aliases = []string{"users"}
+}
+
+// readPassword reads password from given reader. Trailing newlines are removed from the password.
+func readPassword(stdin io.Reader) (string, error) {
+	data, err := io.ReadAll(stdin)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password from standard input: %w", err)
+	}
+
+	password := strings.TrimRight(string(data), "\r\n")
+	if password == "" {
+		return "", fmt.Errorf("password must not be empty")
+	}
+
+	return password, nil
+}
+
+// accessControlParams contains the access control flags shared by user create and modify commands
+type accessControlParams struct {
+	allowReplication config.OptionalBoolean
+	valkeyCategories []string
+	valkeyChannels   []string
+	valkeyCommands   []string
+	valkeyKeys       []string
+	openSearchRules  []string
+}
+
+func (p *accessControlParams) addFlags(fs *pflag.FlagSet) {
+	config.AddToggleFlag(fs, &p.allowReplication, "allow-replication", false, "Allow the user to replicate the database. Only supported for PostgreSQL databases.")
+	fs.StringSliceVar(&p.valkeyCategories, "valkey-categories", nil, "Command categories the user is allowed to use, for example `+@set`. Only supported for Valkey databases.")
+	fs.StringSliceVar(&p.valkeyChannels, "valkey-channels", nil, "Glob-style patterns of the Pub/Sub channels the user is allowed to access. Only supported for Valkey databases.")
+	fs.StringSliceVar(&p.valkeyCommands, "valkey-commands", nil, "Commands the user is allowed to use, for example `+set`. Only supported for Valkey databases.")
+	fs.StringSliceVar(&p.valkeyKeys, "valkey-keys", nil, "Glob-style patterns of the keys the user is allowed to access. Only supported for Valkey databases.")
+	fs.StringArrayVar(&p.openSearchRules, "opensearch-rule", nil, "Access rule in `index=permission` format, multiple can be declared. Index can contain `*` wildcards. Permission is one of: "+strings.Join(openSearchPermissions, ", ")+". Only supported for OpenSearch databases.")
+	for _, name := range []string{"valkey-categories", "valkey-channels", "valkey-commands", "valkey-keys", "opensearch-rule"} {
+		commands.Must(fs.SetAnnotation(name, commands.FlagAnnotationNoFileCompletions, nil))
+	}
+}
+
+// accessControl contains the access control settings for each database type. Nil values are not modified.
+type accessControl struct {
+	pg         *upcloud.ManagedDatabaseUserPGAccessControl
+	valkey     *upcloud.ManagedDatabaseUserValkeyAccessControl
+	openSearch *upcloud.ManagedDatabaseUserOpenSearchAccessControl
+}
+
+func (a accessControl) isEmpty() bool {
+	return a.pg == nil && a.valkey == nil && a.openSearch == nil
+}
+
+// processAccessControl builds access control settings from the flags that were set. Returns an error, if flags for other database types than dbType were set.
+func (p *accessControlParams) processAccessControl(fs *pflag.FlagSet, dbType upcloud.ManagedDatabaseServiceType) (accessControl, error) {
+	ac := accessControl{}
+
+	if fs.Changed("allow-replication") {
+		if dbType != upcloud.ManagedDatabaseServiceTypePostgreSQL {
+			return ac, fmt.Errorf("--allow-replication is only supported for PostgreSQL databases")
+		}
+		ac.pg = &upcloud.ManagedDatabaseUserPGAccessControl{
+			AllowReplication: upcloud.BoolPtr(p.allowReplication.Value()),
+		}
+	}
+
+	valkey := upcloud.ManagedDatabaseUserValkeyAccessControl{}
+	for _, f := range []struct {
+		name   string
+		value  []string
+		target **[]string
+	}{
+		{"valkey-categories", p.valkeyCategories, &valkey.Categories},
+		{"valkey-channels", p.valkeyChannels, &valkey.Channels},
+		{"valkey-commands", p.valkeyCommands, &valkey.Commands},
+		{"valkey-keys", p.valkeyKeys, &valkey.Keys},
+	} {
+		if !fs.Changed(f.name) {
+			continue
+		}
+		if dbType != upcloud.ManagedDatabaseServiceTypeValkey {
+			return ac, fmt.Errorf("--%s is only supported for Valkey databases", f.name)
+		}
+		values := f.value
+		*f.target = &values
+		ac.valkey = &valkey
+	}
+
+	if fs.Changed("opensearch-rule") {
+		if dbType != upcloud.ManagedDatabaseServiceTypeOpenSearch {
+			return ac, fmt.Errorf("--opensearch-rule is only supported for OpenSearch databases")
+		}
+		rules, err := processOpenSearchRules(p.openSearchRules)
+		if err != nil {
+			return ac, err
+		}
+		ac.openSearch = &upcloud.ManagedDatabaseUserOpenSearchAccessControl{Rules: &rules}
+	}
+
+	return ac, nil
+}
+
+func processOpenSearchRules(in []string) ([]upcloud.ManagedDatabaseUserOpenSearchAccessControlRule, error) {
+	rules := make([]upcloud.ManagedDatabaseUserOpenSearchAccessControlRule, 0)
+	for _, v := range in {
+		index, permission, ok := strings.Cut(v, "=")
+		if !ok || index == "" {
+			return nil, fmt.Errorf("invalid OpenSearch rule %q, must be in index=permission format", v)
+		}
+		if !slices.Contains(openSearchPermissions, permission) {
+			return nil, fmt.Errorf("invalid permission %q of OpenSearch rule %s, must be one of: %s", permission, index, strings.Join(openSearchPermissions, ", "))
+		}
+		rules = append(rules, upcloud.ManagedDatabaseUserOpenSearchAccessControlRule{
+			Index:      index,
+			Permission: upcloud.ManagedDatabaseUserOpenSearchAccessControlRulePermission(permission),
+		})
+	}
+	return rules, nil
+}
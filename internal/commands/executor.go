@@ -6,6 +6,8 @@ import (
 	"log/slog"
 	"os"
 	"os/signal"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/UpCloudLtd/progress"
@@ -26,6 +28,7 @@ type Executor interface {
 	PushProgressUpdateMessage(key, msg string)
 	PushProgressSuccess(msg string)
 	StopProgressLog()
+	StopInterruptHandler()
 	WaitFor(waitFn func() error, timeout time.Duration) error
 	Server() service.Server
 	Storage() service.Storage
@@ -48,11 +51,18 @@ type executeResult struct {
 }
 
 type executorImpl struct {
-	Config     *config.Config
-	progress   *progress.Progress
-	service    internal.AllServices
-	logger     *slog.Logger
-	sigIntChan chan os.Signal
+	Config       *config.Config
+	progress     *progress.Progress
+	progressStop *progressStopState
+	service      internal.AllServices
+	logger       *slog.Logger
+	sigIntChan   chan os.Signal
+}
+
+// progressStopState tracks whether the progress log has been stopped. It is shared between the copies of the executor that use the same progress log.
+type progressStopState struct {
+	once    sync.Once
+	stopped atomic.Bool
 }
 
 func (e executorImpl) WithLogger(args ...any) Executor {
@@ -62,6 +72,7 @@ func (e executorImpl) WithLogger(args ...any) Executor {
 
 func (e executorImpl) WithProgress(progress *progress.Progress) Executor {
 	e.progress = progress
+	e.progressStop = &progressStopState{}
 	return &e
 }
 
@@ -88,6 +99,12 @@ func (e *executorImpl) WaitFor(waitFn func() error, timeout time.Duration) error
 }
 
 func (e *executorImpl) PushProgressUpdate(update messages.Update) {
+	// Commands that hand the terminal over to an external program stop the progress log before the command returns. Updates pushed after that, e.g. errors of the external program, are not rendered.
+	if e.progressStop.stopped.Load() {
+		e.Debug("progress log stopped, skipping progress update", "message", update.Message)
+		return
+	}
+
 	err := e.progress.Push(update)
 	if err != nil {
 		e.Debug(fmt.Sprintf("Failed to push progress update: %s", err.Error()))
@@ -115,9 +132,18 @@ func (e *executorImpl) PushProgressSuccess(key string) {
 	})
 }
 
+// StopProgressLog stops the progress log and the interrupt signal handler of the executor. Calling it more than once is safe, as calling progress.Stop() multiple times would panic.
 func (e *executorImpl) StopProgressLog() {
-	signal.Stop(e.sigIntChan) // prevent progress.Stop() from being called multiple times and panicking
-	e.progress.Stop()
+	e.progressStop.once.Do(func() {
+		e.progressStop.stopped.Store(true)
+		e.StopInterruptHandler()
+		e.progress.Stop()
+	})
+}
+
+// StopInterruptHandler stops the interrupt signal handler of the executor, which cancels the context and exits immediately. Commands that need to clean up after an interrupt handle the signal themselves after calling this.
+func (e *executorImpl) StopInterruptHandler() {
+	signal.Stop(e.sigIntChan)
 }
 
 func (e executorImpl) Server() service.Server {
@@ -155,11 +181,12 @@ func (e executorImpl) All() internal.AllServices {
 // NewExecutor creates the default Executor
 func NewExecutor(cfg *config.Config, svc internal.AllServices, logger *slog.Logger) Executor {
 	executor := &executorImpl{
-		Config:     cfg,
-		progress:   progress.NewProgress(config.GetProgressOutputConfig(cfg)),
-		logger:     logger,
-		service:    svc,
-		sigIntChan: make(chan os.Signal, 1),
+		Config:       cfg,
+		progress:     progress.NewProgress(config.GetProgressOutputConfig(cfg)),
+		progressStop: &progressStopState{},
+		logger:       logger,
+		service:      svc,
+		sigIntChan:   make(chan os.Signal, 1),
 	}
 	executor.progress.Start()
 
@@ -0,0 +1,141 @@
+package filestorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "file-storage create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a file storage service",
+			"upctl file-storage create --name my-file-storage --zone fi-hel1 --size 250 --network my-private-network",
+			"upctl file-storage create --name my-file-storage --zone fi-hel1 --size 250 --network my-private-network --ip-address 10.0.0.10 --label env=dev --wait",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	networkParams
+
+	name    string
+	zone    string
+	size    int
+	labels  []string
+	stopped config.OptionalBoolean
+	wait    config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the file storage service.")
+	fs.StringVar(&s.zone, "zone", "", namedargs.ZoneDescription("file storage service"))
+	fs.IntVar(&s.size, "size", 0, "Size of the file storage service in gigabytes.")
+	s.networkParams.addFlags(fs)
+	fs.StringArrayVar(&s.labels, "label", nil, "Labels to describe the file storage service in `key=value` format, multiple can be declared.")
+	config.AddToggleFlag(fs, &s.stopped, "stopped", false, "Create the file storage service in stopped state.")
+	config.AddToggleFlag(fs, &s.wait, "wait", false, "Wait for file storage service to be in running state before returning.")
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("size", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("label", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("zone"))
+	commands.Must(s.Cobra().MarkFlagRequired("size"))
+}
+
+func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("zone", namedargs.CompletionFunc(completion.Zone{}, cfg)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("network", namedargs.CompletionFunc(completion.Network{}, cfg)))
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	msg := fmt.Sprintf("Creating file storage service %s", s.name)
+	exec.PushProgressStarted(msg)
+
+	req := request.CreateFileStorageRequest{
+		Name:             s.name,
+		Zone:             s.zone,
+		SizeGiB:          s.size,
+		ConfiguredStatus: upcloud.FileStorageConfiguredStatusStarted,
+	}
+
+	if s.stopped.Value() {
+		req.ConfiguredStatus = upcloud.FileStorageConfiguredStatusStopped
+	}
+
+	network, err := s.processNetwork(exec, s.name)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+	if network != nil {
+		req.Networks = []upcloud.FileStorageNetwork{*network}
+	}
+
+	if len(s.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(s.labels)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		req.Labels = labelSlice
+	}
+
+	res, err := exec.All().CreateFileStorage(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if s.wait.Value() {
+		waitForFileStorageState(res.UUID, upcloud.FileStorageOperationalStateRunning, exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "UUID", Value: res.UUID, Colour: ui.DefaultUUUIDColours},
+	}}, nil
+}
+
+// waitForFileStorageState waits for file storage service to reach given state and updates progress message with key matching given msg. Finally, progress message is updated back to given msg and either done state or timeout warning.
+func waitForFileStorageState(uuid string, state upcloud.FileStorageOperationalState, exec commands.Executor, msg string) {
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for file storage service %s to be in %s state", uuid, state))
+
+	ctx, cancel := context.WithTimeout(exec.Context(), 15*time.Minute)
+	defer cancel()
+
+	if _, err := exec.All().WaitForFileStorageOperationalState(ctx, &request.WaitForFileStorageOperationalStateRequest{
+		UUID:         uuid,
+		DesiredState: state,
+	}); err != nil {
+		exec.PushProgressUpdate(messages.Update{
+			Key:     msg,
+			Message: msg,
+			Status:  messages.MessageStatusWarning,
+			Details: "Error: " + err.Error(),
+		})
+		return
+	}
+
+	exec.PushProgressUpdateMessage(msg, msg)
+	exec.PushProgressSuccess(msg)
+}
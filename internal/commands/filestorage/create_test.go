@@ -0,0 +1,90 @@
+package filestorage
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	networks := &upcloud.Networks{Networks: []upcloud.Network{
+		{Name: "my-private-network", UUID: "03fc6b80-9039-4bb7-ae43-5ccbe0ae35ce"},
+	}}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateFileStorageRequest
+		errorMsg string
+	}{
+		{
+			name: "without network",
+			args: []string{"--name", "nfs", "--zone", "fi-hel1", "--size", "250"},
+			expected: request.CreateFileStorageRequest{
+				Name:             "nfs",
+				Zone:             "fi-hel1",
+				SizeGiB:          250,
+				ConfiguredStatus: upcloud.FileStorageConfiguredStatusStarted,
+			},
+		},
+		{
+			name: "with network",
+			args: []string{"--name", "nfs", "--zone", "fi-hel1", "--size", "250", "--network", "my-private-network", "--ip-address", "10.0.0.10", "--label", "env=test", "--stopped"},
+			expected: request.CreateFileStorageRequest{
+				Name:             "nfs",
+				Zone:             "fi-hel1",
+				SizeGiB:          250,
+				ConfiguredStatus: upcloud.FileStorageConfiguredStatusStopped,
+				Networks: []upcloud.FileStorageNetwork{{
+					UUID:      "03fc6b80-9039-4bb7-ae43-5ccbe0ae35ce",
+					Name:      "nfs-network",
+					Family:    upcloud.IPAddressFamilyIPv4,
+					IPAddress: "10.0.0.10",
+				}},
+				Labels: []upcloud.Label{{Key: "env", Value: "test"}},
+			},
+		},
+		{
+			name:     "ip address without network",
+			args:     []string{"--name", "nfs", "--zone", "fi-hel1", "--size", "250", "--ip-address", "10.0.0.10"},
+			errorMsg: "--network-name and --ip-address can only be used together with --network",
+		},
+		{
+			name:     "unknown network",
+			args:     []string{"--name", "nfs", "--zone", "fi-hel1", "--size", "250", "--network", "other-network"},
+			errorMsg: "could not resolve network: nothing found matching 'other-network'",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := CreateCommand()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("GetNetworks").Return(networks, nil)
+			mService.On("CreateFileStorage", &expected).Return(&upcloud.FileStorage{UUID: "0a8b6f2e-5c2a-4f3b-9a55-2d0c2bc3d5e1"}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, conf)
+
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				mService.AssertNotCalled(t, "CreateFileStorage", mock.Anything)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateFileStorage", 1)
+			}
+		})
+	}
+}
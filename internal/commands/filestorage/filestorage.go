@@ -1,9 +1,16 @@
 package filestorage
 
 import (
+	"fmt"
+
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/pflag"
 )
 
+var configuredStatuses = []string{string(upcloud.FileStorageConfiguredStatusStarted), string(upcloud.FileStorageConfiguredStatusStopped)}
+
 // BaseFileStorageCommand creates the base "file-storage" command
 func BaseFileStorageCommand() commands.Command {
 	baseCmd := commands.New("file-storage", "Manage file storage services")
@@ -21,3 +28,45 @@ type filestorageCommand struct {
 func (c *filestorageCommand) InitCommand() {
 	c.Cobra().Aliases = []string{"nfs", "filestorage"}
 }
+
+// networkParams contains the network attachment flags shared by file storage create and modify commands
+type networkParams struct {
+	network   string
+	name      string
+	ipAddress string
+}
+
+func (p *networkParams) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.network, "network", "", "Private network to attach the file storage service to, specified by network UUID or name. The shares are served to the clients in this network.")
+	fs.StringVar(&p.name, "network-name", "", "Name of the network attachment. Defaults to `<service-name>-network`, if not defined.")
+	fs.StringVar(&p.ipAddress, "ip-address", "", "IP address of the file storage service in the attached network. Allocated automatically, if not defined.")
+	commands.Must(fs.SetAnnotation("network-name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("ip-address", commands.FlagAnnotationNoFileCompletions, nil))
+}
+
+// processNetwork resolves the network attachment defined with the network flags. Returns nil, if no network was defined.
+func (p *networkParams) processNetwork(exec commands.Executor, serviceName string) (*upcloud.FileStorageNetwork, error) {
+	if p.network == "" {
+		if p.name != "" || p.ipAddress != "" {
+			return nil, fmt.Errorf("--network-name and --ip-address can only be used together with --network")
+		}
+		return nil, nil
+	}
+
+	networkUUID, err := namedargs.ResolveNetwork(exec, p.network)
+	if err != nil {
+		return nil, err
+	}
+
+	name := p.name
+	if name == "" {
+		name = fmt.Sprintf("%s-network", serviceName)
+	}
+
+	return &upcloud.FileStorageNetwork{
+		UUID:      networkUUID,
+		Name:      name,
+		Family:    upcloud.IPAddressFamilyIPv4,
+		IPAddress: p.ipAddress,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package filestorage
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "file-storage modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a file storage service",
+			"upctl file-storage modify my-file-storage --size 500",
+			"upctl file-storage modify 55199a44-4751-4e27-9394-7c7661910be8 --name my-renamed-file-storage --configured-status stopped",
+			"upctl file-storage modify my-file-storage --network my-other-network --ip-address 10.0.1.10",
+			"upctl file-storage modify my-file-storage --detach-network",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingFileStorage
+	completion.FileStorage
+	networkParams
+
+	name             string
+	size             int
+	configuredStatus string
+	labels           []string
+	detachNetwork    config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "New name for the file storage service.")
+	fs.IntVar(&s.size, "size", 0, "New size of the file storage service in gigabytes. The size can only be increased.")
+	fs.StringVar(&s.configuredStatus, "configured-status", "", "Configured status of the file storage service, `started` or `stopped`.")
+	s.networkParams.addFlags(fs)
+	config.AddToggleFlag(fs, &s.detachNetwork, "detach-network", false, "Detach the file storage service from its network.")
+	fs.StringArrayVar(&s.labels, "label", nil, "Labels to describe the file storage service in `key=value` format, multiple can be declared. If set, all the existing labels will be replaced with provided ones.")
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("size", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("configured-status", commands.FlagAnnotationFixedCompletions, configuredStatuses))
+	commands.Must(fs.SetAnnotation("label", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(fs)
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("network", namedargs.CompletionFunc(completion.Network{}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying file storage service %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	req := request.ModifyFileStorageRequest{
+		UUID: uuid,
+	}
+
+	if s.name != "" {
+		req.Name = &s.name
+	}
+
+	if s.size != 0 {
+		req.SizeGiB = &s.size
+	}
+
+	if s.configuredStatus != "" {
+		if !slices.Contains(configuredStatuses, s.configuredStatus) {
+			return commands.HandleError(exec, msg, fmt.Errorf("invalid configured status %q, must be one of: %s", s.configuredStatus, strings.Join(configuredStatuses, ", ")))
+		}
+		status := upcloud.FileStorageConfiguredStatus(s.configuredStatus)
+		req.ConfiguredStatus = &status
+	}
+
+	if s.detachNetwork.Value() && s.network != "" {
+		return commands.HandleError(exec, msg, fmt.Errorf("--detach-network and --network are mutually exclusive"))
+	}
+
+	if s.detachNetwork.Value() {
+		req.Networks = &[]upcloud.FileStorageNetwork{}
+	} else {
+		name := s.name
+		if name == "" && s.network != "" && s.networkParams.name == "" {
+			current, err := exec.All().GetFileStorage(exec.Context(), &request.GetFileStorageRequest{UUID: uuid})
+			if err != nil {
+				return commands.HandleError(exec, msg, err)
+			}
+			name = current.Name
+		}
+
+		network, err := s.processNetwork(exec, name)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+		if network != nil {
+			req.Networks = &[]upcloud.FileStorageNetwork{*network}
+		}
+	}
+
+	if len(s.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(s.labels)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		req.Labels = &labelSlice
+	}
+
+	res, err := exec.All().ModifyFileStorage(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,79 @@
+package filestorageshare
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "file-storage share create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a share into the specified file storage service",
+			"upctl file-storage share create my-file-storage --name data --path /data --acl 10.0.0.0/24",
+			"upctl file-storage share create my-file-storage --name data --path /data --acl target=10.0.0.0/24,permission=ro --acl name=app,target=10.0.0.10,permission=rw",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingFileStorage
+	completion.FileStorage
+
+	name string
+	path string
+	acl  []string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the share.")
+	fs.StringVar(&s.path, "path", "", "Path of the share in the file storage service. Must start with `/`.")
+	fs.StringArrayVar(&s.acl, "acl", []string{}, aclHelp)
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("path", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("acl", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("path"))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *createCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Creating share %s into file storage service %v", s.name, arg)
+	exec.PushProgressStarted(msg)
+
+	if !strings.HasPrefix(s.path, "/") {
+		return commands.HandleError(exec, msg, fmt.Errorf("invalid path %q, must start with /", s.path))
+	}
+
+	acl, err := processACL(s.acl)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	res, err := exec.All().CreateFileStorageShare(exec.Context(), &request.CreateFileStorageShareRequest{
+		ServiceUUID: arg,
+		Name:        s.name,
+		Path:        s.path,
+		ACL:         acl,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,71 @@
+package filestorageshare
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// DeleteCommand creates the "file-storage share delete" command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a share from the specified file storage service",
+			"upctl file-storage share delete my-file-storage --name data",
+		),
+	}
+}
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	resolver.CachingFileStorage
+	completion.FileStorage
+
+	name string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *deleteCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the share to delete.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *deleteCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.FileStorageShare{FileStorage: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *deleteCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Deleting share %s from file storage service %v", s.name, arg)
+	exec.PushProgressStarted(msg)
+
+	name, err := namedargs.ResolveFileStorageShare(exec, arg, s.name)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	err = exec.All().DeleteFileStorageShare(exec.Context(), &request.DeleteFileStorageShareRequest{
+		ServiceUUID: arg,
+		ShareName:   name,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
@@ -0,0 +1,53 @@
+package filestorageshare
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListCommand creates the "file-storage share list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New("list", "List shares of a file storage service", "upctl file-storage share list my-file-storage"),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingFileStorage
+	completion.FileStorage
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *listCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	shares, err := exec.All().GetFileStorageShares(exec.Context(), &request.GetFileStorageSharesRequest{ServiceUUID: arg})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, share := range shares {
+		rows = append(rows, output.TableRow{
+			share.Name,
+			share.Path,
+			share.ACL,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: shares,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "path", Header: "Path"},
+				{Key: "acl", Header: "Allowed clients", Format: format.FileStorageShareACL},
+			},
+			Rows:         rows,
+			EmptyMessage: "No shares found for this file storage service.",
+		},
+	}, nil
+}
@@ -0,0 +1,94 @@
+package filestorageshare
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "file-storage share modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify the access control list of a file storage share",
+			"upctl file-storage share modify my-file-storage --name data --acl 10.0.0.0/24 --acl target=10.0.0.10,permission=rw",
+			"upctl file-storage share modify my-file-storage --name data --clear-acl",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingFileStorage
+	completion.FileStorage
+
+	name     string
+	acl      []string
+	clearACL config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the share to modify.")
+	fs.StringArrayVar(&s.acl, "acl", []string{}, aclHelp+" If set, all the existing ACL entries will be replaced with provided ones.")
+	config.AddToggleFlag(fs, &s.clearACL, "clear-acl", false, "Remove all ACL entries from the share.")
+	commands.Must(fs.SetAnnotation("acl", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.FileStorageShare{FileStorage: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *modifyCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying share %s of file storage service %v", s.name, arg)
+	exec.PushProgressStarted(msg)
+
+	if s.clearACL.Value() && len(s.acl) > 0 {
+		return commands.HandleError(exec, msg, fmt.Errorf("--clear-acl and --acl are mutually exclusive"))
+	}
+	if !s.clearACL.Value() && len(s.acl) == 0 {
+		return commands.HandleError(exec, msg, fmt.Errorf("either --acl or --clear-acl must be defined"))
+	}
+
+	name, err := namedargs.ResolveFileStorageShare(exec, arg, s.name)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	acl := []upcloud.FileStorageShareACL{}
+	if !s.clearACL.Value() {
+		acl, err = processACL(s.acl)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+
+	res, err := exec.All().ModifyFileStorageShare(exec.Context(), &request.ModifyFileStorageShareRequest{
+		ServiceUUID: arg,
+		ShareName:   name,
+		ACL:         &acl,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
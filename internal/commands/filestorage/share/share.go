@@ -0,0 +1,80 @@
+package filestorageshare
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/pflag"
+)
+
+const aclHelp = "Client allowed to access the share, in IP address or CIDR format, multiple can be declared. Usage: `--acl 10.0.0.0/24` or `--acl name=office,target=10.0.0.0/24,permission=rw`. Permission is either `ro` (read-only) or `rw` (read-write) and defaults to `ro`. Name defaults to `acl-<index>`, if not defined."
+
+var validPermissions = []string{string(upcloud.FileStorageShareACLPermissionReadOnly), string(upcloud.FileStorageShareACLPermissionReadWrite)}
+
+// BaseShareCommand creates the base "file-storage share" command
+func BaseShareCommand() commands.Command {
+	return &shareCommand{
+		commands.New("share", "Manage file storage shares"),
+	}
+}
+
+type shareCommand struct {
+	*commands.BaseCommand
+}
+
+func processACL(in []string) ([]upcloud.FileStorageShareACL, error) {
+	acl := make([]upcloud.FileStorageShareACL, 0)
+	for i, v := range in {
+		entry, err := processACLEntry(v, fmt.Sprintf("acl-%d", i+1))
+		if err != nil {
+			return nil, err
+		}
+		acl = append(acl, entry)
+	}
+	return acl, nil
+}
+
+// processACLEntry parses an ACL entry from either plain IP address or CIDR, or `name=office,target=10.0.0.0/24,permission=rw` formatted string
+func processACLEntry(in, defaultName string) (upcloud.FileStorageShareACL, error) {
+	name := defaultName
+	target := in
+	permission := string(upcloud.FileStorageShareACLPermissionReadOnly)
+	e := upcloud.FileStorageShareACL{}
+
+	if strings.Contains(in, "=") {
+		fs := &pflag.FlagSet{}
+		fs.StringVar(&name, "name", defaultName, "")
+		fs.StringVar(&target, "target", "", "")
+		fs.StringVar(&permission, "permission", permission, "")
+
+		args, err := commands.Parse(in)
+		if err != nil {
+			return e, err
+		}
+
+		err = fs.Parse(args)
+		if err != nil {
+			return e, err
+		}
+	}
+
+	if net.ParseIP(target) == nil {
+		if _, _, err := net.ParseCIDR(target); err != nil {
+			return e, fmt.Errorf("invalid target %q of ACL entry %s, must be an IP address or in CIDR format", target, name)
+		}
+	}
+
+	if !slices.Contains(validPermissions, permission) {
+		return e, fmt.Errorf("invalid permission %q of ACL entry %s, must be one of: %s", permission, name, strings.Join(validPermissions, ", "))
+	}
+
+	return upcloud.FileStorageShareACL{
+		Name:       name,
+		Target:     target,
+		Permission: upcloud.FileStorageShareACLPermission(permission),
+	}, nil
+}
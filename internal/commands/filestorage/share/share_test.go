@@ -0,0 +1,66 @@
+package filestorageshare
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessACLEntry(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		in       string
+		expected upcloud.FileStorageShareACL
+		errorMsg string
+	}{
+		{
+			name: "plain CIDR",
+			in:   "10.0.0.0/24",
+			expected: upcloud.FileStorageShareACL{
+				Name:       "acl-1",
+				Target:     "10.0.0.0/24",
+				Permission: upcloud.FileStorageShareACLPermissionReadOnly,
+			},
+		},
+		{
+			name: "plain IP address",
+			in:   "10.0.0.10",
+			expected: upcloud.FileStorageShareACL{
+				Name:       "acl-1",
+				Target:     "10.0.0.10",
+				Permission: upcloud.FileStorageShareACLPermissionReadOnly,
+			},
+		},
+		{
+			name: "key-value",
+			in:   "name=app,target=10.0.0.10,permission=rw",
+			expected: upcloud.FileStorageShareACL{
+				Name:       "app",
+				Target:     "10.0.0.10",
+				Permission: upcloud.FileStorageShareACLPermissionReadWrite,
+			},
+		},
+		{
+			name:     "invalid target",
+			in:       "my-server",
+			errorMsg: `invalid target "my-server" of ACL entry acl-1, must be an IP address or in CIDR format`,
+		},
+		{
+			name:     "invalid permission",
+			in:       "target=10.0.0.0/24,permission=write",
+			errorMsg: `invalid permission "write" of ACL entry acl-1, must be one of: ro, rw`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			entry, err := processACLEntry(test.in, "acl-1")
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, entry)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package filestorage
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ShowCommand creates the "file-storage show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show file storage service details",
+			"upctl file-storage show 55199a44-4751-4e27-9394-7c7661910be8",
+			"upctl file-storage show my-file-storage",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingFileStorage
+	completion.FileStorage
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *showCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	fileStorage, err := exec.All().GetFileStorage(exec.Context(), &request.GetFileStorageRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	networkRows := []output.TableRow{}
+	for _, network := range fileStorage.Networks {
+		networkRows = append(networkRows, output.TableRow{
+			network.Name,
+			network.UUID,
+			network.Family,
+			network.IPAddress,
+		})
+	}
+
+	shareRows := []output.TableRow{}
+	for _, share := range fileStorage.Shares {
+		shareRows = append(shareRows, output.TableRow{
+			share.Name,
+			share.Path,
+			share.ACL,
+		})
+	}
+
+	combined := output.Combined{
+		output.CombinedSection{
+			Contents: output.Details{
+				Sections: []output.DetailSection{
+					{
+						Title: "Overview:",
+						Rows: []output.DetailRow{
+							{Title: "UUID:", Value: fileStorage.UUID, Colour: ui.DefaultUUUIDColours},
+							{Title: "Name:", Value: fileStorage.Name},
+							{Title: "Zone:", Value: fileStorage.Zone},
+							{Title: "Size (GiB):", Value: fileStorage.SizeGiB},
+							{Title: "Configured status:", Value: fileStorage.ConfiguredStatus, Format: format.FileStorageConfiguredStatus},
+							{Title: "Operational state:", Value: fileStorage.OperationalState, Format: format.FileStorageOperationalState},
+						},
+					},
+				},
+			},
+		},
+		labels.GetLabelsSectionWithResourceType(fileStorage.Labels, "file storage service"),
+		output.CombinedSection{
+			Key:   "networks",
+			Title: "Networks:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+					{Key: "family", Header: "Family"},
+					{Key: "ip_address", Header: "IP address", Colour: ui.DefaultAddressColours, Format: format.PossiblyUnknownString},
+				},
+				Rows:         networkRows,
+				EmptyMessage: "No networks attached to this file storage service.",
+			},
+		},
+		output.CombinedSection{
+			Key:   "shares",
+			Title: "Shares:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "path", Header: "Path"},
+					{Key: "acl", Header: "Allowed clients", Format: format.FileStorageShareACL},
+				},
+				Rows:         shareRows,
+				EmptyMessage: "No shares defined for this file storage service.",
+			},
+		},
+	}
+
+	if mountRows := mountInstructionRows(fileStorage); len(mountRows) > 0 {
+		combined = append(combined, output.CombinedSection{
+			Key:   "mount",
+			Title: "Mounting shares:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "share", Header: "Share"},
+					{Key: "command", Header: "Command"},
+				},
+				Rows: mountRows,
+			},
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value:  fileStorage,
+		Output: combined,
+	}, nil
+}
+
+// mountInstructionRows returns NFS mount commands for each share and network address of the given file storage service
+func mountInstructionRows(fileStorage *upcloud.FileStorage) []output.TableRow {
+	rows := []output.TableRow{}
+	for _, share := range fileStorage.Shares {
+		for _, network := range fileStorage.Networks {
+			if network.IPAddress == "" {
+				continue
+			}
+			rows = append(rows, output.TableRow{
+				share.Name,
+				mountCommand(network.IPAddress, share),
+			})
+		}
+	}
+	return rows
+}
+
+// mountCommand returns the command for mounting given share from given address
+func mountCommand(address string, share upcloud.FileStorageShare) string {
+	mountpoint := "/mnt/" + share.Name
+	return fmt.Sprintf("mkdir -p %[1]s && mount -t nfs4 %[2]s:%[3]s %[1]s", mountpoint, address, share.Path)
+}
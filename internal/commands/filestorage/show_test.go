@@ -0,0 +1,25 @@
+package filestorage
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMountInstructionRows(t *testing.T) {
+	fileStorage := &upcloud.FileStorage{
+		Networks: []upcloud.FileStorageNetwork{
+			{Name: "nfs-network", IPAddress: "10.0.0.10"},
+			{Name: "pending-network"},
+		},
+		Shares: []upcloud.FileStorageShare{
+			{Name: "data", Path: "/data"},
+		},
+	}
+
+	rows := mountInstructionRows(fileStorage)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, "data", rows[0][0])
+	assert.Equal(t, "mkdir -p /mnt/data && mount -t nfs4 10.0.0.10:/data /mnt/data", rows[0][1])
+}
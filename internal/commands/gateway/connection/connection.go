@@ -0,0 +1,93 @@
+package gatewayconnection
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/pflag"
+)
+
+const routeHelp = "Usage: `--%[1]s 10.0.0.0/24` or `--%[1]s name=%[2]s,static-network=10.0.0.0/24`. Name defaults to `%[2]s-<index>`, if not defined."
+
+// BaseConnectionCommand creates the base "gateway connection" command
+func BaseConnectionCommand() commands.Command {
+	return &connectionCommand{
+		commands.New("connection", "Manage gateway VPN connections"),
+	}
+}
+
+type connectionCommand struct {
+	*commands.BaseCommand
+}
+
+// routeParams contains the flags shared by connection create and modify commands
+type routeParams struct {
+	localRoutes  []string
+	remoteRoutes []string
+}
+
+// addFlags adds the route flags to given flag set. The usageSuffix is appended to the usage of the flags.
+func (p *routeParams) addFlags(fs *pflag.FlagSet, usageSuffix string) {
+	fs.StringArrayVar(&p.localRoutes, "local-route", []string{}, "Network on the gateway side of the connection, in CIDR format, multiple can be declared. "+fmt.Sprintf(routeHelp, "local-route", "local")+usageSuffix)
+	fs.StringArrayVar(&p.remoteRoutes, "remote-route", []string{}, "Network on the remote side of the connection, in CIDR format, multiple can be declared. "+fmt.Sprintf(routeHelp, "remote-route", "remote")+usageSuffix)
+	commands.Must(fs.SetAnnotation("local-route", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("remote-route", commands.FlagAnnotationNoFileCompletions, nil))
+}
+
+func (p *routeParams) processRoutes() (local []upcloud.GatewayRoute, remote []upcloud.GatewayRoute, err error) {
+	if local, err = processRoutes(p.localRoutes, "local"); err != nil {
+		return nil, nil, err
+	}
+	if remote, err = processRoutes(p.remoteRoutes, "remote"); err != nil {
+		return nil, nil, err
+	}
+	return local, remote, nil
+}
+
+func processRoutes(in []string, namePrefix string) ([]upcloud.GatewayRoute, error) {
+	routes := make([]upcloud.GatewayRoute, 0)
+	for i, v := range in {
+		route, err := processRoute(v, fmt.Sprintf("%s-%d", namePrefix, i+1))
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// processRoute parses a route from either plain CIDR or `name=office,static-network=10.0.0.0/24` formatted string
+func processRoute(in, defaultName string) (upcloud.GatewayRoute, error) {
+	name := defaultName
+	network := in
+	r := upcloud.GatewayRoute{}
+
+	if strings.Contains(in, "=") {
+		fs := &pflag.FlagSet{}
+		fs.StringVar(&name, "name", defaultName, "")
+		fs.StringVar(&network, "static-network", "", "")
+
+		args, err := commands.ParseN(in, 2)
+		if err != nil {
+			return r, err
+		}
+
+		err = fs.Parse(args)
+		if err != nil {
+			return r, err
+		}
+	}
+
+	if _, _, err := net.ParseCIDR(network); err != nil {
+		return r, fmt.Errorf("invalid static network %q of route %s, must be in CIDR format", network, name)
+	}
+
+	return upcloud.GatewayRoute{
+		Name:          name,
+		Type:          upcloud.GatewayRouteTypeStatic,
+		StaticNetwork: network,
+	}, nil
+}
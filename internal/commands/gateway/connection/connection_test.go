@@ -0,0 +1,66 @@
+package gatewayconnection
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessRoute(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		in       string
+		expected upcloud.GatewayRoute
+		errorMsg string
+	}{
+		{
+			name: "plain CIDR",
+			in:   "10.0.0.0/24",
+			expected: upcloud.GatewayRoute{
+				Name:          "local-1",
+				Type:          upcloud.GatewayRouteTypeStatic,
+				StaticNetwork: "10.0.0.0/24",
+			},
+		},
+		{
+			name: "key-value",
+			in:   "name=office-lan,static-network=192.168.0.0/16",
+			expected: upcloud.GatewayRoute{
+				Name:          "office-lan",
+				Type:          upcloud.GatewayRouteTypeStatic,
+				StaticNetwork: "192.168.0.0/16",
+			},
+		},
+		{
+			name: "key-value without name",
+			in:   "static-network=192.168.0.0/16",
+			expected: upcloud.GatewayRoute{
+				Name:          "local-1",
+				Type:          upcloud.GatewayRouteTypeStatic,
+				StaticNetwork: "192.168.0.0/16",
+			},
+		},
+		{
+			name:     "IP address",
+			in:       "10.0.0.1",
+			errorMsg: `invalid static network "10.0.0.1" of route local-1, must be in CIDR format`,
+		},
+		{
+			name:     "key-value without network",
+			in:       "name=office-lan",
+			errorMsg: `invalid static network "" of route office-lan, must be in CIDR format`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			route, err := processRoute(test.in, "local-1")
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, route)
+			}
+		})
+	}
+}
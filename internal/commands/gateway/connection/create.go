@@ -0,0 +1,80 @@
+package gatewayconnection
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "gateway connection create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a VPN connection into the specified gateway",
+			"upctl gateway connection create my-gateway --name office --local-route 10.0.0.0/24 --remote-route 192.168.0.0/24",
+			"upctl gateway connection create my-gateway --name office --local-route name=sdn,static-network=10.0.0.0/24 --remote-route name=office-lan,static-network=192.168.0.0/24",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	name           string
+	connectionType string
+	routes         routeParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the connection.")
+	fs.StringVar(&s.connectionType, "type", string(upcloud.GatewayConnectionTypeIPSec), "Type of the connection. Only `ipsec` is currently supported.")
+	s.routes.addFlags(fs, "")
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("type", commands.FlagAnnotationFixedCompletions, []string{string(upcloud.GatewayConnectionTypeIPSec)}))
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *createCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Creating connection %s into gateway %v", s.name, arg)
+	exec.PushProgressStarted(msg)
+
+	if s.connectionType != string(upcloud.GatewayConnectionTypeIPSec) {
+		return commands.HandleError(exec, msg, fmt.Errorf("invalid connection type %q, must be %s", s.connectionType, upcloud.GatewayConnectionTypeIPSec))
+	}
+
+	localRoutes, remoteRoutes, err := s.routes.processRoutes()
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	res, err := exec.All().CreateGatewayConnection(exec.Context(), &request.CreateGatewayConnectionRequest{
+		ServiceUUID: arg,
+		Connection: request.GatewayConnection{
+			Name:         s.name,
+			Type:         upcloud.GatewayConnectionType(s.connectionType),
+			LocalRoutes:  localRoutes,
+			RemoteRoutes: remoteRoutes,
+		},
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
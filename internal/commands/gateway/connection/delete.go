@@ -0,0 +1,71 @@
+package gatewayconnection
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// DeleteCommand creates the "gateway connection delete" command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a connection from the specified gateway",
+			"upctl gateway connection delete my-gateway --name office",
+		),
+	}
+}
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	name string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *deleteCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the connection to delete.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *deleteCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.GatewayConnection{Gateway: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *deleteCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Deleting connection %s from gateway %v", s.name, arg)
+	exec.PushProgressStarted(msg)
+
+	name, err := namedargs.ResolveGatewayConnection(exec, arg, s.name)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	err = exec.All().DeleteGatewayConnection(exec.Context(), &request.DeleteGatewayConnectionRequest{
+		ServiceUUID: arg,
+		Name:        name,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
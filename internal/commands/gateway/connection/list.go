@@ -0,0 +1,57 @@
+package gatewayconnection
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListCommand creates the "gateway connection list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New("list", "List connections of a gateway", "upctl gateway connection list my-gateway"),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *listCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	connections, err := exec.All().GetGatewayConnections(exec.Context(), &request.GetGatewayConnectionsRequest{ServiceUUID: arg})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, connection := range connections {
+		rows = append(rows, output.TableRow{
+			connection.Name,
+			connection.Type,
+			connection.LocalRoutes,
+			connection.RemoteRoutes,
+			len(connection.Tunnels),
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: connections,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "type", Header: "Type"},
+				{Key: "local_routes", Header: "Local routes", Format: format.GatewayRoutes},
+				{Key: "remote_routes", Header: "Remote routes", Format: format.GatewayRoutes},
+				{Key: "tunnels", Header: "Tunnels"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No connections found for this gateway.",
+		},
+	}, nil
+}
@@ -0,0 +1,86 @@
+package gatewayconnection
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "gateway connection modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a connection of the specified gateway",
+			"upctl gateway connection modify my-gateway --name office --remote-route 192.168.0.0/24 --remote-route 192.168.1.0/24",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	name   string
+	routes routeParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the connection to modify.")
+	s.routes.addFlags(fs, " If set, all the existing routes of the same side will be replaced with provided ones.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.GatewayConnection{Gateway: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *modifyCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying connection %s of gateway %v", s.name, arg)
+	exec.PushProgressStarted(msg)
+
+	name, err := namedargs.ResolveGatewayConnection(exec, arg, s.name)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	localRoutes, remoteRoutes, err := s.routes.processRoutes()
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	req := request.ModifyGatewayConnectionRequest{
+		ServiceUUID: arg,
+		Name:        name,
+	}
+	if len(localRoutes) > 0 {
+		req.Connection.LocalRoutes = localRoutes
+	}
+	if len(remoteRoutes) > 0 {
+		req.Connection.RemoteRoutes = remoteRoutes
+	}
+
+	res, err := exec.All().ModifyGatewayConnection(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,137 @@
+package gatewayconnection
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ShowCommand creates the "gateway connection show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show gateway connection details",
+			"upctl gateway connection show my-gateway --name office",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	name string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *showCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the connection to show.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *showCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.GatewayConnection{Gateway: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *showCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	name, err := namedargs.ResolveGatewayConnection(exec, arg, s.name)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := exec.All().GetGatewayConnection(exec.Context(), &request.GetGatewayConnectionRequest{
+		ServiceUUID: arg,
+		Name:        name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tunnelRows := []output.TableRow{}
+	for _, tunnel := range connection.Tunnels {
+		tunnelRows = append(tunnelRows, output.TableRow{
+			tunnel.Name,
+			tunnel.LocalAddress.Name,
+			tunnel.RemoteAddress.Address,
+			tunnel.OperationalState,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: connection,
+		Output: output.Combined{
+			output.CombinedSection{
+				Contents: output.Details{
+					Sections: []output.DetailSection{
+						{
+							Title: "Overview:",
+							Rows: []output.DetailRow{
+								{Title: "Name:", Value: connection.Name},
+								{Title: "Type:", Value: connection.Type},
+							},
+						},
+					},
+				},
+			},
+			routesSection("local_routes", "Local routes:", connection.LocalRoutes),
+			routesSection("remote_routes", "Remote routes:", connection.RemoteRoutes),
+			output.CombinedSection{
+				Key:   "tunnels",
+				Title: "Tunnels:",
+				Contents: output.Table{
+					Columns: []output.TableColumn{
+						{Key: "name", Header: "Name"},
+						{Key: "local_address", Header: "Local address"},
+						{Key: "remote_address", Header: "Remote address", Colour: ui.DefaultAddressColours},
+						{Key: "operational_state", Header: "State", Format: format.GatewayTunnelState},
+					},
+					Rows:         tunnelRows,
+					EmptyMessage: fmt.Sprintf("No tunnels found for connection %s.", connection.Name),
+				},
+			},
+		},
+	}, nil
+}
+
+func routesSection(key, title string, routes []upcloud.GatewayRoute) output.CombinedSection {
+	rows := []output.TableRow{}
+	for _, route := range routes {
+		rows = append(rows, output.TableRow{
+			route.Name,
+			route.Type,
+			route.StaticNetwork,
+		})
+	}
+
+	return output.CombinedSection{
+		Key:   key,
+		Title: title,
+		Contents: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "type", Header: "Type"},
+				{Key: "static_network", Header: "Static network", Colour: ui.DefaultAddressColours},
+			},
+			Rows:         rows,
+			EmptyMessage: "No routes defined.",
+		},
+	}
+}
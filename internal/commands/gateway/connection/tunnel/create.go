@@ -0,0 +1,106 @@
+package gatewaytunnel
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "gateway connection tunnel create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a tunnel into the specified gateway connection",
+			"upctl gateway connection tunnel create my-gateway --connection office --name tunnel-1 --local-address public-ip-1 --remote-address 198.51.100.10 --psk-file office.psk",
+			"cat office.psk | upctl gateway connection tunnel create my-gateway --connection office --name tunnel-1 --local-address public-ip-1 --remote-address 198.51.100.10 --psk-file - --phase1-algorithm aes256 --phase1-integrity-algorithm sha256 --phase1-dh-group 14",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection    string
+	name          string
+	localAddress  string
+	remoteAddress string
+	ipsec         ipsecParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Create a tunnel into the specified gateway connection
+
+The tunnel is authenticated with a pre-shared key. The IPsec algorithms, Diffie-Hellman groups, and lifetimes can be defined with the flags below. The IKE version can not be selected, because the API does not have a field for it.`)
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.connection, "connection", "", "Name of the connection to create the tunnel into.")
+	fs.StringVar(&s.name, "name", "", "Name of the tunnel.")
+	fs.StringVar(&s.localAddress, "local-address", "", "Name of the gateway address to use as the local endpoint of the tunnel.")
+	fs.StringVar(&s.remoteAddress, "remote-address", "", "IP address of the remote endpoint of the tunnel.")
+	s.ipsec.addFlags(fs)
+	for _, flag := range []string{"name", "local-address", "remote-address"} {
+		commands.Must(fs.SetAnnotation(flag, commands.FlagAnnotationNoFileCompletions, nil))
+	}
+	s.AddFlags(fs)
+
+	for _, flag := range []string{"connection", "name", "local-address", "remote-address", "psk-file"} {
+		commands.Must(s.Cobra().MarkFlagRequired(flag))
+	}
+}
+
+func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("connection", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.GatewayConnection{Gateway: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *createCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Creating tunnel %s into connection %s of gateway %v", s.name, s.connection, arg)
+	exec.PushProgressStarted(msg)
+
+	if net.ParseIP(s.remoteAddress) == nil {
+		return commands.HandleError(exec, msg, fmt.Errorf("invalid remote address %q, must be an IP address", s.remoteAddress))
+	}
+
+	ipsec, err := s.ipsec.processParams(s.Cobra().InOrStdin())
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	connection, err := namedargs.ResolveGatewayConnection(exec, arg, s.connection)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	res, err := exec.All().CreateGatewayConnectionTunnel(exec.Context(), &request.CreateGatewayConnectionTunnelRequest{
+		ServiceUUID:    arg,
+		ConnectionName: connection,
+		Tunnel: request.GatewayTunnel{
+			Name:          s.name,
+			LocalAddress:  upcloud.GatewayTunnelLocalAddress{Name: s.localAddress},
+			RemoteAddress: upcloud.GatewayTunnelRemoteAddress{Address: s.remoteAddress},
+			IPSec:         ipsec,
+		},
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
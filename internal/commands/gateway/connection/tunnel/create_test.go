@@ -0,0 +1,108 @@
+package gatewaytunnel
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	gatewayUUID := "0aded5c1-c7a3-498a-b9c8-a871611c47a2"
+	pskFile := filepath.Join(t.TempDir(), "office.psk")
+	require.NoError(t, os.WriteFile(pskFile, []byte("file-secret\n"), 0o600))
+
+	baseArgs := []string{gatewayUUID, "--connection", "office", "--name", "tunnel-1", "--local-address", "public-ip-1", "--remote-address", "198.51.100.10"}
+	tunnel := func(ipsec upcloud.GatewayTunnelIPSec) request.CreateGatewayConnectionTunnelRequest {
+		return request.CreateGatewayConnectionTunnelRequest{
+			ServiceUUID:    gatewayUUID,
+			ConnectionName: "office",
+			Tunnel: request.GatewayTunnel{
+				Name:          "tunnel-1",
+				LocalAddress:  upcloud.GatewayTunnelLocalAddress{Name: "public-ip-1"},
+				RemoteAddress: upcloud.GatewayTunnelRemoteAddress{Address: "198.51.100.10"},
+				IPSec:         ipsec,
+			},
+		}
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		stdin    string
+		expected request.CreateGatewayConnectionTunnelRequest
+		errorMsg string
+	}{
+		{
+			name: "psk from file",
+			args: append(baseArgs, "--psk-file", pskFile),
+			expected: tunnel(upcloud.GatewayTunnelIPSec{
+				Authentication: upcloud.GatewayTunnelIPSecAuth{Authentication: upcloud.GatewayTunnelIPSecAuthTypePSK, PSK: "file-secret"},
+			}),
+		},
+		{
+			name:  "psk from stdin with ipsec parameters",
+			args:  append(baseArgs, "--psk-file", "-", "--ike-lifetime", "86400", "--phase1-algorithm", "aes256,aes128", "--phase1-integrity-algorithm", "sha256", "--phase1-dh-group", "14,19", "--phase2-algorithm", "aes256gcm16"),
+			stdin: "stdin-secret\n",
+			expected: tunnel(upcloud.GatewayTunnelIPSec{
+				Authentication:            upcloud.GatewayTunnelIPSecAuth{Authentication: upcloud.GatewayTunnelIPSecAuthTypePSK, PSK: "stdin-secret"},
+				IKELifetime:               86400,
+				Phase1Algorithms:          []upcloud.GatewayIPSecAlgorithm{"aes256", "aes128"},
+				Phase1IntegrityAlgorithms: []upcloud.GatewayIPSecIntegrityAlgorithm{"sha256"},
+				Phase1DHGroupNumbers:      []int{14, 19},
+				Phase2Algorithms:          []upcloud.GatewayIPSecAlgorithm{"aes256gcm16"},
+			}),
+		},
+		{
+			name:     "empty psk",
+			args:     append(baseArgs, "--psk-file", "-"),
+			stdin:    "\n",
+			errorMsg: "pre-shared key must not be empty",
+		},
+		{
+			name:     "invalid algorithm",
+			args:     append(baseArgs, "--psk-file", pskFile, "--phase2-integrity-algorithm", "md5"),
+			errorMsg: `invalid phase2-integrity-algorithm "md5", must be one of: sha1, sha256, sha384, sha512, aes128gmac, aes256gmac`,
+		},
+		{
+			name:     "invalid remote address",
+			args:     []string{gatewayUUID, "--connection", "office", "--name", "tunnel-1", "--local-address", "public-ip-1", "--remote-address", "vpn.example.com", "--psk-file", pskFile},
+			errorMsg: `invalid remote address "vpn.example.com", must be an IP address`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := CreateCommand()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("GetGatewayConnections", &request.GetGatewayConnectionsRequest{ServiceUUID: gatewayUUID}).Return([]upcloud.GatewayConnection{{Name: "office"}}, nil)
+			mService.On("CreateGatewayConnectionTunnel", &expected).Return(&upcloud.GatewayTunnel{Name: "tunnel-1"}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, conf)
+
+			c.Cobra().SetIn(strings.NewReader(test.stdin))
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				mService.AssertNotCalled(t, "CreateGatewayConnectionTunnel", mock.Anything)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateGatewayConnectionTunnel", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package gatewaytunnel
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// DeleteCommand creates the "gateway connection tunnel delete" command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a tunnel from the specified gateway connection",
+			"upctl gateway connection tunnel delete my-gateway --connection office --name tunnel-1",
+		),
+	}
+}
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection string
+	name       string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *deleteCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.connection, "connection", "", "Name of the connection the tunnel belongs to.")
+	fs.StringVar(&s.name, "name", "", "Name of the tunnel to delete.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("connection"))
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *deleteCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("connection", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.GatewayConnection{Gateway: args[0]}
+	}, cfg)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.GatewayConnectionTunnel{Gateway: args[0], Connection: s.connection}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *deleteCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Deleting tunnel %s from connection %s of gateway %v", s.name, s.connection, arg)
+	exec.PushProgressStarted(msg)
+
+	connection, err := namedargs.ResolveGatewayConnection(exec, arg, s.connection)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	name, err := namedargs.ResolveGatewayConnectionTunnel(exec, arg, connection, s.name)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	err = exec.All().DeleteGatewayConnectionTunnel(exec.Context(), &request.DeleteGatewayConnectionTunnelRequest{
+		ServiceUUID:    arg,
+		ConnectionName: connection,
+		Name:           name,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
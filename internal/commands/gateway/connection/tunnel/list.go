@@ -0,0 +1,84 @@
+package gatewaytunnel
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ListCommand creates the "gateway connection tunnel list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New("list", "List tunnels of a gateway connection", "upctl gateway connection tunnel list my-gateway --connection office"),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *listCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.connection, "connection", "", "Name of the connection to list the tunnels of.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("connection"))
+}
+
+func (s *listCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("connection", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.GatewayConnection{Gateway: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *listCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	connection, err := namedargs.ResolveGatewayConnection(exec, arg, s.connection)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnels, err := exec.All().GetGatewayConnectionTunnels(exec.Context(), &request.GetGatewayConnectionTunnelsRequest{
+		ServiceUUID:    arg,
+		ConnectionName: connection,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, tunnel := range tunnels {
+		rows = append(rows, output.TableRow{
+			tunnel.Name,
+			tunnel.LocalAddress.Name,
+			tunnel.RemoteAddress.Address,
+			tunnel.OperationalState,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: tunnels,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "local_address", Header: "Local address"},
+				{Key: "remote_address", Header: "Remote address", Colour: ui.DefaultAddressColours},
+				{Key: "operational_state", Header: "State", Format: format.GatewayTunnelState},
+			},
+			Rows:         rows,
+			EmptyMessage: "No tunnels found for this connection.",
+		},
+	}, nil
+}
@@ -0,0 +1,116 @@
+package gatewaytunnel
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ShowCommand creates the "gateway connection tunnel show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show gateway connection tunnel details",
+			"upctl gateway connection tunnel show my-gateway --connection office --name tunnel-1",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	connection string
+	name       string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *showCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.connection, "connection", "", "Name of the connection the tunnel belongs to.")
+	fs.StringVar(&s.name, "name", "", "Name of the tunnel to show.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("connection"))
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *showCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("connection", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.GatewayConnection{Gateway: args[0]}
+	}, cfg)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.GatewayConnectionTunnel{Gateway: args[0], Connection: s.connection}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *showCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	connection, err := namedargs.ResolveGatewayConnection(exec, arg, s.connection)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := namedargs.ResolveGatewayConnectionTunnel(exec, arg, connection, s.name)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnel, err := exec.All().GetGatewayConnectionTunnel(exec.Context(), &request.GetGatewayConnectionTunnelRequest{
+		ServiceUUID:    arg,
+		ConnectionName: connection,
+		Name:           name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ipsec := tunnel.IPSec
+	return output.MarshaledWithHumanOutput{
+		Value: tunnel,
+		Output: output.Combined{
+			output.CombinedSection{
+				Contents: output.Details{
+					Sections: []output.DetailSection{
+						{
+							Title: "Overview:",
+							Rows: []output.DetailRow{
+								{Title: "Name:", Value: tunnel.Name},
+								{Title: "Connection:", Value: connection},
+								{Title: "Local address:", Value: tunnel.LocalAddress.Name},
+								{Title: "Remote address:", Value: tunnel.RemoteAddress.Address, Colour: ui.DefaultAddressColours},
+								{Title: "Operational state:", Value: tunnel.OperationalState, Format: format.GatewayTunnelState},
+							},
+						},
+						{
+							Title: "IPsec:",
+							Rows: []output.DetailRow{
+								{Title: "Authentication:", Value: ipsec.Authentication.Authentication},
+								{Title: "IKE lifetime:", Value: ipsec.IKELifetime},
+								{Title: "Rekey time:", Value: ipsec.RekeyTime},
+								{Title: "Child rekey time:", Value: ipsec.ChildRekeyTime},
+								{Title: "DPD delay:", Value: ipsec.DPDDelay},
+								{Title: "DPD timeout:", Value: ipsec.DPDTimeout},
+								{Title: "Phase 1 algorithms:", Value: ipsec.Phase1Algorithms, Format: format.StringSliceAnd},
+								{Title: "Phase 1 integrity algorithms:", Value: ipsec.Phase1IntegrityAlgorithms, Format: format.StringSliceAnd},
+								{Title: "Phase 1 DH groups:", Value: ipsec.Phase1DHGroupNumbers, Format: format.StringSliceAnd},
+								{Title: "Phase 2 algorithms:", Value: ipsec.Phase2Algorithms, Format: format.StringSliceAnd},
+								{Title: "Phase 2 integrity algorithms:", Value: ipsec.Phase2IntegrityAlgorithms, Format: format.StringSliceAnd},
+								{Title: "Phase 2 DH groups:", Value: ipsec.Phase2DHGroupNumbers, Format: format.StringSliceAnd},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
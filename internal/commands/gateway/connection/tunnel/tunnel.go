@@ -0,0 +1,138 @@
+package gatewaytunnel
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/pflag"
+)
+
+var (
+	ipsecAlgorithms          = []string{"aes128", "aes192", "aes256", "aes128gcm16", "aes128gcm128", "aes192gcm16", "aes192gcm128", "aes256gcm16", "aes256gcm128"}
+	ipsecIntegrityAlgorithms = []string{"sha1", "sha256", "sha384", "sha512", "aes128gmac", "aes256gmac"}
+)
+
+// BaseTunnelCommand creates the base "gateway connection tunnel" command
+func BaseTunnelCommand() commands.Command {
+	return &tunnelCommand{
+		commands.New("tunnel", "Manage tunnels of gateway VPN connections"),
+	}
+}
+
+type tunnelCommand struct {
+	*commands.BaseCommand
+}
+
+// ipsecParams contains the flags used to define IPsec properties of a tunnel
+type ipsecParams struct {
+	pskFile                   string
+	ikeLifetime               int
+	rekeyTime                 int
+	childRekeyTime            int
+	dpdDelay                  int
+	dpdTimeout                int
+	phase1Algorithms          []string
+	phase1IntegrityAlgorithms []string
+	phase1DHGroupNumbers      []int
+	phase2Algorithms          []string
+	phase2IntegrityAlgorithms []string
+	phase2DHGroupNumbers      []int
+}
+
+func (p *ipsecParams) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.pskFile, "psk-file", "", "Path to a file containing the pre-shared key used to authenticate the tunnel. Use `-` to read the key from standard input.")
+	fs.IntVar(&p.ikeLifetime, "ike-lifetime", 0, "Maximum IKE SA lifetime in seconds.")
+	fs.IntVar(&p.rekeyTime, "rekey-time", 0, "IKE SA rekey time in seconds.")
+	fs.IntVar(&p.childRekeyTime, "child-rekey-time", 0, "Child SA rekey time in seconds.")
+	fs.IntVar(&p.dpdDelay, "dpd-delay", 0, "Delay before sending Dead Peer Detection packets if no traffic is detected, in seconds.")
+	fs.IntVar(&p.dpdTimeout, "dpd-timeout", 0, "Timeout period for DPD reply before considering the peer to be dead, in seconds.")
+	fs.StringSliceVar(&p.phase1Algorithms, "phase1-algorithm", nil, "Encryption algorithm(s) allowed in phase 1 (IKE SA): "+strings.Join(ipsecAlgorithms, ", ")+".")
+	fs.StringSliceVar(&p.phase1IntegrityAlgorithms, "phase1-integrity-algorithm", nil, "Integrity algorithm(s) allowed in phase 1 (IKE SA): "+strings.Join(ipsecIntegrityAlgorithms, ", ")+".")
+	fs.IntSliceVar(&p.phase1DHGroupNumbers, "phase1-dh-group", nil, "Diffie-Hellman group number(s) allowed in phase 1 (IKE SA).")
+	fs.StringSliceVar(&p.phase2Algorithms, "phase2-algorithm", nil, "Encryption algorithm(s) allowed in phase 2 (Child SA): "+strings.Join(ipsecAlgorithms, ", ")+".")
+	fs.StringSliceVar(&p.phase2IntegrityAlgorithms, "phase2-integrity-algorithm", nil, "Integrity algorithm(s) allowed in phase 2 (Child SA): "+strings.Join(ipsecIntegrityAlgorithms, ", ")+".")
+	fs.IntSliceVar(&p.phase2DHGroupNumbers, "phase2-dh-group", nil, "Diffie-Hellman group number(s) allowed in phase 2 (Child SA).")
+	commands.Must(fs.SetAnnotation("phase1-algorithm", commands.FlagAnnotationFixedCompletions, ipsecAlgorithms))
+	commands.Must(fs.SetAnnotation("phase1-integrity-algorithm", commands.FlagAnnotationFixedCompletions, ipsecIntegrityAlgorithms))
+	commands.Must(fs.SetAnnotation("phase2-algorithm", commands.FlagAnnotationFixedCompletions, ipsecAlgorithms))
+	commands.Must(fs.SetAnnotation("phase2-integrity-algorithm", commands.FlagAnnotationFixedCompletions, ipsecIntegrityAlgorithms))
+	for _, flag := range []string{"ike-lifetime", "rekey-time", "child-rekey-time", "dpd-delay", "dpd-timeout", "phase1-dh-group", "phase2-dh-group"} {
+		commands.Must(fs.SetAnnotation(flag, commands.FlagAnnotationNoFileCompletions, nil))
+	}
+}
+
+func (p *ipsecParams) processParams(stdin io.Reader) (upcloud.GatewayTunnelIPSec, error) {
+	ipsec := upcloud.GatewayTunnelIPSec{
+		ChildRekeyTime:       p.childRekeyTime,
+		DPDDelay:             p.dpdDelay,
+		DPDTimeout:           p.dpdTimeout,
+		IKELifetime:          p.ikeLifetime,
+		RekeyTime:            p.rekeyTime,
+		Phase1DHGroupNumbers: p.phase1DHGroupNumbers,
+		Phase2DHGroupNumbers: p.phase2DHGroupNumbers,
+	}
+
+	psk, err := readPSK(p.pskFile, stdin)
+	if err != nil {
+		return ipsec, err
+	}
+	ipsec.Authentication = upcloud.GatewayTunnelIPSecAuth{
+		Authentication: upcloud.GatewayTunnelIPSecAuthTypePSK,
+		PSK:            psk,
+	}
+
+	if ipsec.Phase1Algorithms, err = processAlgorithms[upcloud.GatewayIPSecAlgorithm]("phase1-algorithm", p.phase1Algorithms, ipsecAlgorithms); err != nil {
+		return ipsec, err
+	}
+	if ipsec.Phase1IntegrityAlgorithms, err = processAlgorithms[upcloud.GatewayIPSecIntegrityAlgorithm]("phase1-integrity-algorithm", p.phase1IntegrityAlgorithms, ipsecIntegrityAlgorithms); err != nil {
+		return ipsec, err
+	}
+	if ipsec.Phase2Algorithms, err = processAlgorithms[upcloud.GatewayIPSecAlgorithm]("phase2-algorithm", p.phase2Algorithms, ipsecAlgorithms); err != nil {
+		return ipsec, err
+	}
+	if ipsec.Phase2IntegrityAlgorithms, err = processAlgorithms[upcloud.GatewayIPSecIntegrityAlgorithm]("phase2-integrity-algorithm", p.phase2IntegrityAlgorithms, ipsecIntegrityAlgorithms); err != nil {
+		return ipsec, err
+	}
+
+	return ipsec, nil
+}
+
+func processAlgorithms[T ~string](flag string, in, valid []string) ([]T, error) {
+	var algorithms []T
+	for _, v := range in {
+		if !slices.Contains(valid, v) {
+			return nil, fmt.Errorf("invalid %s %q, must be one of: %s", flag, v, strings.Join(valid, ", "))
+		}
+		algorithms = append(algorithms, T(v))
+	}
+	return algorithms, nil
+}
+
+// readPSK reads pre-shared key from given path or from stdin, if path is `-`. Trailing newlines are removed from the key.
+func readPSK(path string, stdin io.Reader) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read pre-shared key from standard input: %w", err)
+		}
+	} else {
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read pre-shared key: %w", err)
+		}
+	}
+
+	psk := strings.TrimRight(string(data), "\r\n")
+	if psk == "" {
+		return "", fmt.Errorf("pre-shared key must not be empty")
+	}
+
+	return psk, nil
+}
@@ -0,0 +1,127 @@
+package gateway
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "gateway create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a gateway",
+			"upctl gateway create --name my-nat-gateway --zone fi-hel1 --router my-router",
+			"upctl gateway create --name my-vpn-gateway --zone fi-hel1 --router my-router --feature vpn --plan advanced --address public-ip-1",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+
+	name      string
+	zone      string
+	plan      string
+	features  []string
+	router    string
+	addresses []string
+	labels    []string
+	stopped   config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Gateway name.")
+	fs.StringVar(&s.zone, "zone", "", namedargs.ZoneDescription("gateway"))
+	fs.StringVar(&s.plan, "plan", "", "Plan to use for the gateway. Run `upctl gateway plans` to list all available plans.")
+	fs.StringSliceVar(&s.features, "feature", []string{string(upcloud.GatewayFeatureNAT)}, "Features to enable for the gateway: "+strings.Join(validFeatures, ", ")+". Multiple can be declared.")
+	fs.StringVar(&s.router, "router", "", "Router to attach the gateway to, specified by router UUID or name.")
+	fs.StringArrayVar(&s.addresses, "address", []string{}, "Name of a public IP address to allocate for the gateway, multiple can be declared. The names are used to refer to the addresses when defining VPN tunnels.")
+	fs.StringArrayVar(&s.labels, "label", nil, "Labels to describe the gateway in `key=value` format, multiple can be declared.")
+	config.AddToggleFlag(fs, &s.stopped, "stopped", false, "Create the gateway in stopped state.")
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("feature", commands.FlagAnnotationFixedCompletions, validFeatures))
+	commands.Must(fs.SetAnnotation("address", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("label", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("zone"))
+	commands.Must(s.Cobra().MarkFlagRequired("router"))
+}
+
+func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("zone", namedargs.CompletionFunc(completion.Zone{}, cfg)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("router", namedargs.CompletionFunc(completion.Router{}, cfg)))
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	msg := fmt.Sprintf("Creating gateway %s", s.name)
+	exec.PushProgressStarted(msg)
+
+	req := request.CreateGatewayRequest{
+		Name:             s.name,
+		Zone:             s.zone,
+		Plan:             s.plan,
+		ConfiguredStatus: upcloud.GatewayConfiguredStatusStarted,
+	}
+
+	if s.stopped.Value() {
+		req.ConfiguredStatus = upcloud.GatewayConfiguredStatusStopped
+	}
+
+	for _, feature := range s.features {
+		if !slices.Contains(validFeatures, feature) {
+			return commands.HandleError(exec, msg, fmt.Errorf("invalid feature %q, must be one of: %s", feature, strings.Join(validFeatures, ", ")))
+		}
+		req.Features = append(req.Features, upcloud.GatewayFeature(feature))
+	}
+
+	if len(s.addresses) > 0 && !slices.Contains(s.features, string(upcloud.GatewayFeatureVPN)) {
+		return commands.HandleError(exec, msg, fmt.Errorf("addresses can only be defined for gateways with %s feature", upcloud.GatewayFeatureVPN))
+	}
+	for _, address := range s.addresses {
+		req.Addresses = append(req.Addresses, request.GatewayAddress{Name: address})
+	}
+
+	routerUUID, err := namedargs.ResolveRouter(exec, s.router)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+	req.Routers = []request.GatewayRouter{{UUID: routerUUID}}
+
+	if len(s.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(s.labels)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		req.Labels = labelSlice
+	}
+
+	res, err := exec.All().CreateGateway(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "UUID", Value: res.UUID, Colour: ui.DefaultUUUIDColours},
+	}}, nil
+}
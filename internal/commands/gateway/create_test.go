@@ -0,0 +1,93 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	routers := &upcloud.Routers{Routers: []upcloud.Router{
+		{Name: "my-router", UUID: "04c0e4d2-5e4b-4ecd-9b9e-1e3b6a6d8b0e"},
+	}}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateGatewayRequest
+		errorMsg string
+	}{
+		{
+			name: "nat gateway",
+			args: []string{"--name", "nat", "--zone", "fi-hel1", "--router", "my-router"},
+			expected: request.CreateGatewayRequest{
+				Name:             "nat",
+				Zone:             "fi-hel1",
+				Features:         []upcloud.GatewayFeature{upcloud.GatewayFeatureNAT},
+				Routers:          []request.GatewayRouter{{UUID: "04c0e4d2-5e4b-4ecd-9b9e-1e3b6a6d8b0e"}},
+				ConfiguredStatus: upcloud.GatewayConfiguredStatusStarted,
+			},
+		},
+		{
+			name: "vpn gateway",
+			args: []string{"--name", "vpn", "--zone", "fi-hel1", "--router", "my-router", "--feature", "vpn", "--plan", "advanced", "--address", "public-ip-1", "--label", "env=test", "--stopped"},
+			expected: request.CreateGatewayRequest{
+				Name:             "vpn",
+				Zone:             "fi-hel1",
+				Plan:             "advanced",
+				Features:         []upcloud.GatewayFeature{upcloud.GatewayFeatureVPN},
+				Routers:          []request.GatewayRouter{{UUID: "04c0e4d2-5e4b-4ecd-9b9e-1e3b6a6d8b0e"}},
+				Addresses:        []request.GatewayAddress{{Name: "public-ip-1"}},
+				Labels:           []upcloud.Label{{Key: "env", Value: "test"}},
+				ConfiguredStatus: upcloud.GatewayConfiguredStatusStopped,
+			},
+		},
+		{
+			name:     "invalid feature",
+			args:     []string{"--name", "nat", "--zone", "fi-hel1", "--router", "my-router", "--feature", "firewall"},
+			errorMsg: `invalid feature "firewall", must be one of: nat, vpn`,
+		},
+		{
+			name:     "address without vpn feature",
+			args:     []string{"--name", "nat", "--zone", "fi-hel1", "--router", "my-router", "--address", "public-ip-1"},
+			errorMsg: "addresses can only be defined for gateways with vpn feature",
+		},
+		{
+			name:     "unknown router",
+			args:     []string{"--name", "nat", "--zone", "fi-hel1", "--router", "other-router"},
+			errorMsg: "could not resolve router: nothing found matching 'other-router'",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := CreateCommand()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("GetRouters").Return(routers, nil)
+			mService.On("CreateGateway", &expected).Return(&upcloud.Gateway{UUID: "0aded5c1-c7a3-498a-b9c8-a871611c47a2"}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, conf)
+
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				mService.AssertNotCalled(t, "CreateGateway", mock.Anything)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateGateway", 1)
+			}
+		})
+	}
+}
@@ -2,6 +2,12 @@ package gateway
 
 import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+)
+
+var (
+	validFeatures      = []string{string(upcloud.GatewayFeatureNAT), string(upcloud.GatewayFeatureVPN)}
+	configuredStatuses = []string{string(upcloud.GatewayConfiguredStatusStarted), string(upcloud.GatewayConfiguredStatusStopped)}
 )
 
 // BaseGatewayCommand creates the base "gateway" command
@@ -0,0 +1,91 @@
+package gateway
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "gateway modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a gateway",
+			"upctl gateway modify my-gateway --name my-renamed-gateway",
+			"upctl gateway modify 8abc8009-4325-4b23-4321-b1232cd81231 --plan production",
+			"upctl gateway modify my-gateway --configured-status stopped",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+
+	name             string
+	plan             string
+	configuredStatus string
+	labels           []string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "New name for the gateway.")
+	fs.StringVar(&s.plan, "plan", "", "Plan to use for the gateway. Run `upctl gateway plans` to list all available plans.")
+	fs.StringVar(&s.configuredStatus, "configured-status", "", "Configured status of the gateway, `started` or `stopped`.")
+	fs.StringArrayVar(&s.labels, "label", nil, "Labels to describe the gateway in `key=value` format, multiple can be declared. If set, all the existing labels will be replaced with provided ones.")
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("plan", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("configured-status", commands.FlagAnnotationFixedCompletions, configuredStatuses))
+	commands.Must(fs.SetAnnotation("label", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(fs)
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying gateway %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	req := request.ModifyGatewayRequest{
+		UUID: uuid,
+		Name: s.name,
+		Plan: s.plan,
+	}
+
+	if s.configuredStatus != "" {
+		if !slices.Contains(configuredStatuses, s.configuredStatus) {
+			return commands.HandleError(exec, msg, fmt.Errorf("invalid configured status %q, must be one of: %s", s.configuredStatus, strings.Join(configuredStatuses, ", ")))
+		}
+		req.ConfiguredStatus = upcloud.GatewayConfiguredStatus(s.configuredStatus)
+	}
+
+	if len(s.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(s.labels)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		req.Labels = &labelSlice
+	}
+
+	res, err := exec.All().ModifyGateway(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
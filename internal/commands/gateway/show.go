@@ -0,0 +1,131 @@
+package gateway
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ShowCommand creates the "gateway show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show gateway details",
+			"upctl gateway show 8abc8009-4325-4b23-4321-b1232cd81231",
+			"upctl gateway show my-gateway",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingGateway
+	completion.Gateway
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *showCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	gateway, err := exec.All().GetGateway(exec.Context(), &request.GetGatewayRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	routerRows := []output.TableRow{}
+	for _, router := range gateway.Routers {
+		routerRows = append(routerRows, output.TableRow{router.UUID})
+	}
+
+	addressRows := []output.TableRow{}
+	for _, address := range gateway.Addresses {
+		addressRows = append(addressRows, output.TableRow{
+			address.Name,
+			address.Address,
+		})
+	}
+
+	connectionRows := []output.TableRow{}
+	for _, connection := range gateway.Connections {
+		connectionRows = append(connectionRows, output.TableRow{
+			connection.Name,
+			connection.Type,
+			connection.LocalRoutes,
+			connection.RemoteRoutes,
+			len(connection.Tunnels),
+		})
+	}
+
+	combined := output.Combined{
+		output.CombinedSection{
+			Contents: output.Details{
+				Sections: []output.DetailSection{
+					{
+						Title: "Overview:",
+						Rows: []output.DetailRow{
+							{Title: "UUID:", Value: gateway.UUID, Colour: ui.DefaultUUUIDColours},
+							{Title: "Name:", Value: gateway.Name},
+							{Title: "Plan:", Value: gateway.Plan},
+							{Title: "Zone:", Value: gateway.Zone},
+							{Title: "Features:", Value: gateway.Features, Format: format.StringSliceAnd},
+							{Title: "Configured status:", Value: gateway.ConfiguredStatus},
+							{Title: "Operational state:", Value: gateway.OperationalState, Format: format.GatewayState},
+						},
+					},
+				},
+			},
+		},
+		labels.GetLabelsSectionWithResourceType(gateway.Labels, "gateway"),
+		output.CombinedSection{
+			Key:   "routers",
+			Title: "Routers:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+				},
+				Rows:         routerRows,
+				EmptyMessage: "No routers attached to this gateway.",
+			},
+		},
+	}
+
+	if len(gateway.Addresses) > 0 {
+		combined = append(combined, output.CombinedSection{
+			Key:   "addresses",
+			Title: "Addresses:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "address", Header: "Address", Colour: ui.DefaultAddressColours},
+				},
+				Rows: addressRows,
+			},
+		})
+	}
+
+	if len(gateway.Connections) > 0 {
+		combined = append(combined, output.CombinedSection{
+			Key:   "connections",
+			Title: "Connections:",
+			Contents: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "type", Header: "Type"},
+					{Key: "local_routes", Header: "Local routes", Format: format.GatewayRoutes},
+					{Key: "remote_routes", Header: "Remote routes", Format: format.GatewayRoutes},
+					{Key: "tunnels", Header: "Tunnels"},
+				},
+				Rows: connectionRows,
+			},
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value:  gateway,
+		Output: combined,
+	}, nil
+}
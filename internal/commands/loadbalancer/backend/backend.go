@@ -0,0 +1,121 @@
+package loadbalancerbackend
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+var validHealthCheckTypes = []string{string(upcloud.LoadBalancerHealthCheckTypeTCP), string(upcloud.LoadBalancerHealthCheckTypeHTTP)}
+
+// BaseBackendCommand creates the base "load-balancer backend" command
+func BaseBackendCommand() commands.Command {
+	return &backendCommand{
+		commands.New("backend", "Manage load balancer backends"),
+	}
+}
+
+type backendCommand struct {
+	*commands.BaseCommand
+}
+
+// BackendPropertiesParams contains the parameters used to define load balancer backend properties
+type BackendPropertiesParams struct {
+	TimeoutServer             int
+	TimeoutTunnel             int
+	HealthCheckType           string
+	HealthCheckInterval       int
+	HealthCheckFall           int
+	HealthCheckRise           int
+	HealthCheckURL            string
+	HealthCheckExpectedStatus int
+	StickySessionCookieName   string
+}
+
+// CreateBackendParams contains the parameters used to define a load balancer backend
+type CreateBackendParams struct {
+	Name     string
+	Resolver string
+	BackendPropertiesParams
+}
+
+func addPropertiesFlags(fs *pflag.FlagSet, p *BackendPropertiesParams) {
+	fs.IntVar(&p.TimeoutServer, "timeout-server", 0, "Backend server inactivity timeout in seconds.")
+	fs.IntVar(&p.TimeoutTunnel, "timeout-tunnel", 0, "Maximum inactivity time in seconds on the client and server side for tunnels.")
+	fs.StringVar(&p.HealthCheckType, "health-check-type", "", "Health check type, `tcp` or `http`.")
+	fs.IntVar(&p.HealthCheckInterval, "health-check-interval", 0, "Interval between health checks in seconds.")
+	fs.IntVar(&p.HealthCheckFall, "health-check-fall", 0, "Number of failed health checks after which the member is considered down.")
+	fs.IntVar(&p.HealthCheckRise, "health-check-rise", 0, "Number of successful health checks after which the member is considered up.")
+	fs.StringVar(&p.HealthCheckURL, "health-check-url", "", "Target path for HTTP health checks.")
+	fs.IntVar(&p.HealthCheckExpectedStatus, "health-check-expected-status", 0, "Expected HTTP status code returned by the member for HTTP health checks.")
+	fs.StringVar(&p.StickySessionCookieName, "sticky-session-cookie-name", "", "Name of the cookie used for sticky sessions. Sticky sessions are disabled if not set.")
+
+	for _, flag := range []string{"timeout-server", "timeout-tunnel", "health-check-interval", "health-check-fall", "health-check-rise", "health-check-url", "health-check-expected-status", "sticky-session-cookie-name"} {
+		commands.Must(fs.SetAnnotation(flag, commands.FlagAnnotationNoFileCompletions, nil))
+	}
+	commands.Must(fs.SetAnnotation("health-check-type", commands.FlagAnnotationFixedCompletions, validHealthCheckTypes))
+}
+
+// GetCreateBackendFlagSet returns the flags used to define a load balancer backend
+func GetCreateBackendFlagSet(p *CreateBackendParams) *pflag.FlagSet {
+	fs := &pflag.FlagSet{}
+
+	fs.StringVar(&p.Name, "name", "", "Backend name.")
+	fs.StringVar(&p.Resolver, "resolver", "", "Name of the resolver to use for dynamic members.")
+	addPropertiesFlags(fs, &p.BackendPropertiesParams)
+
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("resolver", commands.FlagAnnotationNoFileCompletions, nil))
+
+	return fs
+}
+
+// processProperties returns backend properties defined in the given parameters or nil, if no properties were defined.
+func processProperties(p BackendPropertiesParams) (*upcloud.LoadBalancerBackendProperties, error) {
+	if p == (BackendPropertiesParams{}) {
+		return nil, nil
+	}
+
+	if p.HealthCheckType != "" && !slices.Contains(validHealthCheckTypes, p.HealthCheckType) {
+		return nil, fmt.Errorf("invalid health check type %q, must be one of: tcp, http", p.HealthCheckType)
+	}
+
+	return &upcloud.LoadBalancerBackendProperties{
+		TimeoutServer:             p.TimeoutServer,
+		TimeoutTunnel:             p.TimeoutTunnel,
+		HealthCheckType:           upcloud.LoadBalancerHealthCheckType(p.HealthCheckType),
+		HealthCheckInterval:       p.HealthCheckInterval,
+		HealthCheckFall:           p.HealthCheckFall,
+		HealthCheckRise:           p.HealthCheckRise,
+		HealthCheckURL:            p.HealthCheckURL,
+		HealthCheckExpectedStatus: p.HealthCheckExpectedStatus,
+		StickySessionCookieName:   p.StickySessionCookieName,
+	}, nil
+}
+
+// ProcessBackendParams validates the given parameters and converts them to a backend definition
+func ProcessBackendParams(p CreateBackendParams) (request.LoadBalancerBackend, error) {
+	be := request.LoadBalancerBackend{}
+
+	if p.Name == "" {
+		return be, fmt.Errorf("backend name is required")
+	}
+
+	properties, err := processProperties(p.BackendPropertiesParams)
+	if err != nil {
+		return be, err
+	}
+
+	be = request.LoadBalancerBackend{
+		Name:       p.Name,
+		Resolver:   p.Resolver,
+		Members:    []request.LoadBalancerBackendMember{},
+		Properties: properties,
+	}
+
+	return be, nil
+}
@@ -0,0 +1,62 @@
+package loadbalancerbackend
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// CreateCommand creates the "load-balancer backend create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a new backend into the specified load balancer",
+			"upctl load-balancer backend create my-load-balancer --name web",
+			"upctl load-balancer backend create my-load-balancer --name api --health-check-type http --health-check-url /health",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	p CreateBackendParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	fs := GetCreateBackendFlagSet(&s.p)
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *createCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Creating backend %s into load balancer %v", s.p.Name, arg)
+	exec.PushProgressStarted(msg)
+
+	be, err := ProcessBackendParams(s.p)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	res, err := exec.All().CreateLoadBalancerBackend(exec.Context(), &request.CreateLoadBalancerBackendRequest{
+		ServiceUUID: arg,
+		Backend:     be,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,96 @@
+package loadbalancerbackend
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer backend modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a backend of the specified load balancer",
+			"upctl load-balancer backend modify my-load-balancer --name web --new-name www",
+			"upctl load-balancer backend modify my-load-balancer --name api --timeout-server 30",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name         string
+	newName      string
+	resolverName string
+	properties   BackendPropertiesParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the backend to modify.")
+	fs.StringVar(&s.newName, "new-name", "", "New name for the backend.")
+	fs.StringVar(&s.resolverName, "resolver", "", "Name of the resolver to use for dynamic members.")
+	addPropertiesFlags(fs, &s.properties)
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("new-name", cobra.NoFileCompletions))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("resolver", cobra.NoFileCompletions))
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerBackend{LoadBalancer: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *modifyCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying backend %s of load balancer %v", s.name, arg)
+	exec.PushProgressStarted(msg)
+
+	name, err := namedargs.ResolveLoadBalancerBackend(exec, arg, s.name)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	properties, err := processProperties(s.properties)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	be := request.ModifyLoadBalancerBackend{
+		Name:       s.newName,
+		Properties: properties,
+	}
+
+	if s.resolverName != "" {
+		be.Resolver = &s.resolverName
+	}
+
+	res, err := exec.All().ModifyLoadBalancerBackend(exec.Context(), &request.ModifyLoadBalancerBackendRequest{
+		ServiceUUID: arg,
+		Name:        name,
+		Backend:     be,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,45 @@
+package loadbalancercertificatebundle
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+)
+
+var (
+	validTypes = []string{
+		string(upcloud.LoadBalancerCertificateBundleTypeManual),
+		string(upcloud.LoadBalancerCertificateBundleTypeDynamic),
+		string(upcloud.LoadBalancerCertificateBundleTypeAuthority),
+	}
+	validKeyTypes = []string{"rsa", "ecdsa"}
+)
+
+// BaseCertificateBundleCommand creates the base "load-balancer certificate-bundle" command
+func BaseCertificateBundleCommand() commands.Command {
+	return &certificateBundleCommand{
+		commands.New("certificate-bundle", "Manage load balancer certificate bundles"),
+	}
+}
+
+type certificateBundleCommand struct {
+	*commands.BaseCommand
+}
+
+// readPEMFile reads PEM encoded data from given path and returns it base64 encoded, as expected by the API.
+func readPEMFile(path, description string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", description, err)
+	}
+
+	if block, _ := pem.Decode(data); block == nil {
+		return "", fmt.Errorf("%s file %s does not contain PEM encoded data", description, path)
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,144 @@
+package loadbalancercertificatebundle
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "load-balancer certificate-bundle create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a load balancer certificate bundle",
+			"upctl load-balancer certificate-bundle create --name my-certificate --certificate cert.pem --intermediates chain.pem --private-key key.pem",
+			"upctl load-balancer certificate-bundle create --name my-dynamic-certificate --type dynamic --hostname example.com --hostname www.example.com",
+			"upctl load-balancer certificate-bundle create --name my-ca --type authority --certificate ca.pem",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+
+	name          string
+	bundleType    string
+	certificate   string
+	intermediates string
+	privateKey    string
+	hostnames     []string
+	keyType       string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Create a load balancer certificate bundle
+
+Manual certificate bundles contain a certificate, optional intermediate certificates, and a private key that are read from PEM encoded files. Dynamic certificate bundles contain certificates that are automatically issued and renewed with ACME for the given hostnames. Authority certificate bundles contain certificate authority certificates that are used to validate backend member certificates.`)
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Certificate bundle name.")
+	fs.StringVar(&s.bundleType, "type", string(upcloud.LoadBalancerCertificateBundleTypeManual), "Certificate bundle type: "+strings.Join(validTypes, ", "))
+	fs.StringVar(&s.certificate, "certificate", "", "Path to PEM encoded certificate file. Required for manual and authority certificate bundles.")
+	fs.StringVar(&s.intermediates, "intermediates", "", "Path to PEM encoded intermediate certificates file. Only for manual certificate bundles.")
+	fs.StringVar(&s.privateKey, "private-key", "", "Path to PEM encoded private key file. Required for manual certificate bundles.")
+	fs.StringArrayVar(&s.hostnames, "hostname", []string{}, "Hostname to issue the certificate for, multiple can be declared. Required for dynamic certificate bundles.")
+	fs.StringVar(&s.keyType, "key-type", "", "Private key type of dynamic certificate bundle: "+strings.Join(validKeyTypes, ", ")+". Defaults to rsa.")
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("type", commands.FlagAnnotationFixedCompletions, validTypes))
+	commands.Must(fs.SetAnnotation("hostname", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("key-type", commands.FlagAnnotationFixedCompletions, validKeyTypes))
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *createCommand) buildRequest() (*request.CreateLoadBalancerCertificateBundleRequest, error) {
+	req := &request.CreateLoadBalancerCertificateBundleRequest{
+		Type: upcloud.LoadBalancerCertificateBundleType(s.bundleType),
+		Name: s.name,
+	}
+
+	switch req.Type {
+	case upcloud.LoadBalancerCertificateBundleTypeManual:
+		if s.certificate == "" || s.privateKey == "" {
+			return nil, fmt.Errorf("certificate and private-key are required for %s certificate bundles", s.bundleType)
+		}
+		if len(s.hostnames) > 0 || s.keyType != "" {
+			return nil, fmt.Errorf("hostname and key-type can only be used with %s certificate bundles", upcloud.LoadBalancerCertificateBundleTypeDynamic)
+		}
+	case upcloud.LoadBalancerCertificateBundleTypeDynamic:
+		if len(s.hostnames) == 0 {
+			return nil, fmt.Errorf("at least one hostname is required for %s certificate bundles", s.bundleType)
+		}
+		if s.certificate != "" || s.intermediates != "" || s.privateKey != "" {
+			return nil, fmt.Errorf("certificate, intermediates, and private-key can not be used with %s certificate bundles", s.bundleType)
+		}
+		if s.keyType == "" {
+			s.keyType = "rsa"
+		}
+		if !slices.Contains(validKeyTypes, s.keyType) {
+			return nil, fmt.Errorf("invalid key type %q, must be one of: %s", s.keyType, strings.Join(validKeyTypes, ", "))
+		}
+		req.Hostnames = s.hostnames
+		req.KeyType = s.keyType
+	case upcloud.LoadBalancerCertificateBundleTypeAuthority:
+		if s.certificate == "" {
+			return nil, fmt.Errorf("certificate is required for %s certificate bundles", s.bundleType)
+		}
+		if s.intermediates != "" || s.privateKey != "" || len(s.hostnames) > 0 || s.keyType != "" {
+			return nil, fmt.Errorf("only certificate can be defined for %s certificate bundles", s.bundleType)
+		}
+	default:
+		return nil, fmt.Errorf("invalid certificate bundle type %q, must be one of: %s", s.bundleType, strings.Join(validTypes, ", "))
+	}
+
+	var err error
+	if s.certificate != "" {
+		if req.Certificate, err = readPEMFile(s.certificate, "certificate"); err != nil {
+			return nil, err
+		}
+	}
+	if s.intermediates != "" {
+		if req.Intermediates, err = readPEMFile(s.intermediates, "intermediates"); err != nil {
+			return nil, err
+		}
+	}
+	if s.privateKey != "" {
+		if req.PrivateKey, err = readPEMFile(s.privateKey, "private key"); err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	req, err := s.buildRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Creating certificate bundle %s", s.name)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateLoadBalancerCertificateBundle(exec.Context(), req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "UUID", Value: res.UUID, Colour: ui.DefaultUUUIDColours},
+	}}, nil
+}
@@ -0,0 +1,46 @@
+package loadbalancercertificatebundle
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// DeleteCommand creates the "load-balancer certificate-bundle delete" command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a load balancer certificate bundle",
+			"upctl load-balancer certificate-bundle delete 0aded5c1-c7a3-498a-b9c8-a871611c47a2",
+			"upctl load-balancer certificate-bundle delete my-certificate-bundle",
+		),
+	}
+}
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancerCertificateBundle
+	completion.LoadBalancerCertificateBundle
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *deleteCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Deleting certificate bundle %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	err := exec.All().DeleteLoadBalancerCertificateBundle(exec.Context(), &request.DeleteLoadBalancerCertificateBundleRequest{
+		UUID: uuid,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
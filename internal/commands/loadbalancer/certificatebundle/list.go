@@ -0,0 +1,68 @@
+package loadbalancercertificatebundle
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/paging"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ListCommand creates the "load-balancer certificate-bundle list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New("list", "List load balancer certificate bundles", "upctl load-balancer certificate-bundle list"),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	paging.PageParameters
+}
+
+// InitCommand implements Command.InitCommand
+func (s *listCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	s.ConfigureFlags(fs)
+	s.AddFlags(fs)
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	svc := exec.All()
+	bundles, err := svc.GetLoadBalancerCertificateBundles(exec.Context(), &request.GetLoadBalancerCertificateBundlesRequest{
+		Page: s.Page(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, bundle := range bundles {
+		rows = append(rows, output.TableRow{
+			bundle.UUID,
+			bundle.Name,
+			bundle.Type,
+			bundle.Hostnames,
+			bundle.NotAfter,
+			bundle.OperationalState,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: bundles,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+				{Key: "name", Header: "Name"},
+				{Key: "type", Header: "Type"},
+				{Key: "hostnames", Header: "Hostnames", Format: format.StringSliceAnd},
+				{Key: "not_after", Header: "Expires", Format: format.LoadBalancerCertificateExpiry},
+				{Key: "operational_state", Header: "State"},
+			},
+			Rows: rows,
+		},
+	}, nil
+}
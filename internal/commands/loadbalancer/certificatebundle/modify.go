@@ -0,0 +1,94 @@
+package loadbalancercertificatebundle
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer certificate-bundle modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a load balancer certificate bundle",
+			"upctl load-balancer certificate-bundle modify my-certificate --certificate cert.pem --intermediates chain.pem --private-key key.pem",
+			"upctl load-balancer certificate-bundle modify my-dynamic-certificate --hostname example.com --hostname api.example.com",
+			"upctl load-balancer certificate-bundle modify my-ca --name my-renamed-ca",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancerCertificateBundle
+	completion.LoadBalancerCertificateBundle
+
+	name          string
+	certificate   string
+	intermediates string
+	privateKey    string
+	hostnames     []string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "New name for the certificate bundle.")
+	fs.StringVar(&s.certificate, "certificate", "", "Path to PEM encoded certificate file. Only for manual and authority certificate bundles.")
+	fs.StringVar(&s.intermediates, "intermediates", "", "Path to PEM encoded intermediate certificates file. Only for manual certificate bundles.")
+	fs.StringVar(&s.privateKey, "private-key", "", "Path to PEM encoded private key file. Only for manual certificate bundles.")
+	fs.StringArrayVar(&s.hostnames, "hostname", []string{}, "Hostname to issue the certificate for, multiple can be declared. Replaces the current hostnames. Only for dynamic certificate bundles.")
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("hostname", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(fs)
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying certificate bundle %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	req := &request.ModifyLoadBalancerCertificateBundleRequest{
+		UUID:      uuid,
+		Name:      s.name,
+		Hostnames: s.hostnames,
+	}
+
+	var err error
+	if s.certificate != "" {
+		if req.Certificate, err = readPEMFile(s.certificate, "certificate"); err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+	if s.intermediates != "" {
+		var intermediates string
+		if intermediates, err = readPEMFile(s.intermediates, "intermediates"); err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+		req.Intermediates = upcloud.StringPtr(intermediates)
+	}
+	if s.privateKey != "" {
+		if req.PrivateKey, err = readPEMFile(s.privateKey, "private key"); err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+
+	res, err := exec.All().ModifyLoadBalancerCertificateBundle(exec.Context(), req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "UUID", Value: res.UUID, Colour: ui.DefaultUUUIDColours},
+	}}, nil
+}
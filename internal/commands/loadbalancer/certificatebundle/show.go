@@ -0,0 +1,84 @@
+package loadbalancercertificatebundle
+
+import (
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ShowCommand creates the "load-balancer certificate-bundle show" command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show load balancer certificate bundle details",
+			"upctl load-balancer certificate-bundle show 0aded5c1-c7a3-498a-b9c8-a871611c47a2",
+			"upctl load-balancer certificate-bundle show my-certificate-bundle",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancerCertificateBundle
+	completion.LoadBalancerCertificateBundle
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *showCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.All()
+	bundle, err := svc.GetLoadBalancerCertificateBundle(exec.Context(), &request.GetLoadBalancerCertificateBundleRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.DetailRow{
+		{Title: "UUID:", Key: "uuid", Value: bundle.UUID, Colour: ui.DefaultUUUIDColours},
+		{Title: "Name:", Key: "name", Value: bundle.Name},
+		{Title: "Type:", Key: "type", Value: bundle.Type},
+	}
+	if len(bundle.Hostnames) > 0 {
+		rows = append(rows,
+			output.DetailRow{Title: "Hostnames:", Key: "hostnames", Value: bundle.Hostnames, Format: format.StringSliceAnd},
+		)
+	}
+	if bundle.KeyType != "" {
+		rows = append(rows, output.DetailRow{Title: "Key type:", Key: "key_type", Value: bundle.KeyType})
+	}
+	rows = append(rows,
+		output.DetailRow{Title: "Not before:", Key: "not_before", Value: formatTime(bundle.NotBefore)},
+		output.DetailRow{Title: "Not after:", Key: "not_after", Value: bundle.NotAfter, Format: format.LoadBalancerCertificateExpiry},
+		output.DetailRow{Title: "Operational state:", Key: "operational_state", Value: bundle.OperationalState},
+		output.DetailRow{Title: "Created:", Key: "created_at", Value: formatTime(bundle.CreatedAt)},
+		output.DetailRow{Title: "Updated:", Key: "updated_at", Value: formatTime(bundle.UpdatedAt)},
+	)
+
+	return output.MarshaledWithHumanOutput{
+		Value: bundle,
+		Output: output.Combined{
+			output.CombinedSection{
+				Contents: output.Details{
+					Sections: []output.DetailSection{
+						{
+							Title: "Overview:",
+							Rows:  rows,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
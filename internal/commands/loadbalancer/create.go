@@ -0,0 +1,401 @@
+package loadbalancer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	loadbalancerbackend "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/backend"
+	loadbalancerfrontend "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/frontend"
+	loadbalancermember "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/member"
+	loadbalancerresolver "github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/loadbalancer/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var maintenanceDOWs = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// CreateCommand creates the "load-balancer create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a load balancer",
+			`upctl load-balancer create \
+				--name my-load-balancer \
+				--zone fi-hel1 \
+				--network name=public,type=public \
+				--network name=private,type=private,network=my-network \
+				--backend name=web \
+				--member backend=web,name=web-1,ip=10.0.0.10,port=80 \
+				--member backend=web,name=web-2,ip=10.0.0.11,port=80 \
+				--frontend name=web,port=80,default-backend=web,network=public`,
+			`upctl load-balancer create \
+				--name my-load-balancer \
+				--plan production-small \
+				--zone de-fra1 \
+				--network name=private,type=private,network=03e5ca07-f36c-4957-a676-e001e40441eb \
+				--resolver name=internal,nameserver=10.0.0.2 \
+				--backend name=api,resolver=internal,health-check-type=http,health-check-url=/health \
+				--member backend=api,name=api,type=dynamic \
+				--frontend name=api,mode=tcp,port=8080,default-backend=api,network=private \
+				--wait`,
+		),
+	}
+}
+
+type createParams struct {
+	request.CreateLoadBalancerRequest
+	networks  []string
+	frontends []string
+	backends  []string
+	members   []string
+	resolvers []string
+	labels    []string
+	wait      config.OptionalBoolean
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	params createParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	// Deprecating loadbalancer in favour of load-balancer
+	// TODO: Remove this in the future
+	commands.SetSubcommandDeprecationHelp(s, []string{deprecatedAliasLoadBalancer})
+
+	s.params = createParams{CreateLoadBalancerRequest: request.CreateLoadBalancerRequest{}}
+	fs := &pflag.FlagSet{}
+
+	fs.StringVar(&s.params.Name, "name", "", "Load balancer name.")
+	fs.StringVar(&s.params.Plan, "plan", "development", "Plan to use for the load balancer. Run `upctl load-balancer plans` to list all available plans.")
+	fs.StringVar(&s.params.Zone, "zone", "", namedargs.ZoneDescription("load balancer"))
+	fs.StringArrayVar(
+		&s.params.networks,
+		"network",
+		[]string{},
+		"Network(s) to attach the load balancer to, multiple can be declared.\n"+
+			"Usage: `--network name=public,type=public` or `--network name=private,type=private,network=my-network`\n"+
+			"The network key accepts name or UUID of a private network. Family defaults to IPv4.",
+	)
+	fs.StringArrayVar(
+		&s.params.frontends,
+		"frontend",
+		[]string{},
+		"Frontend(s) to create, multiple can be declared.\n"+
+			"Usage: `--frontend "+
+			"name=web,"+
+			"mode=http,"+
+			"port=443,"+
+			"default-backend=web,"+
+			"network=public,"+
+			"timeout-client=10`",
+	)
+	fs.StringArrayVar(
+		&s.params.backends,
+		"backend",
+		[]string{},
+		"Backend(s) to create, multiple can be declared.\n"+
+			"Usage: `--backend "+
+			"name=web,"+
+			"resolver=internal,"+
+			"timeout-server=10,"+
+			"health-check-type=http,"+
+			"health-check-url=/health`",
+	)
+	fs.StringArrayVar(
+		&s.params.members,
+		"member",
+		[]string{},
+		"Backend member(s) to create, multiple can be declared. The backend key refers to the name of a backend defined with --backend.\n"+
+			"Usage: `--member "+
+			"backend=web,"+
+			"name=web-1,"+
+			"type=static,"+
+			"ip=10.0.0.10,"+
+			"port=80,"+
+			"weight=100,"+
+			"max-sessions=1000`\n"+
+			"Add `disable-member` key to create the member in disabled state.",
+	)
+	fs.StringArrayVar(
+		&s.params.resolvers,
+		"resolver",
+		[]string{},
+		"DNS resolver(s) to create for dynamic backend members, multiple can be declared.\n"+
+			"Usage: `--resolver "+
+			"name=internal,"+
+			"nameserver=10.0.0.2,"+
+			"retries=5,"+
+			"timeout=30,"+
+			"timeout-retry=10,"+
+			"cache-valid=180,"+
+			"cache-invalid=10`",
+	)
+	fs.StringArrayVar(&s.params.labels, "label", nil, "Labels to describe the load balancer in `key=value` format, multiple can be declared.")
+	fs.StringVar((*string)(&s.params.MaintenanceDOW), "maintenance-dow", "", "Day of the week for automatic maintenance, in lower case (e.g. sunday). Set randomly if not provided.")
+	fs.StringVar(&s.params.MaintenanceTime, "maintenance-time", "", "Time of the day for automatic maintenance in UTC, in HH:MM:SS format. Set randomly if not provided.")
+	config.AddToggleFlag(fs, &s.params.wait, "wait", false, "Wait for load balancer to be in running state before returning.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("zone"))
+	commands.Must(s.Cobra().MarkFlagRequired("network"))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("maintenance-dow", cobra.FixedCompletions(maintenanceDOWs, cobra.ShellCompDirectiveNoFileComp)))
+	for _, flag := range []string{"name", "network", "frontend", "backend", "member", "resolver", "label", "maintenance-time"} {
+		commands.Must(s.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("plan", namedargs.CompletionFunc(completion.LoadBalancerPlan{}, cfg)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("zone", namedargs.CompletionFunc(completion.Zone{}, cfg)))
+}
+
+func (p *createParams) processParams(exec commands.Executor) error {
+	networks := make([]request.LoadBalancerNetwork, 0)
+	for _, v := range p.networks {
+		n, err := processNetwork(exec, v)
+		if err != nil {
+			return err
+		}
+		networks = append(networks, n)
+	}
+	p.Networks = networks
+
+	resolvers := make([]request.LoadBalancerResolver, 0)
+	for _, v := range p.resolvers {
+		r, err := processResolver(v)
+		if err != nil {
+			return err
+		}
+		resolvers = append(resolvers, r)
+	}
+	p.Resolvers = resolvers
+
+	backends := make([]request.LoadBalancerBackend, 0)
+	for _, v := range p.backends {
+		be, err := processBackend(v)
+		if err != nil {
+			return err
+		}
+		backends = append(backends, be)
+	}
+
+	for _, v := range p.members {
+		backend, m, err := processMember(v)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for i := range backends {
+			if backends[i].Name == backend {
+				backends[i].Members = append(backends[i].Members, m)
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("backend %q of member %s is not defined", backend, m.Name)
+		}
+	}
+	p.Backends = backends
+
+	frontends := make([]request.LoadBalancerFrontend, 0)
+	for _, v := range p.frontends {
+		fe, err := processFrontend(v)
+		if err != nil {
+			return err
+		}
+		frontends = append(frontends, fe)
+	}
+	p.Frontends = frontends
+
+	if len(p.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(p.labels)
+		if err != nil {
+			return err
+		}
+
+		p.Labels = labelSlice
+	}
+
+	return nil
+}
+
+func processNetwork(exec commands.Executor, in string) (request.LoadBalancerNetwork, error) {
+	var name, networkType, family, network string
+	n := request.LoadBalancerNetwork{}
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&name, "name", "", "")
+	fs.StringVar(&networkType, "type", "", "")
+	fs.StringVar(&family, "family", string(upcloud.LoadBalancerAddressFamilyIPv4), "")
+	fs.StringVar(&network, "network", "", "")
+
+	args, err := commands.ParseN(in, 2)
+	if err != nil {
+		return n, err
+	}
+
+	err = fs.Parse(args)
+	if err != nil {
+		return n, err
+	}
+
+	if name == "" {
+		return n, fmt.Errorf("network name is required")
+	}
+
+	n = request.LoadBalancerNetwork{
+		Name:   name,
+		Type:   upcloud.LoadBalancerNetworkType(networkType),
+		Family: upcloud.LoadBalancerAddressFamily(family),
+	}
+
+	switch n.Type {
+	case upcloud.LoadBalancerNetworkTypePublic:
+		if network != "" {
+			return n, fmt.Errorf("network can not be defined for public network %s", name)
+		}
+	case upcloud.LoadBalancerNetworkTypePrivate:
+		if network == "" {
+			return n, fmt.Errorf("network is required for private network %s", name)
+		}
+
+		n.UUID, err = namedargs.ResolveNetwork(exec, network)
+		if err != nil {
+			return n, err
+		}
+	default:
+		return n, fmt.Errorf("invalid network type %q, must be one of: public, private", networkType)
+	}
+
+	return n, nil
+}
+
+func processFrontend(in string) (request.LoadBalancerFrontend, error) {
+	p := loadbalancerfrontend.CreateFrontendParams{}
+	fs := loadbalancerfrontend.GetCreateFrontendFlagSet(&p)
+	fe := request.LoadBalancerFrontend{}
+
+	args, err := commands.ParseN(in, 2)
+	if err != nil {
+		return fe, err
+	}
+
+	err = fs.Parse(args)
+	if err != nil {
+		return fe, err
+	}
+
+	return loadbalancerfrontend.ProcessFrontendParams(p)
+}
+
+func processBackend(in string) (request.LoadBalancerBackend, error) {
+	p := loadbalancerbackend.CreateBackendParams{}
+	fs := loadbalancerbackend.GetCreateBackendFlagSet(&p)
+	be := request.LoadBalancerBackend{}
+
+	args, err := commands.ParseN(in, 2)
+	if err != nil {
+		return be, err
+	}
+
+	err = fs.Parse(args)
+	if err != nil {
+		return be, err
+	}
+
+	return loadbalancerbackend.ProcessBackendParams(p)
+}
+
+func processMember(in string) (string, request.LoadBalancerBackendMember, error) {
+	var backend string
+	p := loadbalancermember.CreateMemberParams{}
+	fs := loadbalancermember.GetCreateMemberFlagSet(&p)
+	fs.StringVar(&backend, "backend", "", "")
+	m := request.LoadBalancerBackendMember{}
+
+	args, err := commands.ParseN(in, 2)
+	if err != nil {
+		return backend, m, err
+	}
+
+	err = fs.Parse(args)
+	if err != nil {
+		return backend, m, err
+	}
+
+	if backend == "" {
+		return backend, m, fmt.Errorf("backend is required for member %s", p.Name)
+	}
+
+	m, err = loadbalancermember.ProcessMemberParams(p)
+	return backend, m, err
+}
+
+func processResolver(in string) (request.LoadBalancerResolver, error) {
+	p := loadbalancerresolver.CreateResolverParams{}
+	fs := loadbalancerresolver.GetCreateResolverFlagSet(&p)
+	r := request.LoadBalancerResolver{}
+
+	args, err := commands.ParseN(in, 2)
+	if err != nil {
+		return r, err
+	}
+
+	err = fs.Parse(args)
+	if err != nil {
+		return r, err
+	}
+
+	return loadbalancerresolver.ProcessResolverParams(p)
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	// Deprecating loadbalancer in favour of load-balancer
+	// TODO: Remove this in the future
+	commands.SetSubcommandExecutionDeprecationMessage(s, []string{deprecatedAliasLoadBalancer}, "load-balancer")
+
+	if s.params.MaintenanceDOW != "" && !slices.Contains(maintenanceDOWs, string(s.params.MaintenanceDOW)) {
+		return nil, fmt.Errorf("invalid maintenance day of week %q, must be one of: %s", s.params.MaintenanceDOW, strings.Join(maintenanceDOWs, ", "))
+	}
+
+	if err := s.params.processParams(exec); err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Creating load balancer %s", s.params.Name)
+	exec.PushProgressStarted(msg)
+
+	r := s.params.CreateLoadBalancerRequest
+	r.ConfiguredStatus = upcloud.LoadBalancerConfiguredStatusStarted
+
+	res, err := exec.All().CreateLoadBalancer(exec.Context(), &r)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if s.params.wait.Value() {
+		waitForLoadBalancerState(res.UUID, upcloud.LoadBalancerOperationalStateRunning, exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "UUID", Value: res.UUID, Colour: ui.DefaultUUUIDColours},
+	}}, nil
+}
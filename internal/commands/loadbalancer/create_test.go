@@ -0,0 +1,199 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateLoadBalancer(t *testing.T) {
+	network := upcloud.Network{
+		UUID: "aa39e313-d908-418a-a959-459699bdc83a",
+		Name: "test-network",
+	}
+	networks := upcloud.Networks{Networks: []upcloud.Network{network}}
+
+	baseArgs := []string{
+		"--name", "my-load-balancer",
+		"--zone", "fi-hel1",
+		"--network", "name=public,type=public",
+		"--network", "name=private,type=private,network=test-network",
+		"--backend", "name=web,health-check-type=http,health-check-url=/health",
+		"--member", "backend=web,name=web-1,ip=10.0.0.10,port=80",
+		"--member", "backend=web,name=web-2,ip=10.0.0.11,port=80,weight=50,disable-member",
+		"--frontend", "name=web,port=80,default-backend=web,network=public",
+	}
+	baseRequest := request.CreateLoadBalancerRequest{
+		Name:             "my-load-balancer",
+		Plan:             "development",
+		Zone:             "fi-hel1",
+		ConfiguredStatus: upcloud.LoadBalancerConfiguredStatusStarted,
+		Networks: []request.LoadBalancerNetwork{
+			{
+				Name:   "public",
+				Type:   upcloud.LoadBalancerNetworkTypePublic,
+				Family: upcloud.LoadBalancerAddressFamilyIPv4,
+			},
+			{
+				Name:   "private",
+				Type:   upcloud.LoadBalancerNetworkTypePrivate,
+				Family: upcloud.LoadBalancerAddressFamilyIPv4,
+				UUID:   network.UUID,
+			},
+		},
+		Backends: []request.LoadBalancerBackend{
+			{
+				Name: "web",
+				Members: []request.LoadBalancerBackendMember{
+					{
+						Name:        "web-1",
+						Type:        upcloud.LoadBalancerBackendMemberTypeStatic,
+						IP:          "10.0.0.10",
+						Port:        80,
+						Weight:      100,
+						MaxSessions: 1000,
+						Enabled:     true,
+					},
+					{
+						Name:        "web-2",
+						Type:        upcloud.LoadBalancerBackendMemberTypeStatic,
+						IP:          "10.0.0.11",
+						Port:        80,
+						Weight:      50,
+						MaxSessions: 1000,
+						Enabled:     false,
+					},
+				},
+				Properties: &upcloud.LoadBalancerBackendProperties{
+					HealthCheckType: upcloud.LoadBalancerHealthCheckTypeHTTP,
+					HealthCheckURL:  "/health",
+				},
+			},
+		},
+		Frontends: []request.LoadBalancerFrontend{
+			{
+				Name:           "web",
+				Mode:           upcloud.LoadBalancerModeHTTP,
+				Port:           80,
+				DefaultBackend: "web",
+				Networks:       []upcloud.LoadBalancerFrontendNetwork{{Name: "public"}},
+				Rules:          []request.LoadBalancerFrontendRule{},
+				TLSConfigs:     []request.LoadBalancerFrontendTLSConfig{},
+			},
+		},
+		Resolvers: []request.LoadBalancerResolver{},
+	}
+
+	resolverArgs := append(append([]string{}, baseArgs...),
+		"--plan", "production-small",
+		"--resolver", "name=internal,nameserver=10.0.0.2,nameserver=10.0.0.3:5353",
+		"--label", "env=dev",
+		"--maintenance-dow", "sunday",
+		"--maintenance-time", "04:00:00",
+	)
+	resolverRequest := baseRequest
+	resolverRequest.Plan = "production-small"
+	resolverRequest.Resolvers = []request.LoadBalancerResolver{
+		{
+			Name:         "internal",
+			Nameservers:  []string{"10.0.0.2", "10.0.0.3:5353"},
+			Retries:      5,
+			Timeout:      30,
+			TimeoutRetry: 10,
+			CacheValid:   180,
+			CacheInvalid: 10,
+		},
+	}
+	resolverRequest.Labels = []upcloud.Label{{Key: "env", Value: "dev"}}
+	resolverRequest.MaintenanceDOW = "sunday"
+	resolverRequest.MaintenanceTime = "04:00:00"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		request  request.CreateLoadBalancerRequest
+		errorMsg string
+	}{
+		{
+			name:    "with backend, members and frontend",
+			args:    baseArgs,
+			request: baseRequest,
+		},
+		{
+			name:    "with resolver, labels and maintenance window",
+			args:    resolverArgs,
+			request: resolverRequest,
+		},
+		{
+			name: "member of undefined backend",
+			args: []string{
+				"--name", "my-load-balancer",
+				"--zone", "fi-hel1",
+				"--network", "name=public,type=public",
+				"--member", "backend=api,name=api-1,ip=10.0.0.10,port=80",
+			},
+			errorMsg: `backend "api" of member api-1 is not defined`,
+		},
+		{
+			name: "static member without ip",
+			args: []string{
+				"--name", "my-load-balancer",
+				"--zone", "fi-hel1",
+				"--network", "name=public,type=public",
+				"--backend", "name=web",
+				"--member", "backend=web,name=web-1,port=80",
+			},
+			errorMsg: "ip and port are required for static member web-1",
+		},
+		{
+			name: "private network without network",
+			args: []string{
+				"--name", "my-load-balancer",
+				"--zone", "fi-hel1",
+				"--network", "name=private,type=private",
+			},
+			errorMsg: "network is required for private network private",
+		},
+		{
+			name: "invalid frontend mode",
+			args: []string{
+				"--name", "my-load-balancer",
+				"--zone", "fi-hel1",
+				"--network", "name=public,type=public",
+				"--frontend", "name=web,mode=udp,port=80,default-backend=web",
+			},
+			errorMsg: `invalid mode "udp", must be one of: http, tcp`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := CreateCommand()
+			mService := new(smock.Service)
+
+			req := test.request
+			mService.On("CreateLoadBalancer", &req).Return(&upcloud.LoadBalancer{UUID: "17fbd082-30b0-11eb-adc1-0242ac120003"}, nil)
+			mService.On("GetNetworks").Return(&networks, nil)
+
+			c := commands.BuildCommand(testCmd, nil, conf)
+
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				mService.AssertNumberOfCalls(t, "CreateLoadBalancer", 0)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateLoadBalancer", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package loadbalancerfrontend
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// CreateCommand creates the "load-balancer frontend create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a new frontend into the specified load balancer",
+			"upctl load-balancer frontend create my-load-balancer --name web --port 443 --default-backend web",
+			"upctl load-balancer frontend create my-load-balancer --name api --mode tcp --port 8080 --default-backend api --network public",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	p CreateFrontendParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	fs := GetCreateFrontendFlagSet(&s.p)
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("port"))
+	commands.Must(s.Cobra().MarkFlagRequired("default-backend"))
+}
+
+func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("default-backend", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerBackend{LoadBalancer: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *createCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Creating frontend %s into load balancer %v", s.p.Name, arg)
+	exec.PushProgressStarted(msg)
+
+	backend, err := namedargs.ResolveLoadBalancerBackend(exec, arg, s.p.DefaultBackend)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+	s.p.DefaultBackend = backend
+
+	fe, err := ProcessFrontendParams(s.p)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	res, err := exec.All().CreateLoadBalancerFrontend(exec.Context(), &request.CreateLoadBalancerFrontendRequest{
+		ServiceUUID: arg,
+		Frontend:    fe,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,81 @@
+package loadbalancerfrontend
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFrontend(t *testing.T) {
+	lbUUID := "17fbd082-30b0-11eb-adc1-0242ac120003"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateLoadBalancerFrontendRequest
+		errorMsg string
+	}{
+		{
+			name:     "missing required flags",
+			args:     []string{lbUUID, "--name", "web"},
+			errorMsg: `required flag(s) "default-backend", "port" not set`,
+		},
+		{
+			name: "http frontend",
+			args: []string{lbUUID, "--name", "web", "--port", "443", "--default-backend", "WEB", "--network", "public", "--timeout-client", "30"},
+			expected: request.CreateLoadBalancerFrontendRequest{
+				ServiceUUID: lbUUID,
+				Frontend: request.LoadBalancerFrontend{
+					Name:           "web",
+					Mode:           upcloud.LoadBalancerModeHTTP,
+					Port:           443,
+					DefaultBackend: "web",
+					Networks:       []upcloud.LoadBalancerFrontendNetwork{{Name: "public"}},
+					Rules:          []request.LoadBalancerFrontendRule{},
+					TLSConfigs:     []request.LoadBalancerFrontendTLSConfig{},
+					Properties:     &upcloud.LoadBalancerFrontendProperties{TimeoutClient: 30},
+				},
+			},
+		},
+		{
+			name:     "unknown backend",
+			args:     []string{lbUUID, "--name", "web", "--port", "443", "--default-backend", "api"},
+			errorMsg: "could not resolve backend: nothing found matching 'api'",
+		},
+		{
+			name:     "invalid port",
+			args:     []string{lbUUID, "--name", "web", "--port", "70000", "--default-backend", "web"},
+			errorMsg: "invalid port 70000, must be between 1 and 65535",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := CreateCommand()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("CreateLoadBalancerFrontend", &expected).Return(&upcloud.LoadBalancerFrontend{}, nil)
+			mService.On("GetLoadBalancerBackends", &request.GetLoadBalancerBackendsRequest{ServiceUUID: lbUUID}).Return([]upcloud.LoadBalancerBackend{{Name: "web"}}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, conf)
+
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateLoadBalancerFrontend", 1)
+			}
+		})
+	}
+}
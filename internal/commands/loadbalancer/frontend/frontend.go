@@ -0,0 +1,116 @@
+package loadbalancerfrontend
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+var validModes = []string{string(upcloud.LoadBalancerModeHTTP), string(upcloud.LoadBalancerModeTCP)}
+
+// BaseFrontendCommand creates the base "load-balancer frontend" command
+func BaseFrontendCommand() commands.Command {
+	return &frontendCommand{
+		commands.New("frontend", "Manage load balancer frontends"),
+	}
+}
+
+type frontendCommand struct {
+	*commands.BaseCommand
+}
+
+// CreateFrontendParams contains the parameters used to define a load balancer frontend
+type CreateFrontendParams struct {
+	Name                 string
+	Mode                 string
+	Port                 int
+	DefaultBackend       string
+	Networks             []string
+	TimeoutClient        int
+	InboundProxyProtocol config.OptionalBoolean
+}
+
+// GetCreateFrontendFlagSet returns the flags used to define a load balancer frontend
+func GetCreateFrontendFlagSet(p *CreateFrontendParams) *pflag.FlagSet {
+	fs := &pflag.FlagSet{}
+
+	fs.StringVar(&p.Name, "name", "", "Frontend name.")
+	fs.StringVar(&p.Mode, "mode", string(upcloud.LoadBalancerModeHTTP), "Frontend mode, `http` or `tcp`.")
+	fs.IntVar(&p.Port, "port", 0, "Port to listen on.")
+	fs.StringVar(&p.DefaultBackend, "default-backend", "", "Name of the backend to route traffic to by default.")
+	fs.StringArrayVar(&p.Networks, "network", []string{}, "Name of the load balancer network to listen on. Use multiple times to listen on multiple networks.")
+	fs.IntVar(&p.TimeoutClient, "timeout-client", 0, "Client inactivity timeout in seconds.")
+	config.AddToggleFlag(fs, &p.InboundProxyProtocol, "inbound-proxy-protocol", false, "Enable PROXY protocol v2 for inbound connections.")
+
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("mode", commands.FlagAnnotationFixedCompletions, validModes))
+	commands.Must(fs.SetAnnotation("port", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("network", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("timeout-client", commands.FlagAnnotationNoFileCompletions, nil))
+
+	return fs
+}
+
+func validateMode(mode string) error {
+	if slices.Contains(validModes, mode) {
+		return nil
+	}
+	return fmt.Errorf("invalid mode %q, must be one of: http, tcp", mode)
+}
+
+func validatePort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("invalid port %d, must be between 1 and 65535", port)
+	}
+	return nil
+}
+
+// ProcessFrontendParams validates the given parameters and converts them to a frontend definition
+func ProcessFrontendParams(p CreateFrontendParams) (request.LoadBalancerFrontend, error) {
+	fe := request.LoadBalancerFrontend{}
+
+	if p.Name == "" {
+		return fe, fmt.Errorf("frontend name is required")
+	}
+
+	if p.DefaultBackend == "" {
+		return fe, fmt.Errorf("default backend is required for frontend %s", p.Name)
+	}
+
+	if err := validateMode(p.Mode); err != nil {
+		return fe, err
+	}
+
+	if err := validatePort(p.Port); err != nil {
+		return fe, err
+	}
+
+	networks := make([]upcloud.LoadBalancerFrontendNetwork, 0)
+	for _, n := range p.Networks {
+		networks = append(networks, upcloud.LoadBalancerFrontendNetwork{Name: n})
+	}
+
+	fe = request.LoadBalancerFrontend{
+		Name:           p.Name,
+		Mode:           upcloud.LoadBalancerMode(p.Mode),
+		Port:           p.Port,
+		DefaultBackend: p.DefaultBackend,
+		Networks:       networks,
+		Rules:          []request.LoadBalancerFrontendRule{},
+		TLSConfigs:     []request.LoadBalancerFrontendTLSConfig{},
+	}
+
+	if p.TimeoutClient > 0 || p.InboundProxyProtocol.Value() {
+		fe.Properties = &upcloud.LoadBalancerFrontendProperties{
+			TimeoutClient:        p.TimeoutClient,
+			InboundProxyProtocol: p.InboundProxyProtocol.Value(),
+		}
+	}
+
+	return fe, nil
+}
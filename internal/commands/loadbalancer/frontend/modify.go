@@ -0,0 +1,124 @@
+package loadbalancerfrontend
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer frontend modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a frontend of the specified load balancer",
+			"upctl load-balancer frontend modify my-load-balancer --name web --port 8443",
+			"upctl load-balancer frontend modify my-load-balancer --name web --new-name www --default-backend www",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name           string
+	newName        string
+	mode           string
+	port           int
+	defaultBackend string
+	timeoutClient  int
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the frontend to modify.")
+	fs.StringVar(&s.newName, "new-name", "", "New name for the frontend.")
+	fs.StringVar(&s.mode, "mode", "", "Frontend mode, `http` or `tcp`.")
+	fs.IntVar(&s.port, "port", 0, "Port to listen on.")
+	fs.StringVar(&s.defaultBackend, "default-backend", "", "Name of the backend to route traffic to by default.")
+	fs.IntVar(&s.timeoutClient, "timeout-client", 0, "Client inactivity timeout in seconds.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("new-name", cobra.NoFileCompletions))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("mode", cobra.FixedCompletions(validModes, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("port", cobra.NoFileCompletions))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("timeout-client", cobra.NoFileCompletions))
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerFrontend{LoadBalancer: args[0]}
+	}, cfg)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("default-backend", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerBackend{LoadBalancer: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *modifyCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying frontend %s of load balancer %v", s.name, arg)
+	exec.PushProgressStarted(msg)
+
+	name, err := namedargs.ResolveLoadBalancerFrontend(exec, arg, s.name)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	fe := request.ModifyLoadBalancerFrontend{
+		Name: s.newName,
+		Port: s.port,
+	}
+
+	if s.mode != "" {
+		if err := validateMode(s.mode); err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+		fe.Mode = upcloud.LoadBalancerMode(s.mode)
+	}
+
+	if s.port != 0 {
+		if err := validatePort(s.port); err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+
+	if s.defaultBackend != "" {
+		backend, err := namedargs.ResolveLoadBalancerBackend(exec, arg, s.defaultBackend)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+		fe.DefaultBackend = backend
+	}
+
+	if s.timeoutClient != 0 {
+		fe.Properties = &upcloud.LoadBalancerFrontendProperties{
+			TimeoutClient: s.timeoutClient,
+		}
+	}
+
+	res, err := exec.All().ModifyLoadBalancerFrontend(exec.Context(), &request.ModifyLoadBalancerFrontendRequest{
+		ServiceUUID: arg,
+		Name:        name,
+		Frontend:    fe,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -1,7 +1,14 @@
 package loadbalancer
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/UpCloudLtd/progress/messages"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 )
 
 const deprecatedAliasLoadBalancer = "loadbalancer"
@@ -31,3 +38,27 @@ func (lb *loadbalancerCommand) InitCommand() {
 	// TODO: Remove this in the future
 	commands.SetDeprecationHelp(lb.Cobra(), lb.DeprecatedAliases())
 }
+
+// waitForLoadBalancerState waits for load balancer to reach given state and updates progress message with key matching given msg. Finally, progress message is updated back to given msg and either done state or timeout warning.
+func waitForLoadBalancerState(uuid string, state upcloud.LoadBalancerOperationalState, exec commands.Executor, msg string) {
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for load balancer %s to be in %s state", uuid, state))
+
+	ctx, cancel := context.WithTimeout(exec.Context(), 15*time.Minute)
+	defer cancel()
+
+	if _, err := exec.All().WaitForLoadBalancerOperationalState(ctx, &request.WaitForLoadBalancerOperationalStateRequest{
+		UUID:         uuid,
+		DesiredState: state,
+	}); err != nil {
+		exec.PushProgressUpdate(messages.Update{
+			Key:     msg,
+			Message: msg,
+			Status:  messages.MessageStatusWarning,
+			Details: "Error: " + err.Error(),
+		})
+		return
+	}
+
+	exec.PushProgressUpdateMessage(msg, msg)
+	exec.PushProgressSuccess(msg)
+}
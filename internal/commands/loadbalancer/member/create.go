@@ -0,0 +1,80 @@
+package loadbalancermember
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// CreateCommand creates the "load-balancer member create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Add a new member to a backend of the specified load balancer",
+			"upctl load-balancer member create my-load-balancer --backend web --name web-1 --ip 10.0.0.10 --port 80",
+			"upctl load-balancer member create my-load-balancer --backend web --name web-2 --ip 10.0.0.11 --port 80 --weight 50 --disable-member",
+			"upctl load-balancer member create my-load-balancer --backend api --name api --type dynamic",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	backend string
+	p       CreateMemberParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	fs := GetCreateMemberFlagSet(&s.p)
+	fs.StringVar(&s.backend, "backend", "", "Name of the backend to add the member to.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("backend"))
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("backend", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerBackend{LoadBalancer: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *createCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Adding member %s to backend %s of load balancer %v", s.p.Name, s.backend, arg)
+	exec.PushProgressStarted(msg)
+
+	backend, err := namedargs.ResolveLoadBalancerBackend(exec, arg, s.backend)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	m, err := ProcessMemberParams(s.p)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	res, err := exec.All().CreateLoadBalancerBackendMember(exec.Context(), &request.CreateLoadBalancerBackendMemberRequest{
+		ServiceUUID: arg,
+		BackendName: backend,
+		Member:      m,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
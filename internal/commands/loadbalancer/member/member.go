@@ -0,0 +1,92 @@
+package loadbalancermember
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+var validTypes = []string{string(upcloud.LoadBalancerBackendMemberTypeStatic), string(upcloud.LoadBalancerBackendMemberTypeDynamic)}
+
+// BaseMemberCommand creates the base "load-balancer member" command
+func BaseMemberCommand() commands.Command {
+	return &memberCommand{
+		commands.New("member", "Manage load balancer backend members"),
+	}
+}
+
+type memberCommand struct {
+	*commands.BaseCommand
+}
+
+// CreateMemberParams contains the parameters used to define a load balancer backend member
+type CreateMemberParams struct {
+	Name        string
+	Type        string
+	IP          string
+	Port        int
+	Weight      int
+	MaxSessions int
+	Enabled     config.OptionalBoolean
+}
+
+// GetCreateMemberFlagSet returns the flags used to define a load balancer backend member
+func GetCreateMemberFlagSet(p *CreateMemberParams) *pflag.FlagSet {
+	fs := &pflag.FlagSet{}
+
+	fs.StringVar(&p.Name, "name", "", "Member name.")
+	fs.StringVar(&p.Type, "type", string(upcloud.LoadBalancerBackendMemberTypeStatic), "Member type, `static` or `dynamic`. Dynamic members are resolved using the resolver of the backend.")
+	fs.StringVar(&p.IP, "ip", "", "IP address of the member. Required for static members.")
+	fs.IntVar(&p.Port, "port", 0, "Port of the member. Required for static members.")
+	fs.IntVar(&p.Weight, "weight", 100, "Weight of the member. Traffic is distributed to members in proportion to their weights.")
+	fs.IntVar(&p.MaxSessions, "max-sessions", 1000, "Maximum number of sessions before queueing.")
+	config.AddEnableOrDisableFlag(fs, &p.Enabled, true, "member", "the member. Disabled members do not receive traffic")
+
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("type", commands.FlagAnnotationFixedCompletions, validTypes))
+	commands.Must(fs.SetAnnotation("ip", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("port", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("weight", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("max-sessions", commands.FlagAnnotationNoFileCompletions, nil))
+
+	return fs
+}
+
+// ProcessMemberParams validates the given parameters and converts them to a backend member definition
+func ProcessMemberParams(p CreateMemberParams) (request.LoadBalancerBackendMember, error) {
+	m := request.LoadBalancerBackendMember{}
+
+	if p.Name == "" {
+		return m, fmt.Errorf("member name is required")
+	}
+
+	switch upcloud.LoadBalancerBackendMemberType(p.Type) {
+	case upcloud.LoadBalancerBackendMemberTypeStatic:
+		if p.IP == "" || p.Port == 0 {
+			return m, fmt.Errorf("ip and port are required for static member %s", p.Name)
+		}
+	case upcloud.LoadBalancerBackendMemberTypeDynamic:
+	default:
+		return m, fmt.Errorf("invalid member type %q, must be one of: static, dynamic", p.Type)
+	}
+
+	if p.Port < 0 || p.Port > 65535 {
+		return m, fmt.Errorf("invalid port %d, must be between 1 and 65535", p.Port)
+	}
+
+	m = request.LoadBalancerBackendMember{
+		Name:        p.Name,
+		Type:        upcloud.LoadBalancerBackendMemberType(p.Type),
+		IP:          p.IP,
+		Port:        p.Port,
+		Weight:      p.Weight,
+		MaxSessions: p.MaxSessions,
+		Enabled:     p.Enabled.Value(),
+	}
+
+	return m, nil
+}
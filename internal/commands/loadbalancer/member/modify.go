@@ -0,0 +1,117 @@
+package loadbalancermember
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer member modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a member of a backend of the specified load balancer",
+			"upctl load-balancer member modify my-load-balancer --backend web --name web-1 --weight 0",
+			"upctl load-balancer member modify my-load-balancer --backend web --name web-2 --enable-member",
+			"upctl load-balancer member modify my-load-balancer --backend web --name web-1 --ip 10.0.0.12 --port 8080",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	backend     string
+	name        string
+	newName     string
+	ip          string
+	port        int
+	weight      int
+	maxSessions int
+	enabled     config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.backend, "backend", "", "Name of the backend the member belongs to.")
+	fs.StringVar(&s.name, "name", "", "Name of the member to modify.")
+	fs.StringVar(&s.newName, "new-name", "", "New name for the member.")
+	fs.StringVar(&s.ip, "ip", "", "IP address of the member.")
+	fs.IntVar(&s.port, "port", 0, "Port of the member.")
+	fs.IntVar(&s.weight, "weight", 0, "Weight of the member. Traffic is distributed to members in proportion to their weights.")
+	fs.IntVar(&s.maxSessions, "max-sessions", 0, "Maximum number of sessions before queueing.")
+	config.AddEnableDisableFlags(fs, &s.enabled, "member", "the member")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("backend"))
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	for _, flag := range []string{"name", "new-name", "ip", "port", "weight", "max-sessions"} {
+		commands.Must(s.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("backend", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerBackend{LoadBalancer: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *modifyCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying member %s of backend %s of load balancer %v", s.name, s.backend, arg)
+	exec.PushProgressStarted(msg)
+
+	backend, err := namedargs.ResolveLoadBalancerBackend(exec, arg, s.backend)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	m := request.ModifyLoadBalancerBackendMember{
+		Name: s.newName,
+		Port: s.port,
+	}
+
+	if s.ip != "" {
+		m.IP = &s.ip
+	}
+
+	// Zero is a valid weight, so weight and max sessions are only included if explicitly set
+	if s.Cobra().Flags().Changed("weight") {
+		m.Weight = upcloud.IntPtr(s.weight)
+	}
+
+	if s.Cobra().Flags().Changed("max-sessions") {
+		m.MaxSessions = upcloud.IntPtr(s.maxSessions)
+	}
+
+	if s.enabled.IsSet() {
+		m.Enabled = upcloud.BoolPtr(s.enabled.Value())
+	}
+
+	res, err := exec.All().ModifyLoadBalancerBackendMember(exec.Context(), &request.ModifyLoadBalancerBackendMemberRequest{
+		ServiceUUID: arg,
+		BackendName: backend,
+		Name:        s.name,
+		Member:      m,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,80 @@
+package loadbalancermember
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyMember(t *testing.T) {
+	lbUUID := "17fbd082-30b0-11eb-adc1-0242ac120003"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.ModifyLoadBalancerBackendMemberRequest
+		errorMsg string
+	}{
+		{
+			name:     "missing backend",
+			args:     []string{lbUUID, "--name", "web-1"},
+			errorMsg: `required flag(s) "backend" not set`,
+		},
+		{
+			name: "drain member",
+			args: []string{lbUUID, "--backend", "web", "--name", "web-1", "--weight", "0"},
+			expected: request.ModifyLoadBalancerBackendMemberRequest{
+				ServiceUUID: lbUUID,
+				BackendName: "web",
+				Name:        "web-1",
+				Member: request.ModifyLoadBalancerBackendMember{
+					Weight: upcloud.IntPtr(0),
+				},
+			},
+		},
+		{
+			name: "enable member and change address",
+			args: []string{lbUUID, "--backend", "web", "--name", "web-1", "--enable-member", "--ip", "10.0.0.12", "--port", "8080"},
+			expected: request.ModifyLoadBalancerBackendMemberRequest{
+				ServiceUUID: lbUUID,
+				BackendName: "web",
+				Name:        "web-1",
+				Member: request.ModifyLoadBalancerBackendMember{
+					Enabled: upcloud.BoolPtr(true),
+					IP:      upcloud.StringPtr("10.0.0.12"),
+					Port:    8080,
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := ModifyCommand()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("ModifyLoadBalancerBackendMember", &expected).Return(&upcloud.LoadBalancerBackendMember{}, nil)
+			mService.On("GetLoadBalancerBackends", &request.GetLoadBalancerBackendsRequest{ServiceUUID: lbUUID}).Return([]upcloud.LoadBalancerBackend{{Name: "web"}}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, conf)
+
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "ModifyLoadBalancerBackendMember", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package loadbalancer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var configuredStatuses = []string{string(upcloud.LoadBalancerConfiguredStatusStarted), string(upcloud.LoadBalancerConfiguredStatusStopped)}
+
+// ModifyCommand creates the "load-balancer modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a load balancer",
+			"upctl load-balancer modify my-load-balancer --name my-renamed-load-balancer",
+			"upctl load-balancer modify 55199a44-4751-4e27-9394-7c7661910be3 --plan production-small",
+			"upctl load-balancer modify my-load-balancer --configured-status stopped",
+			"upctl load-balancer modify my-load-balancer --label env=dev --maintenance-dow sunday --maintenance-time 04:00:00",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name             string
+	plan             string
+	configuredStatus string
+	labels           []string
+	maintenanceDOW   string
+	maintenanceTime  string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	// Deprecating loadbalancer in favour of load-balancer
+	// TODO: Remove this in the future
+	commands.SetSubcommandDeprecationHelp(s, []string{deprecatedAliasLoadBalancer})
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "New name for the load balancer.")
+	fs.StringVar(&s.plan, "plan", "", "Plan to use for the load balancer. Run `upctl load-balancer plans` to list all available plans.")
+	fs.StringVar(&s.configuredStatus, "configured-status", "", "Configured status of the load balancer, `started` or `stopped`.")
+	fs.StringArrayVar(&s.labels, "label", nil, "Labels to describe the load balancer in `key=value` format, multiple can be declared. If set, all the existing labels will be replaced with provided ones.")
+	fs.StringVar(&s.maintenanceDOW, "maintenance-dow", "", "Day of the week for automatic maintenance, in lower case (e.g. sunday).")
+	fs.StringVar(&s.maintenanceTime, "maintenance-time", "", "Time of the day for automatic maintenance in UTC, in HH:MM:SS format.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("configured-status", cobra.FixedCompletions(configuredStatuses, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("maintenance-dow", cobra.FixedCompletions(maintenanceDOWs, cobra.ShellCompDirectiveNoFileComp)))
+	for _, flag := range []string{"name", "label", "maintenance-time"} {
+		commands.Must(s.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("plan", namedargs.CompletionFunc(completion.LoadBalancerPlan{}, cfg)))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *modifyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	// Deprecating loadbalancer in favour of load-balancer
+	// TODO: Remove this in the future
+	commands.SetSubcommandExecutionDeprecationMessage(s, []string{deprecatedAliasLoadBalancer}, "load-balancer")
+
+	msg := fmt.Sprintf("Modifying load balancer %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	req := request.ModifyLoadBalancerRequest{
+		UUID:            uuid,
+		Name:            s.name,
+		Plan:            s.plan,
+		MaintenanceDOW:  upcloud.LoadBalancerMaintenanceDOW(s.maintenanceDOW),
+		MaintenanceTime: s.maintenanceTime,
+	}
+
+	if s.configuredStatus != "" {
+		if !slices.Contains(configuredStatuses, s.configuredStatus) {
+			return commands.HandleError(exec, msg, fmt.Errorf("invalid configured status %q, must be one of: %s", s.configuredStatus, strings.Join(configuredStatuses, ", ")))
+		}
+		req.ConfiguredStatus = s.configuredStatus
+	}
+
+	if s.maintenanceDOW != "" && !slices.Contains(maintenanceDOWs, s.maintenanceDOW) {
+		return commands.HandleError(exec, msg, fmt.Errorf("invalid maintenance day of week %q, must be one of: %s", s.maintenanceDOW, strings.Join(maintenanceDOWs, ", ")))
+	}
+
+	if len(s.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(s.labels)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		req.Labels = &labelSlice
+	}
+
+	res, err := exec.All().ModifyLoadBalancer(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,79 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyLoadBalancer(t *testing.T) {
+	uuid := "17fbd082-30b0-11eb-adc1-0242ac120003"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		request  request.ModifyLoadBalancerRequest
+		errorMsg string
+	}{
+		{
+			name:    "rename",
+			args:    []string{uuid, "--name", "renamed"},
+			request: request.ModifyLoadBalancerRequest{UUID: uuid, Name: "renamed"},
+		},
+		{
+			name:    "stop",
+			args:    []string{uuid, "--configured-status", "stopped"},
+			request: request.ModifyLoadBalancerRequest{UUID: uuid, ConfiguredStatus: "stopped"},
+		},
+		{
+			name: "plan, labels and maintenance window",
+			args: []string{uuid, "--plan", "production-small", "--label", "env=dev", "--maintenance-dow", "monday", "--maintenance-time", "01:00:00"},
+			request: request.ModifyLoadBalancerRequest{
+				UUID:            uuid,
+				Plan:            "production-small",
+				Labels:          &[]upcloud.Label{{Key: "env", Value: "dev"}},
+				MaintenanceDOW:  "monday",
+				MaintenanceTime: "01:00:00",
+			},
+		},
+		{
+			name:     "invalid configured status",
+			args:     []string{uuid, "--configured-status", "paused"},
+			errorMsg: `invalid configured status "paused", must be one of: started, stopped`,
+		},
+		{
+			name:     "invalid maintenance day of week",
+			args:     []string{uuid, "--maintenance-dow", "caturday"},
+			errorMsg: `invalid maintenance day of week "caturday", must be one of: monday, tuesday, wednesday, thursday, friday, saturday, sunday`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := ModifyCommand()
+			mService := new(smock.Service)
+
+			req := test.request
+			mService.On("ModifyLoadBalancer", &req).Return(&upcloud.LoadBalancer{UUID: uuid}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, conf)
+
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "ModifyLoadBalancer", 1)
+			}
+		})
+	}
+}
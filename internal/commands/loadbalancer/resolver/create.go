@@ -0,0 +1,63 @@
+package loadbalancerresolver
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// CreateCommand creates the "load-balancer resolver create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a new DNS resolver into the specified load balancer",
+			"upctl load-balancer resolver create my-load-balancer --name internal --nameserver 10.0.0.2",
+			"upctl load-balancer resolver create my-load-balancer --name public --nameserver 94.237.127.9:53 --nameserver 94.237.40.9:53 --cache-valid 60",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	p CreateResolverParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	fs := GetCreateResolverFlagSet(&s.p)
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("nameserver"))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *createCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Creating resolver %s into load balancer %v", s.p.Name, arg)
+	exec.PushProgressStarted(msg)
+
+	r, err := ProcessResolverParams(s.p)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	res, err := exec.All().CreateLoadBalancerResolver(exec.Context(), &request.CreateLoadBalancerResolverRequest{
+		ServiceUUID: arg,
+		Resolver:    r,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
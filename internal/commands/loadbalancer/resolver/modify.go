@@ -0,0 +1,109 @@
+package loadbalancerresolver
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer resolver modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a DNS resolver of the specified load balancer",
+			"upctl load-balancer resolver modify my-load-balancer --name internal --nameserver 10.0.0.2 --nameserver 10.0.0.3",
+			"upctl load-balancer resolver modify my-load-balancer --name internal --new-name private --timeout 10",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	name    string
+	newName string
+	p       CreateResolverParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the resolver to modify.")
+	fs.StringVar(&s.newName, "new-name", "", "New name for the resolver.")
+	addSettingsFlags(fs, &s.p, CreateResolverParams{Nameservers: []string{}})
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", cobra.NoFileCompletions))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("new-name", cobra.NoFileCompletions))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *modifyCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying resolver %s of load balancer %v", s.name, arg)
+	exec.PushProgressStarted(msg)
+
+	svc := exec.All()
+	// Resolver is replaced as a whole, so the current settings are used as the base for the modification
+	current, err := svc.GetLoadBalancerResolver(exec.Context(), &request.GetLoadBalancerResolverRequest{
+		ServiceUUID: arg,
+		Name:        s.name,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	r := request.LoadBalancerResolver{
+		Name:         current.Name,
+		Nameservers:  current.Nameservers,
+		Retries:      current.Retries,
+		Timeout:      current.Timeout,
+		TimeoutRetry: current.TimeoutRetry,
+		CacheValid:   current.CacheValid,
+		CacheInvalid: current.CacheInvalid,
+	}
+
+	if s.newName != "" {
+		r.Name = s.newName
+	}
+	if len(s.p.Nameservers) > 0 {
+		r.Nameservers = s.p.Nameservers
+	}
+	if s.p.Retries != 0 {
+		r.Retries = s.p.Retries
+	}
+	if s.p.Timeout != 0 {
+		r.Timeout = s.p.Timeout
+	}
+	if s.p.TimeoutRetry != 0 {
+		r.TimeoutRetry = s.p.TimeoutRetry
+	}
+	if s.p.CacheValid != 0 {
+		r.CacheValid = s.p.CacheValid
+	}
+	if s.p.CacheInvalid != 0 {
+		r.CacheInvalid = s.p.CacheInvalid
+	}
+
+	res, err := svc.ModifyLoadBalancerResolver(exec.Context(), &request.ModifyLoadBalancerResolverRequest{
+		ServiceUUID: arg,
+		Name:        s.name,
+		Resolver:    r,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
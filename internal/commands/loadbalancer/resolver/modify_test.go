@@ -0,0 +1,81 @@
+package loadbalancerresolver
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyResolver(t *testing.T) {
+	lbUUID := "17fbd082-30b0-11eb-adc1-0242ac120003"
+	current := upcloud.LoadBalancerResolver{
+		Name:         "internal",
+		Nameservers:  []string{"10.0.0.2"},
+		Retries:      5,
+		Timeout:      30,
+		TimeoutRetry: 10,
+		CacheValid:   180,
+		CacheInvalid: 10,
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.LoadBalancerResolver
+	}{
+		{
+			name: "replace nameservers",
+			args: []string{lbUUID, "--name", "internal", "--nameserver", "10.0.0.3", "--nameserver", "10.0.0.4"},
+			expected: request.LoadBalancerResolver{
+				Name:         "internal",
+				Nameservers:  []string{"10.0.0.3", "10.0.0.4"},
+				Retries:      5,
+				Timeout:      30,
+				TimeoutRetry: 10,
+				CacheValid:   180,
+				CacheInvalid: 10,
+			},
+		},
+		{
+			name: "rename and change timeouts",
+			args: []string{lbUUID, "--name", "internal", "--new-name", "private", "--timeout", "10", "--cache-valid", "60"},
+			expected: request.LoadBalancerResolver{
+				Name:         "private",
+				Nameservers:  []string{"10.0.0.2"},
+				Retries:      5,
+				Timeout:      10,
+				TimeoutRetry: 10,
+				CacheValid:   60,
+				CacheInvalid: 10,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := ModifyCommand()
+			mService := new(smock.Service)
+
+			mService.On("GetLoadBalancerResolver", &request.GetLoadBalancerResolverRequest{ServiceUUID: lbUUID, Name: "internal"}).Return(&current, nil)
+			mService.On("ModifyLoadBalancerResolver", &request.ModifyLoadBalancerResolverRequest{
+				ServiceUUID: lbUUID,
+				Name:        "internal",
+				Resolver:    test.expected,
+			}).Return(&upcloud.LoadBalancerResolver{}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, conf)
+
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			require.NoError(t, err)
+			mService.AssertNumberOfCalls(t, "ModifyLoadBalancerResolver", 1)
+		})
+	}
+}
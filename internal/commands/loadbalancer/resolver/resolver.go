@@ -0,0 +1,88 @@
+package loadbalancerresolver
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// BaseResolverCommand creates the base "load-balancer resolver" command
+func BaseResolverCommand() commands.Command {
+	return &resolverCommand{
+		commands.New("resolver", "Manage load balancer DNS resolvers"),
+	}
+}
+
+type resolverCommand struct {
+	*commands.BaseCommand
+}
+
+// CreateResolverParams contains the parameters used to define a load balancer resolver
+type CreateResolverParams struct {
+	Name         string
+	Nameservers  []string
+	Retries      int
+	Timeout      int
+	TimeoutRetry int
+	CacheValid   int
+	CacheInvalid int
+}
+
+func addSettingsFlags(fs *pflag.FlagSet, p *CreateResolverParams, def CreateResolverParams) {
+	fs.StringArrayVar(&p.Nameservers, "nameserver", def.Nameservers, "Nameserver address in `ip:port` format. If port is omitted, 53 is used. Use multiple times to define multiple nameservers.")
+	fs.IntVar(&p.Retries, "retries", def.Retries, "Number of retries on failure.")
+	fs.IntVar(&p.Timeout, "timeout", def.Timeout, "Timeout for the query in seconds.")
+	fs.IntVar(&p.TimeoutRetry, "timeout-retry", def.TimeoutRetry, "Timeout for the query retries in seconds.")
+	fs.IntVar(&p.CacheValid, "cache-valid", def.CacheValid, "Time in seconds to cache valid results.")
+	fs.IntVar(&p.CacheInvalid, "cache-invalid", def.CacheInvalid, "Time in seconds to cache invalid results.")
+
+	for _, flag := range []string{"nameserver", "retries", "timeout", "timeout-retry", "cache-valid", "cache-invalid"} {
+		commands.Must(fs.SetAnnotation(flag, commands.FlagAnnotationNoFileCompletions, nil))
+	}
+}
+
+// GetCreateResolverFlagSet returns the flags used to define a load balancer resolver
+func GetCreateResolverFlagSet(p *CreateResolverParams) *pflag.FlagSet {
+	fs := &pflag.FlagSet{}
+
+	fs.StringVar(&p.Name, "name", "", "Resolver name.")
+	addSettingsFlags(fs, p, CreateResolverParams{
+		Nameservers:  []string{},
+		Retries:      5,
+		Timeout:      30,
+		TimeoutRetry: 10,
+		CacheValid:   180,
+		CacheInvalid: 10,
+	})
+
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+
+	return fs
+}
+
+// ProcessResolverParams validates the given parameters and converts them to a resolver definition
+func ProcessResolverParams(p CreateResolverParams) (request.LoadBalancerResolver, error) {
+	r := request.LoadBalancerResolver{}
+
+	if p.Name == "" {
+		return r, fmt.Errorf("resolver name is required")
+	}
+
+	if len(p.Nameservers) == 0 {
+		return r, fmt.Errorf("at least one nameserver is required for resolver %s", p.Name)
+	}
+
+	r = request.LoadBalancerResolver{
+		Name:         p.Name,
+		Nameservers:  p.Nameservers,
+		Retries:      p.Retries,
+		Timeout:      p.Timeout,
+		TimeoutRetry: p.TimeoutRetry,
+		CacheValid:   p.CacheValid,
+		CacheInvalid: p.CacheInvalid,
+	}
+
+	return r, nil
+}
@@ -0,0 +1,103 @@
+package loadbalancerrule
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// CreateCommand creates the "load-balancer rule create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a new rule into a load balancer frontend",
+			"upctl load-balancer rule create my-load-balancer --frontend web --name api --priority 50 --match type=path,value=/api --action type=use_backend,backend=api",
+			"upctl load-balancer rule create my-load-balancer --frontend web --name to-https --priority 100 --match type=src_port,value=80 --action type=http_redirect,scheme=https",
+			"upctl load-balancer rule create my-load-balancer --frontend web --name block --priority 90 --matching-condition or --match type=src_ip,value=192.0.2.0/24 --match type=header,name=User-Agent,value=bad-bot,method=substring,ignore-case --action type=http_return,status=403,payload=Forbidden",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	frontend string
+	name     string
+	priority int
+	params   ruleParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.frontend, "frontend", "", "Name of the frontend to add the rule to.")
+	fs.StringVar(&s.name, "name", "", "Rule name.")
+	fs.IntVar(&s.priority, "priority", 0, "Rule priority, from 0 to 100. Rules with higher priority are evaluated first.")
+	s.params.addFlags(fs)
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("frontend"))
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("priority"))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("matching-condition", cobra.FixedCompletions(matchingConditions, cobra.ShellCompDirectiveNoFileComp)))
+	for _, flag := range []string{"name", "priority", "match", "action"} {
+		commands.Must(s.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("frontend", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerFrontend{LoadBalancer: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *createCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Creating rule %s into frontend %s of load balancer %v", s.name, s.frontend, arg)
+	exec.PushProgressStarted(msg)
+
+	if err := validatePriority(s.priority); err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	matchers, actions, err := s.params.processMatchersAndActions()
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	frontend, err := namedargs.ResolveLoadBalancerFrontend(exec, arg, s.frontend)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	res, err := exec.All().CreateLoadBalancerFrontendRule(exec.Context(), &request.CreateLoadBalancerFrontendRuleRequest{
+		ServiceUUID:  arg,
+		FrontendName: frontend,
+		Rule: request.LoadBalancerFrontendRule{
+			Name:              s.name,
+			Priority:          s.priority,
+			MatchingCondition: upcloud.LoadBalancerMatchingCondition(s.params.matchingCondition),
+			Matchers:          matchers,
+			Actions:           actions,
+		},
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
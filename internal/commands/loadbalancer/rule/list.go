@@ -0,0 +1,101 @@
+package loadbalancerrule
+
+import (
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ListCommand creates the "load-balancer rule list" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List rules of a load balancer frontend",
+			"upctl load-balancer rule list my-load-balancer --frontend web",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	frontend string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *listCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.frontend, "frontend", "", "Name of the frontend.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("frontend"))
+}
+
+func (s *listCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("frontend", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerFrontend{LoadBalancer: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *listCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	frontend, err := namedargs.ResolveLoadBalancerFrontend(exec, arg, s.frontend)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := exec.All().GetLoadBalancerFrontendRules(exec.Context(), &request.GetLoadBalancerFrontendRulesRequest{
+		ServiceUUID:  arg,
+		FrontendName: frontend,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortRules(rules)
+
+	rows := []output.TableRow{}
+	for _, rule := range rules {
+		matchers := []string{}
+		for _, m := range rule.Matchers {
+			matchers = append(matchers, matcherString(m))
+		}
+
+		actions := []string{}
+		for _, a := range rule.Actions {
+			actions = append(actions, actionString(a))
+		}
+
+		rows = append(rows, output.TableRow{
+			rule.Name,
+			rule.Priority,
+			rule.MatchingCondition,
+			strings.Join(matchers, "\n"),
+			strings.Join(actions, "\n"),
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: rules,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "priority", Header: "Priority"},
+				{Key: "matching_condition", Header: "Condition"},
+				{Key: "matchers", Header: "Matchers"},
+				{Key: "actions", Header: "Actions"},
+			},
+			Rows: rows,
+		},
+	}, nil
+}
@@ -0,0 +1,159 @@
+package loadbalancerrule
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ModifyCommand creates the "load-balancer rule modify" command
+func ModifyCommand() commands.Command {
+	return &modifyCommand{
+		BaseCommand: commands.New(
+			"modify",
+			"Modify a rule of a load balancer frontend",
+			"upctl load-balancer rule modify my-load-balancer --frontend web --name api --priority 60",
+			"upctl load-balancer rule modify my-load-balancer --frontend web --name api --match type=path,value=/api/v2 --action type=use_backend,backend=api-v2",
+		),
+	}
+}
+
+type modifyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	frontend string
+	name     string
+	newName  string
+	priority int
+	params   ruleParams
+}
+
+// InitCommand implements Command.InitCommand
+func (s *modifyCommand) InitCommand() {
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.frontend, "frontend", "", "Name of the frontend the rule belongs to.")
+	fs.StringVar(&s.name, "name", "", "Name of the rule to modify.")
+	fs.StringVar(&s.newName, "new-name", "", "New name for the rule.")
+	fs.IntVar(&s.priority, "priority", 0, "Rule priority, from 0 to 100. Rules with higher priority are evaluated first.")
+	s.params.addFlags(fs)
+	fs.Lookup("match").Usage += "\nIf set, all the existing matchers will be replaced with provided ones."
+	fs.Lookup("action").Usage += "\nIf set, all the existing actions will be replaced with provided ones."
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("frontend"))
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("matching-condition", cobra.FixedCompletions(matchingConditions, cobra.ShellCompDirectiveNoFileComp)))
+	for _, flag := range []string{"name", "new-name", "priority", "match", "action"} {
+		commands.Must(s.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
+	}
+}
+
+func (s *modifyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("frontend", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerFrontend{LoadBalancer: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *modifyCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Modifying rule %s of frontend %s of load balancer %v", s.name, s.frontend, arg)
+	exec.PushProgressStarted(msg)
+
+	priorityChanged := s.Cobra().Flags().Changed("priority")
+	if priorityChanged {
+		if err := validatePriority(s.priority); err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+
+	matchers, actions, err := s.params.processMatchersAndActions()
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	frontend, err := namedargs.ResolveLoadBalancerFrontend(exec, arg, s.frontend)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	svc := exec.All()
+
+	// Name and priority can be modified in place, other changes require replacing the whole rule
+	if len(matchers) == 0 && len(actions) == 0 && s.params.matchingCondition == "" {
+		rule := request.ModifyLoadBalancerFrontendRule{Name: s.newName}
+		if priorityChanged {
+			rule.Priority = upcloud.IntPtr(s.priority)
+		}
+
+		res, err := svc.ModifyLoadBalancerFrontendRule(exec.Context(), &request.ModifyLoadBalancerFrontendRuleRequest{
+			ServiceUUID:  arg,
+			FrontendName: frontend,
+			Name:         s.name,
+			Rule:         rule,
+		})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		exec.PushProgressSuccess(msg)
+
+		return output.OnlyMarshaled{Value: res}, nil
+	}
+
+	current, err := svc.GetLoadBalancerFrontendRule(exec.Context(), &request.GetLoadBalancerFrontendRuleRequest{
+		ServiceUUID:  arg,
+		FrontendName: frontend,
+		Name:         s.name,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	rule := request.LoadBalancerFrontendRule{
+		Name:              current.Name,
+		Priority:          current.Priority,
+		MatchingCondition: current.MatchingCondition,
+		Matchers:          current.Matchers,
+		Actions:           current.Actions,
+	}
+	if s.newName != "" {
+		rule.Name = s.newName
+	}
+	if priorityChanged {
+		rule.Priority = s.priority
+	}
+	if s.params.matchingCondition != "" {
+		rule.MatchingCondition = upcloud.LoadBalancerMatchingCondition(s.params.matchingCondition)
+	}
+	if len(matchers) > 0 {
+		rule.Matchers = matchers
+	}
+	if len(actions) > 0 {
+		rule.Actions = actions
+	}
+
+	res, err := svc.ReplaceLoadBalancerFrontendRule(exec.Context(), &request.ReplaceLoadBalancerFrontendRuleRequest{
+		ServiceUUID:  arg,
+		FrontendName: frontend,
+		Name:         s.name,
+		Rule:         rule,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: res}, nil
+}
@@ -0,0 +1,133 @@
+package loadbalancerrule
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const maxPriority = 100
+
+// ReorderCommand creates the "load-balancer rule reorder" command
+func ReorderCommand() commands.Command {
+	return &reorderCommand{
+		BaseCommand: commands.New(
+			"reorder",
+			"Change the evaluation order of load balancer frontend rules",
+			"upctl load-balancer rule reorder my-load-balancer --frontend web --rule to-https --rule api --rule static",
+		),
+	}
+}
+
+type reorderCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	frontend string
+	rules    []string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *reorderCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Change the evaluation order of load balancer frontend rules
+
+Rules are evaluated in descending priority order. This command sets the priorities of the given rules so that they are evaluated in the order they are listed: the first rule gets priority 100, the second 99, and so on. Rules that are not listed are evaluated after the listed rules in their current order, and their priorities are renumbered accordingly.`)
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.frontend, "frontend", "", "Name of the frontend the rules belong to.")
+	fs.StringArrayVar(&s.rules, "rule", []string{}, "Name of a rule, in the order the rules should be evaluated. Use multiple times to define the order of multiple rules.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("frontend"))
+	commands.Must(s.Cobra().MarkFlagRequired("rule"))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("rule", cobra.NoFileCompletions))
+}
+
+func (s *reorderCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("frontend", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerFrontend{LoadBalancer: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *reorderCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Reordering rules of frontend %s of load balancer %v", s.frontend, arg)
+	exec.PushProgressStarted(msg)
+
+	if len(s.rules) > maxPriority+1 {
+		return commands.HandleError(exec, msg, fmt.Errorf("can not order more than %d rules", maxPriority+1))
+	}
+
+	frontend, err := namedargs.ResolveLoadBalancerFrontend(exec, arg, s.frontend)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	svc := exec.All()
+	rules, err := svc.GetLoadBalancerFrontendRules(exec.Context(), &request.GetLoadBalancerFrontendRulesRequest{
+		ServiceUUID:  arg,
+		FrontendName: frontend,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	for i, name := range s.rules {
+		if slices.Contains(s.rules[:i], name) {
+			return commands.HandleError(exec, msg, fmt.Errorf("rule %s is listed more than once", name))
+		}
+
+		if !slices.ContainsFunc(rules, func(r upcloud.LoadBalancerFrontendRule) bool { return r.Name == name }) {
+			return commands.HandleError(exec, msg, fmt.Errorf("rule %s not found in frontend %s", name, frontend))
+		}
+	}
+
+	// Rules that are not listed are ordered after the listed rules, so that their priorities do not collide with the priorities of the listed rules.
+	unlisted := slices.DeleteFunc(slices.Clone(rules), func(r upcloud.LoadBalancerFrontendRule) bool {
+		return slices.Contains(s.rules, r.Name)
+	})
+	sortRules(unlisted)
+	ordered := slices.Clone(s.rules)
+	for _, rule := range unlisted {
+		ordered = append(ordered, rule.Name)
+	}
+	if len(ordered) > maxPriority+1 {
+		return commands.HandleError(exec, msg, fmt.Errorf("can not order more than %d rules", maxPriority+1))
+	}
+
+	for i, name := range ordered {
+		idx := slices.IndexFunc(rules, func(r upcloud.LoadBalancerFrontendRule) bool {
+			return r.Name == name
+		})
+
+		priority := maxPriority - i
+		if rules[idx].Priority == priority {
+			continue
+		}
+
+		_, err := svc.ModifyLoadBalancerFrontendRule(exec.Context(), &request.ModifyLoadBalancerFrontendRuleRequest{
+			ServiceUUID:  arg,
+			FrontendName: frontend,
+			Name:         name,
+			Rule:         request.ModifyLoadBalancerFrontendRule{Priority: upcloud.IntPtr(priority)},
+		})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
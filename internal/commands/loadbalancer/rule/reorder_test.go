@@ -0,0 +1,83 @@
+package loadbalancerrule
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReorderRules(t *testing.T) {
+	lbUUID := "17fbd082-30b0-11eb-adc1-0242ac120003"
+	rules := []upcloud.LoadBalancerFrontendRule{
+		{Name: "api", Priority: 100},
+		{Name: "static", Priority: 50},
+		{Name: "to-https", Priority: 10},
+	}
+
+	for _, test := range []struct {
+		name       string
+		args       []string
+		priorities map[string]int
+		errorMsg   string
+	}{
+		{
+			name:       "move rule first",
+			args:       []string{lbUUID, "--frontend", "web", "--rule", "to-https", "--rule", "api", "--rule", "static"},
+			priorities: map[string]int{"to-https": 100, "api": 99, "static": 98},
+		},
+		{
+			name:       "skip unchanged and renumber unlisted",
+			args:       []string{lbUUID, "--frontend", "web", "--rule", "api", "--rule", "to-https"},
+			priorities: map[string]int{"to-https": 99, "static": 98},
+		},
+		{
+			name:     "unknown rule",
+			args:     []string{lbUUID, "--frontend", "web", "--rule", "admin"},
+			errorMsg: "rule admin not found in frontend web",
+		},
+		{
+			name:     "duplicate rule",
+			args:     []string{lbUUID, "--frontend", "web", "--rule", "api", "--rule", "api"},
+			errorMsg: "rule api is listed more than once",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := ReorderCommand()
+			mService := new(smock.Service)
+
+			mService.On("GetLoadBalancerFrontends", &request.GetLoadBalancerFrontendsRequest{ServiceUUID: lbUUID}).Return([]upcloud.LoadBalancerFrontend{{Name: "web"}}, nil)
+			mService.On("GetLoadBalancerFrontendRules", &request.GetLoadBalancerFrontendRulesRequest{ServiceUUID: lbUUID, FrontendName: "web"}).Return(rules, nil)
+			for name, priority := range test.priorities {
+				mService.On("ModifyLoadBalancerFrontendRule", &request.ModifyLoadBalancerFrontendRuleRequest{
+					ServiceUUID:  lbUUID,
+					FrontendName: "web",
+					Name:         name,
+					Rule:         request.ModifyLoadBalancerFrontendRule{Priority: upcloud.IntPtr(priority)},
+				}).Return(&upcloud.LoadBalancerFrontendRule{}, nil)
+			}
+
+			c := commands.BuildCommand(testCmd, nil, conf)
+
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				mService.AssertNotCalled(t, "ModifyLoadBalancerFrontendRule", mock.Anything)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "ModifyLoadBalancerFrontendRule", len(test.priorities))
+			}
+		})
+	}
+}
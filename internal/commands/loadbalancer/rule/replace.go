@@ -0,0 +1,167 @@
+package loadbalancerrule
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// ReplaceCommand creates the "load-balancer rule replace" command
+func ReplaceCommand() commands.Command {
+	return &replaceCommand{
+		BaseCommand: commands.New(
+			"replace",
+			"Replace all rules of a load balancer frontend with rules defined in a file",
+			"upctl load-balancer rule replace my-load-balancer --frontend web --file rules.yaml",
+		),
+	}
+}
+
+type replaceCommand struct {
+	*commands.BaseCommand
+	resolver.CachingLoadBalancer
+	completion.LoadBalancer
+
+	frontend string
+	file     string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *replaceCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Replace all rules of a load balancer frontend with rules defined in a file
+
+The file should be a YAML or JSON document that contains the rules in the same format as the API uses under "rules" key. For example:
+
+  rules:
+    - name: api
+      priority: 50
+      matchers:
+        - type: path
+          path:
+            method: starts
+            value: /api
+      actions:
+        - type: use_backend
+          use_backend:
+            backend: api
+
+Rules that exist both in the frontend and in the file are replaced, rules that only exist in the file are created, and rules that only exist in the frontend are deleted. The file is validated before any changes are made and obsolete rules are deleted only after the other rules have been created or replaced. If a change fails, the error lists the changes that were already applied.`)
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.frontend, "frontend", "", "Name of the frontend to replace the rules of.")
+	fs.StringVar(&s.file, "file", "", "Path to a YAML or JSON file containing the rules.")
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("frontend"))
+	commands.Must(s.Cobra().MarkFlagRequired("file"))
+}
+
+func (s *replaceCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("frontend", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.LoadBalancerFrontend{LoadBalancer: args[0]}
+	}, cfg)))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *replaceCommand) ExecuteSingleArgument(exec commands.Executor, arg string) (output.Output, error) {
+	msg := fmt.Sprintf("Replacing rules of frontend %s of load balancer %v", s.frontend, arg)
+	exec.PushProgressStarted(msg)
+
+	rules, err := readRulesFile(s.file)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	frontend, err := namedargs.ResolveLoadBalancerFrontend(exec, arg, s.frontend)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	svc := exec.All()
+	current, err := svc.GetLoadBalancerFrontendRules(exec.Context(), &request.GetLoadBalancerFrontendRulesRequest{
+		ServiceUUID:  arg,
+		FrontendName: frontend,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exists := func(name string) bool {
+		return slices.ContainsFunc(current, func(r upcloud.LoadBalancerFrontendRule) bool {
+			return r.Name == name
+		})
+	}
+
+	// Create and replace rules before deleting the obsolete ones, so that the frontend is not left without routing for the deleted rules if the replace fails.
+	applied := []string{}
+	for _, rule := range rules {
+		if exists(rule.Name) {
+			_, err = svc.ReplaceLoadBalancerFrontendRule(exec.Context(), &request.ReplaceLoadBalancerFrontendRuleRequest{
+				ServiceUUID:  arg,
+				FrontendName: frontend,
+				Name:         rule.Name,
+				Rule:         rule,
+			})
+			if err != nil {
+				return commands.HandleError(exec, msg, replaceError("replace", rule.Name, applied, err))
+			}
+			applied = append(applied, "replaced "+rule.Name)
+		} else {
+			_, err = svc.CreateLoadBalancerFrontendRule(exec.Context(), &request.CreateLoadBalancerFrontendRuleRequest{
+				ServiceUUID:  arg,
+				FrontendName: frontend,
+				Rule:         rule,
+			})
+			if err != nil {
+				return commands.HandleError(exec, msg, replaceError("create", rule.Name, applied, err))
+			}
+			applied = append(applied, "created "+rule.Name)
+		}
+	}
+
+	for _, rule := range current {
+		if slices.ContainsFunc(rules, func(r request.LoadBalancerFrontendRule) bool { return r.Name == rule.Name }) {
+			continue
+		}
+
+		err = svc.DeleteLoadBalancerFrontendRule(exec.Context(), &request.DeleteLoadBalancerFrontendRuleRequest{
+			ServiceUUID:  arg,
+			FrontendName: frontend,
+			Name:         rule.Name,
+		})
+		if err != nil {
+			return commands.HandleError(exec, msg, replaceError("delete", rule.Name, applied, err))
+		}
+		applied = append(applied, "deleted "+rule.Name)
+	}
+
+	result, err := svc.GetLoadBalancerFrontendRules(exec.Context(), &request.GetLoadBalancerFrontendRulesRequest{
+		ServiceUUID:  arg,
+		FrontendName: frontend,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: result}, nil
+}
+
+// replaceError describes which changes were already applied to the frontend when replacing the rules failed.
+func replaceError(action, name string, applied []string, err error) error {
+	if len(applied) == 0 {
+		return fmt.Errorf("failed to %s rule %s, no changes were applied: %w", action, name, err)
+	}
+	return fmt.Errorf("failed to %s rule %s, changes already applied: %s: %w", action, name, strings.Join(applied, ", "), err)
+}
@@ -0,0 +1,82 @@
+package loadbalancerrule
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceRules(t *testing.T) {
+	lbUUID := "17fbd082-30b0-11eb-adc1-0242ac120003"
+	current := []upcloud.LoadBalancerFrontendRule{
+		{Name: "api", Priority: 100},
+		{Name: "old", Priority: 50},
+	}
+
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("rules:\n  - name: api\n    priority: 100\n  - name: web\n    priority: 10\n"), 0o600))
+
+	for _, test := range []struct {
+		name      string
+		createErr error
+		calls     []string
+		errorMsg  string
+	}{
+		{
+			name:  "deletes obsolete rules last",
+			calls: []string{"ReplaceLoadBalancerFrontendRule", "CreateLoadBalancerFrontendRule", "DeleteLoadBalancerFrontendRule"},
+		},
+		{
+			name:      "failure lists applied changes",
+			createErr: fmt.Errorf("priority conflict"),
+			calls:     []string{"ReplaceLoadBalancerFrontendRule", "CreateLoadBalancerFrontendRule"},
+			errorMsg:  "failed to create rule web, changes already applied: replaced api: priority conflict",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			mService := new(smock.Service)
+
+			mService.On("GetLoadBalancerFrontends", &request.GetLoadBalancerFrontendsRequest{ServiceUUID: lbUUID}).Return([]upcloud.LoadBalancerFrontend{{Name: "web"}}, nil)
+			mService.On("GetLoadBalancerFrontendRules", &request.GetLoadBalancerFrontendRulesRequest{ServiceUUID: lbUUID, FrontendName: "web"}).Return(current, nil)
+			mService.On("ReplaceLoadBalancerFrontendRule", mock.Anything).Return(&upcloud.LoadBalancerFrontendRule{}, nil)
+			if test.createErr != nil {
+				mService.On("CreateLoadBalancerFrontendRule", mock.Anything).Return(nil, test.createErr)
+			} else {
+				mService.On("CreateLoadBalancerFrontendRule", mock.Anything).Return(&upcloud.LoadBalancerFrontendRule{}, nil)
+			}
+			mService.On("DeleteLoadBalancerFrontendRule", &request.DeleteLoadBalancerFrontendRuleRequest{ServiceUUID: lbUUID, FrontendName: "web", Name: "old"}).Return(nil)
+
+			c := commands.BuildCommand(ReplaceCommand(), nil, conf)
+			c.Cobra().SetArgs([]string{lbUUID, "--frontend", "web", "--file", path})
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			calls := []string{}
+			for _, call := range mService.Calls {
+				switch call.Method {
+				case "ReplaceLoadBalancerFrontendRule", "CreateLoadBalancerFrontendRule", "DeleteLoadBalancerFrontendRule":
+					calls = append(calls, call.Method)
+				}
+			}
+			assert.Equal(t, test.calls, calls)
+		})
+	}
+}
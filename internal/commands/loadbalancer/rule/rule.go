@@ -0,0 +1,376 @@
+package loadbalancerrule
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+var (
+	matcherTypes = []string{
+		string(upcloud.LoadBalancerMatcherTypeSrcIP),
+		string(upcloud.LoadBalancerMatcherTypeSrcPort),
+		string(upcloud.LoadBalancerMatcherTypeBodySize),
+		string(upcloud.LoadBalancerMatcherTypePath),
+		string(upcloud.LoadBalancerMatcherTypeURL),
+		string(upcloud.LoadBalancerMatcherTypeURLQuery),
+		string(upcloud.LoadBalancerMatcherTypeHost),
+		string(upcloud.LoadBalancerMatcherTypeHTTPMethod),
+		string(upcloud.LoadBalancerMatcherTypeCookie),
+		string(upcloud.LoadBalancerMatcherTypeHeader),
+		string(upcloud.LoadBalancerMatcherTypeURLParam),
+		string(upcloud.LoadBalancerMatcherTypeNumMembersUp),
+	}
+	actionTypes = []string{
+		string(upcloud.LoadBalancerActionTypeUseBackend),
+		string(upcloud.LoadBalancerActionTypeTCPReject),
+		string(upcloud.LoadBalancerActionTypeHTTPReturn),
+		string(upcloud.LoadBalancerActionTypeHTTPRedirect),
+		string(upcloud.LoadBalancerActionTypeSetForwardedHeaders),
+	}
+	matchingConditions = []string{
+		string(upcloud.LoadBalancerMatchingConditionAnd),
+		string(upcloud.LoadBalancerMatchingConditionOr),
+	}
+)
+
+const (
+	matchHelp = "Matcher(s) of the rule, multiple can be declared.\n" +
+		"Usage: `--match type=path,value=/api` or `--match type=header,name=X-Api-Version,value=2,method=exact,ignore-case`\n" +
+		"Available types: src_ip, src_port, body_size, path, url, url_query, host, http_method, cookie, header, url_param, num_members_up. " +
+		"String matchers accept method (exact, substring, regexp, starts, ends, domain, ip) and ignore-case keys, path defaults to starts and other string matchers to exact. " +
+		"Integer matchers accept method (equal, greater, greater_or_equal, less, less_or_equal) key and default to equal. " +
+		"num_members_up matcher requires backend key. Add inverse key to negate the matcher."
+	actionHelp = "Action(s) of the rule, multiple can be declared.\n" +
+		"Usage: `--action type=use_backend,backend=api`, `--action type=http_redirect,scheme=https`, `--action type=http_redirect,location=https://example.com,status=301` or `--action type=http_return,status=403,content-type=text/plain,payload=Forbidden`\n" +
+		"Available types: use_backend, tcp_reject, http_return, http_redirect, set_forwarded_headers."
+)
+
+// BaseRuleCommand creates the base "load-balancer rule" command
+func BaseRuleCommand() commands.Command {
+	return &ruleCommand{
+		commands.New("rule", "Manage load balancer frontend rules"),
+	}
+}
+
+type ruleCommand struct {
+	*commands.BaseCommand
+}
+
+// ProcessMatcher parses a matcher from `type=path,value=/api` formatted string
+func ProcessMatcher(in string) (upcloud.LoadBalancerMatcher, error) {
+	var matcherType, value, method, name, backend string
+	var ignoreCase, inverse config.OptionalBoolean
+	m := upcloud.LoadBalancerMatcher{}
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&matcherType, "type", "", "")
+	fs.StringVar(&value, "value", "", "")
+	fs.StringVar(&method, "method", "", "")
+	fs.StringVar(&name, "name", "", "")
+	fs.StringVar(&backend, "backend", "", "")
+	config.AddToggleFlag(fs, &ignoreCase, "ignore-case", false, "")
+	config.AddToggleFlag(fs, &inverse, "inverse", false, "")
+
+	args, err := commands.ParseN(in, 2)
+	if err != nil {
+		return m, err
+	}
+
+	err = fs.Parse(args)
+	if err != nil {
+		return m, err
+	}
+
+	m.Type = upcloud.LoadBalancerMatcherType(matcherType)
+	if inverse.IsSet() {
+		m.Inverse = upcloud.BoolPtr(inverse.Value())
+	}
+
+	stringMatcher := func(defaultMethod upcloud.LoadBalancerStringMatcherMethod) *upcloud.LoadBalancerMatcherString {
+		s := &upcloud.LoadBalancerMatcherString{
+			Method: upcloud.LoadBalancerStringMatcherMethod(method),
+			Value:  value,
+		}
+		if method == "" {
+			s.Method = defaultMethod
+		}
+		if ignoreCase.IsSet() {
+			s.IgnoreCase = upcloud.BoolPtr(ignoreCase.Value())
+		}
+		return s
+	}
+	stringMatcherWithArgument := func() (*upcloud.LoadBalancerMatcherStringWithArgument, error) {
+		if name == "" {
+			return nil, fmt.Errorf("name is required for %s matcher", matcherType)
+		}
+		s := stringMatcher(upcloud.LoadBalancerStringMatcherMethodExact)
+		return &upcloud.LoadBalancerMatcherStringWithArgument{
+			Method:     s.Method,
+			Name:       name,
+			Value:      s.Value,
+			IgnoreCase: s.IgnoreCase,
+		}, nil
+	}
+	integerMatcher := func() (*upcloud.LoadBalancerMatcherInteger, error) {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for %s matcher, must be an integer", value, matcherType)
+		}
+		s := &upcloud.LoadBalancerMatcherInteger{
+			Method: upcloud.LoadBalancerIntegerMatcherMethod(method),
+			Value:  i,
+		}
+		if method == "" {
+			s.Method = upcloud.LoadBalancerIntegerMatcherMethodEqual
+		}
+		return s, nil
+	}
+
+	switch m.Type {
+	case upcloud.LoadBalancerMatcherTypeSrcIP:
+		m.SrcIP = &upcloud.LoadBalancerMatcherSourceIP{Value: value}
+	case upcloud.LoadBalancerMatcherTypeSrcPort:
+		m.SrcPort, err = integerMatcher()
+	case upcloud.LoadBalancerMatcherTypeBodySize:
+		m.BodySize, err = integerMatcher()
+	case upcloud.LoadBalancerMatcherTypePath:
+		m.Path = stringMatcher(upcloud.LoadBalancerStringMatcherMethodStarts)
+	case upcloud.LoadBalancerMatcherTypeURL:
+		m.URL = stringMatcher(upcloud.LoadBalancerStringMatcherMethodExact)
+	case upcloud.LoadBalancerMatcherTypeURLQuery:
+		m.URLQuery = stringMatcher(upcloud.LoadBalancerStringMatcherMethodExact)
+	case upcloud.LoadBalancerMatcherTypeHost:
+		m.Host = &upcloud.LoadBalancerMatcherHost{Value: value}
+	case upcloud.LoadBalancerMatcherTypeHTTPMethod:
+		m.HTTPMethod = &upcloud.LoadBalancerMatcherHTTPMethod{Value: upcloud.LoadBalancerHTTPMatcherMethod(strings.ToUpper(value))}
+	case upcloud.LoadBalancerMatcherTypeCookie:
+		m.Cookie, err = stringMatcherWithArgument()
+	case upcloud.LoadBalancerMatcherTypeHeader:
+		m.Header, err = stringMatcherWithArgument()
+	case upcloud.LoadBalancerMatcherTypeURLParam:
+		m.URLParam, err = stringMatcherWithArgument()
+	case upcloud.LoadBalancerMatcherTypeNumMembersUp:
+		if backend == "" {
+			return m, fmt.Errorf("backend is required for %s matcher", matcherType)
+		}
+		var i *upcloud.LoadBalancerMatcherInteger
+		i, err = integerMatcher()
+		if err == nil {
+			m.NumMembersUp = &upcloud.LoadBalancerMatcherNumMembersUp{
+				Method:  i.Method,
+				Value:   i.Value,
+				Backend: backend,
+			}
+		}
+	default:
+		return m, fmt.Errorf("invalid matcher type %q, must be one of: %s", matcherType, strings.Join(matcherTypes, ", "))
+	}
+
+	return m, err
+}
+
+// ProcessAction parses an action from `type=use_backend,backend=api` formatted string
+func ProcessAction(in string) (upcloud.LoadBalancerAction, error) {
+	var actionType, backend, location, scheme, contentType, payload string
+	var status int
+	a := upcloud.LoadBalancerAction{}
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&actionType, "type", "", "")
+	fs.StringVar(&backend, "backend", "", "")
+	fs.StringVar(&location, "location", "", "")
+	fs.StringVar(&scheme, "scheme", "", "")
+	fs.IntVar(&status, "status", 0, "")
+	fs.StringVar(&contentType, "content-type", "text/plain", "")
+	fs.StringVar(&payload, "payload", "", "")
+
+	args, err := commands.ParseN(in, 2)
+	if err != nil {
+		return a, err
+	}
+
+	err = fs.Parse(args)
+	if err != nil {
+		return a, err
+	}
+
+	a.Type = upcloud.LoadBalancerActionType(actionType)
+	switch a.Type {
+	case upcloud.LoadBalancerActionTypeUseBackend:
+		if backend == "" {
+			return a, fmt.Errorf("backend is required for %s action", actionType)
+		}
+		a.UseBackend = &upcloud.LoadBalancerActionUseBackend{Backend: backend}
+	case upcloud.LoadBalancerActionTypeTCPReject:
+		a.TCPReject = &upcloud.LoadBalancerActionTCPReject{}
+	case upcloud.LoadBalancerActionTypeHTTPReturn:
+		if status == 0 {
+			return a, fmt.Errorf("status is required for %s action", actionType)
+		}
+		a.HTTPReturn = &upcloud.LoadBalancerActionHTTPReturn{
+			Status:      status,
+			ContentType: contentType,
+			Payload:     base64.StdEncoding.EncodeToString([]byte(payload)),
+		}
+	case upcloud.LoadBalancerActionTypeHTTPRedirect:
+		if (location == "") == (scheme == "") {
+			return a, fmt.Errorf("either location or scheme is required for %s action", actionType)
+		}
+		a.HTTPRedirect = &upcloud.LoadBalancerActionHTTPRedirect{
+			Location: location,
+			Scheme:   upcloud.LoadBalancerActionHTTPRedirectScheme(scheme),
+			Status:   status,
+		}
+	case upcloud.LoadBalancerActionTypeSetForwardedHeaders:
+		a.SetForwardedHeaders = &upcloud.LoadBalancerActionSetForwardedHeaders{}
+	default:
+		return a, fmt.Errorf("invalid action type %q, must be one of: %s", actionType, strings.Join(actionTypes, ", "))
+	}
+
+	return a, nil
+}
+
+// ruleParams contains the flags shared by rule create and modify commands
+type ruleParams struct {
+	matchers          []string
+	actions           []string
+	matchingCondition string
+}
+
+func (p *ruleParams) addFlags(fs *pflag.FlagSet) {
+	fs.StringArrayVar(&p.matchers, "match", []string{}, matchHelp)
+	fs.StringArrayVar(&p.actions, "action", []string{}, actionHelp)
+	fs.StringVar(&p.matchingCondition, "matching-condition", "", "Defines whether all (`and`) or any (`or`) of the matchers must match for the actions to be executed. Defaults to `and`.")
+}
+
+func (p *ruleParams) processMatchersAndActions() ([]upcloud.LoadBalancerMatcher, []upcloud.LoadBalancerAction, error) {
+	if p.matchingCondition != "" && !slices.Contains(matchingConditions, p.matchingCondition) {
+		return nil, nil, fmt.Errorf("invalid matching condition %q, must be one of: %s", p.matchingCondition, strings.Join(matchingConditions, ", "))
+	}
+
+	matchers := make([]upcloud.LoadBalancerMatcher, 0)
+	for _, v := range p.matchers {
+		m, err := ProcessMatcher(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		matchers = append(matchers, m)
+	}
+
+	actions := make([]upcloud.LoadBalancerAction, 0)
+	for _, v := range p.actions {
+		a, err := ProcessAction(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		actions = append(actions, a)
+	}
+
+	return matchers, actions, nil
+}
+
+type rulesFile struct {
+	Rules []request.LoadBalancerFrontendRule `json:"rules"`
+}
+
+// readRulesFile reads frontend rules from a YAML or JSON file. The file should contain the rules in API format under `rules` key.
+func readRulesFile(path string) ([]request.LoadBalancerFrontendRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f rulesFile
+	if err := commands.UnmarshalYAMLStrict(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse rules file: %w", err)
+	}
+
+	names := make(map[string]bool)
+	for i, rule := range f.Rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("rule at index %d does not have a name", i)
+		}
+		if names[rule.Name] {
+			return nil, fmt.Errorf("rule name %s is used more than once", rule.Name)
+		}
+		names[rule.Name] = true
+	}
+
+	return f.Rules, nil
+}
+
+func matcherString(m upcloud.LoadBalancerMatcher) string {
+	var s string
+	switch {
+	case m.SrcIP != nil:
+		s = fmt.Sprintf("%s %s", m.Type, m.SrcIP.Value)
+	case m.SrcPort != nil:
+		s = fmt.Sprintf("%s %s %d", m.Type, m.SrcPort.Method, m.SrcPort.Value)
+	case m.BodySize != nil:
+		s = fmt.Sprintf("%s %s %d", m.Type, m.BodySize.Method, m.BodySize.Value)
+	case m.Path != nil:
+		s = fmt.Sprintf("%s %s %s", m.Type, m.Path.Method, m.Path.Value)
+	case m.URL != nil:
+		s = fmt.Sprintf("%s %s %s", m.Type, m.URL.Method, m.URL.Value)
+	case m.URLQuery != nil:
+		s = fmt.Sprintf("%s %s %s", m.Type, m.URLQuery.Method, m.URLQuery.Value)
+	case m.Host != nil:
+		s = fmt.Sprintf("%s %s", m.Type, m.Host.Value)
+	case m.HTTPMethod != nil:
+		s = fmt.Sprintf("%s %s", m.Type, m.HTTPMethod.Value)
+	case m.Cookie != nil:
+		s = fmt.Sprintf("%s %s %s %s", m.Type, m.Cookie.Name, m.Cookie.Method, m.Cookie.Value)
+	case m.Header != nil:
+		s = fmt.Sprintf("%s %s %s %s", m.Type, m.Header.Name, m.Header.Method, m.Header.Value)
+	case m.URLParam != nil:
+		s = fmt.Sprintf("%s %s %s %s", m.Type, m.URLParam.Name, m.URLParam.Method, m.URLParam.Value)
+	case m.NumMembersUp != nil:
+		s = fmt.Sprintf("%s %s %s %d", m.Type, m.NumMembersUp.Backend, m.NumMembersUp.Method, m.NumMembersUp.Value)
+	default:
+		s = string(m.Type)
+	}
+
+	if m.Inverse != nil && *m.Inverse {
+		s = "not " + s
+	}
+	return strings.TrimSpace(s)
+}
+
+func actionString(a upcloud.LoadBalancerAction) string {
+	switch {
+	case a.UseBackend != nil:
+		return fmt.Sprintf("%s %s", a.Type, a.UseBackend.Backend)
+	case a.HTTPReturn != nil:
+		return fmt.Sprintf("%s %d", a.Type, a.HTTPReturn.Status)
+	case a.HTTPRedirect != nil && a.HTTPRedirect.Location != "":
+		return fmt.Sprintf("%s %s", a.Type, a.HTTPRedirect.Location)
+	case a.HTTPRedirect != nil:
+		return fmt.Sprintf("%s %s", a.Type, a.HTTPRedirect.Scheme)
+	default:
+		return string(a.Type)
+	}
+}
+
+func validatePriority(priority int) error {
+	if priority < 0 || priority > 100 {
+		return fmt.Errorf("invalid priority %d, must be between 0 and 100", priority)
+	}
+	return nil
+}
+
+// sortRules sorts rules to evaluation order, i.e. by descending priority.
+func sortRules(rules []upcloud.LoadBalancerFrontendRule) {
+	slices.SortStableFunc(rules, func(a, b upcloud.LoadBalancerFrontendRule) int {
+		return b.Priority - a.Priority
+	})
+}
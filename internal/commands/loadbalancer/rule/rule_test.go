@@ -0,0 +1,247 @@
+package loadbalancerrule
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessMatcher(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		in       string
+		expected upcloud.LoadBalancerMatcher
+		errorMsg string
+	}{
+		{
+			name: "path defaults to starts",
+			in:   "type=path,value=/api",
+			expected: upcloud.LoadBalancerMatcher{
+				Type: upcloud.LoadBalancerMatcherTypePath,
+				Path: &upcloud.LoadBalancerMatcherString{Method: upcloud.LoadBalancerStringMatcherMethodStarts, Value: "/api"},
+			},
+		},
+		{
+			name: "inverse host",
+			in:   "type=host,value=example.com,inverse",
+			expected: upcloud.LoadBalancerMatcher{
+				Type:    upcloud.LoadBalancerMatcherTypeHost,
+				Inverse: upcloud.BoolPtr(true),
+				Host:    &upcloud.LoadBalancerMatcherHost{Value: "example.com"},
+			},
+		},
+		{
+			name: "header with method and ignore case",
+			in:   "type=header,name=User-Agent,value=bot,method=substring,ignore-case",
+			expected: upcloud.LoadBalancerMatcher{
+				Type: upcloud.LoadBalancerMatcherTypeHeader,
+				Header: &upcloud.LoadBalancerMatcherStringWithArgument{
+					Method:     upcloud.LoadBalancerStringMatcherMethodSubstring,
+					Name:       "User-Agent",
+					Value:      "bot",
+					IgnoreCase: upcloud.BoolPtr(true),
+				},
+			},
+		},
+		{
+			name: "src ip",
+			in:   "type=src_ip,value=192.0.2.0/24",
+			expected: upcloud.LoadBalancerMatcher{
+				Type:  upcloud.LoadBalancerMatcherTypeSrcIP,
+				SrcIP: &upcloud.LoadBalancerMatcherSourceIP{Value: "192.0.2.0/24"},
+			},
+		},
+		{
+			name: "src port",
+			in:   "type=src_port,value=80",
+			expected: upcloud.LoadBalancerMatcher{
+				Type:    upcloud.LoadBalancerMatcherTypeSrcPort,
+				SrcPort: &upcloud.LoadBalancerMatcherInteger{Method: upcloud.LoadBalancerIntegerMatcherMethodEqual, Value: 80},
+			},
+		},
+		{
+			name: "num members up",
+			in:   "type=num_members_up,backend=api,value=1,method=less",
+			expected: upcloud.LoadBalancerMatcher{
+				Type:         upcloud.LoadBalancerMatcherTypeNumMembersUp,
+				NumMembersUp: &upcloud.LoadBalancerMatcherNumMembersUp{Method: upcloud.LoadBalancerIntegerMatcherMethodLess, Value: 1, Backend: "api"},
+			},
+		},
+		{
+			name:     "header without name",
+			in:       "type=header,value=bot",
+			errorMsg: "name is required for header matcher",
+		},
+		{
+			name:     "non-integer port",
+			in:       "type=src_port,value=http",
+			errorMsg: `invalid value "http" for src_port matcher, must be an integer`,
+		},
+		{
+			name:     "unknown type",
+			in:       "type=referer,value=example.com",
+			errorMsg: `invalid matcher type "referer", must be one of: src_ip, src_port, body_size, path, url, url_query, host, http_method, cookie, header, url_param, num_members_up`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := ProcessMatcher(test.in)
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, m)
+			}
+		})
+	}
+}
+
+func TestProcessAction(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		in       string
+		expected upcloud.LoadBalancerAction
+		errorMsg string
+	}{
+		{
+			name: "use backend",
+			in:   "type=use_backend,backend=api",
+			expected: upcloud.LoadBalancerAction{
+				Type:       upcloud.LoadBalancerActionTypeUseBackend,
+				UseBackend: &upcloud.LoadBalancerActionUseBackend{Backend: "api"},
+			},
+		},
+		{
+			name: "redirect to https",
+			in:   "type=http_redirect,scheme=https",
+			expected: upcloud.LoadBalancerAction{
+				Type:         upcloud.LoadBalancerActionTypeHTTPRedirect,
+				HTTPRedirect: &upcloud.LoadBalancerActionHTTPRedirect{Scheme: upcloud.LoadBalancerActionHTTPRedirectScheme("https")},
+			},
+		},
+		{
+			name: "http return",
+			in:   "type=http_return,status=403,payload=Forbidden",
+			expected: upcloud.LoadBalancerAction{
+				Type: upcloud.LoadBalancerActionTypeHTTPReturn,
+				HTTPReturn: &upcloud.LoadBalancerActionHTTPReturn{
+					Status:      403,
+					ContentType: "text/plain",
+					Payload:     "Rm9yYmlkZGVu",
+				},
+			},
+		},
+		{
+			name: "set forwarded headers",
+			in:   "type=set_forwarded_headers",
+			expected: upcloud.LoadBalancerAction{
+				Type:                upcloud.LoadBalancerActionTypeSetForwardedHeaders,
+				SetForwardedHeaders: &upcloud.LoadBalancerActionSetForwardedHeaders{},
+			},
+		},
+		{
+			name:     "use backend without backend",
+			in:       "type=use_backend",
+			errorMsg: "backend is required for use_backend action",
+		},
+		{
+			name:     "redirect with both location and scheme",
+			in:       "type=http_redirect,scheme=https,location=https://example.com",
+			errorMsg: "either location or scheme is required for http_redirect action",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			a, err := ProcessAction(test.in)
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, a)
+			}
+		})
+	}
+}
+
+func TestReadRulesFile(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, test := range []struct {
+		name     string
+		content  string
+		expected []request.LoadBalancerFrontendRule
+		errorMsg string
+	}{
+		{
+			name: "yaml",
+			content: `rules:
+  - name: api
+    priority: 50
+    matchers:
+      - type: path
+        path:
+          method: starts
+          value: /api
+    actions:
+      - type: use_backend
+        use_backend:
+          backend: api
+`,
+			expected: []request.LoadBalancerFrontendRule{
+				{
+					Name:     "api",
+					Priority: 50,
+					Matchers: []upcloud.LoadBalancerMatcher{{
+						Type: upcloud.LoadBalancerMatcherTypePath,
+						Path: &upcloud.LoadBalancerMatcherString{Method: upcloud.LoadBalancerStringMatcherMethodStarts, Value: "/api"},
+					}},
+					Actions: []upcloud.LoadBalancerAction{{
+						Type:       upcloud.LoadBalancerActionTypeUseBackend,
+						UseBackend: &upcloud.LoadBalancerActionUseBackend{Backend: "api"},
+					}},
+				},
+			},
+		},
+		{
+			name:    "json",
+			content: `{"rules": [{"name": "reject", "priority": 10, "matchers": [], "actions": [{"type": "tcp_reject", "tcp_reject": {}}]}]}`,
+			expected: []request.LoadBalancerFrontendRule{
+				{
+					Name:     "reject",
+					Priority: 10,
+					Matchers: []upcloud.LoadBalancerMatcher{},
+					Actions: []upcloud.LoadBalancerAction{{
+						Type:      upcloud.LoadBalancerActionTypeTCPReject,
+						TCPReject: &upcloud.LoadBalancerActionTCPReject{},
+					}},
+				},
+			},
+		},
+		{
+			name:     "duplicate names",
+			content:  "rules:\n  - name: api\n    priority: 1\n  - name: api\n    priority: 2\n",
+			errorMsg: "rule name api is used more than once",
+		},
+		{
+			name:     "unknown field",
+			content:  "rules:\n  - name: api\n    prio: 1\n",
+			errorMsg: `failed to parse rules file: json: unknown field "prio"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(dir, test.name+".yaml")
+			require.NoError(t, os.WriteFile(path, []byte(test.content), 0o600))
+
+			rules, err := readRulesFile(path)
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, rules)
+			}
+		})
+	}
+}
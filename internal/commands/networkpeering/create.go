@@ -0,0 +1,121 @@
+package networkpeering
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/spf13/pflag"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// CreateCommand creates the "network-peering create" command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a network peering",
+			"upctl network-peering create --name my-peering --network my-network --peer-network 03585987-bf7d-4544-8e9b-5a1b4d74a333",
+			"upctl network-peering create --name my-peering --network 0312e6b1-b8be-4f2d-a4e2-9ee4d2d1d1b9 --peer-network 03585987-bf7d-4544-8e9b-5a1b4d74a333 --label env=dev --wait",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+
+	name        string
+	network     string
+	peerNetwork string
+	labels      []string
+	disabled    config.OptionalBoolean
+	wait        config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Create a network peering
+
+Network peering connects a private network in your account to a private network in another account, or in your own account. The peering becomes active only after a matching peering has been created from the peer network to the local network. Until then, the peering is in pending-peer state.`)
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&s.name, "name", "", "Name of the network peering.")
+	fs.StringVar(&s.network, "network", "", "Local network to peer, specified by network UUID or name.")
+	fs.StringVar(&s.peerNetwork, "peer-network", "", "UUID of the network to peer with. The peer network can belong to another account, in which case the UUID must be provided by the owner of that account.")
+	fs.StringArrayVar(&s.labels, "label", nil, "Labels to describe the network peering in `key=value` format, multiple can be declared.")
+	config.AddToggleFlag(fs, &s.disabled, "disabled", false, "Create the network peering in disabled state.")
+	config.AddToggleFlag(fs, &s.wait, "wait", false, "Wait for network peering to be in active state before returning. The peering becomes active only after the peer has created a matching peering.")
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("peer-network", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("label", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(fs)
+
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("network"))
+	commands.Must(s.Cobra().MarkFlagRequired("peer-network"))
+}
+
+func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("network", namedargs.CompletionFunc(completion.Network{}, cfg)))
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	msg := fmt.Sprintf("Creating network peering %s", s.name)
+	exec.PushProgressStarted(msg)
+
+	if s.disabled.Value() && s.wait.Value() {
+		return commands.HandleError(exec, msg, fmt.Errorf("--wait cannot be used with --disabled"))
+	}
+
+	networkUUID, err := namedargs.ResolveNetwork(exec, s.network)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	req := request.CreateNetworkPeeringRequest{
+		NetworkPeering: request.NetworkPeering{
+			Name:             s.name,
+			ConfiguredStatus: upcloud.NetworkPeeringConfiguredStatusActive,
+			Network:          request.NetworkPeeringNetwork{UUID: networkUUID},
+			PeerNetwork:      request.NetworkPeeringNetwork{UUID: s.peerNetwork},
+		},
+	}
+
+	if s.disabled.Value() {
+		req.NetworkPeering.ConfiguredStatus = upcloud.NetworkPeeringConfiguredStatusDisabled
+	}
+
+	if len(s.labels) > 0 {
+		labelSlice, err := labels.StringsToSliceOfLabels(s.labels)
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+
+		req.NetworkPeering.Labels = labelSlice
+	}
+
+	res, err := exec.All().CreateNetworkPeering(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if s.wait.Value() {
+		waitForNetworkPeeringState(res.UUID, upcloud.NetworkPeeringStateActive, exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "UUID", Value: res.UUID, Colour: ui.DefaultUUUIDColours},
+		{Title: "State", Value: res.State, Format: format.NetworkPeeringState},
+	}}, nil
+}
@@ -0,0 +1,89 @@
+package networkpeering
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommand(t *testing.T) {
+	networks := &upcloud.Networks{Networks: []upcloud.Network{
+		{Name: "my-network", UUID: "0312e6b1-b8be-4f2d-a4e2-9ee4d2d1d1b9"},
+	}}
+	peerNetworkUUID := "03585987-bf7d-4544-8e9b-5a1b4d74a333"
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected request.CreateNetworkPeeringRequest
+		errorMsg string
+	}{
+		{
+			name: "active",
+			args: []string{"--name", "my-peering", "--network", "my-network", "--peer-network", peerNetworkUUID, "--label", "env=test"},
+			expected: request.CreateNetworkPeeringRequest{
+				NetworkPeering: request.NetworkPeering{
+					Name:             "my-peering",
+					ConfiguredStatus: upcloud.NetworkPeeringConfiguredStatusActive,
+					Network:          request.NetworkPeeringNetwork{UUID: "0312e6b1-b8be-4f2d-a4e2-9ee4d2d1d1b9"},
+					PeerNetwork:      request.NetworkPeeringNetwork{UUID: peerNetworkUUID},
+					Labels:           []upcloud.Label{{Key: "env", Value: "test"}},
+				},
+			},
+		},
+		{
+			name: "disabled",
+			args: []string{"--name", "my-peering", "--network", "0312e6b1-b8be-4f2d-a4e2-9ee4d2d1d1b9", "--peer-network", peerNetworkUUID, "--disabled"},
+			expected: request.CreateNetworkPeeringRequest{
+				NetworkPeering: request.NetworkPeering{
+					Name:             "my-peering",
+					ConfiguredStatus: upcloud.NetworkPeeringConfiguredStatusDisabled,
+					Network:          request.NetworkPeeringNetwork{UUID: "0312e6b1-b8be-4f2d-a4e2-9ee4d2d1d1b9"},
+					PeerNetwork:      request.NetworkPeeringNetwork{UUID: peerNetworkUUID},
+				},
+			},
+		},
+		{
+			name:     "disabled with wait",
+			args:     []string{"--name", "my-peering", "--network", "my-network", "--peer-network", peerNetworkUUID, "--disabled", "--wait"},
+			errorMsg: "--wait cannot be used with --disabled",
+		},
+		{
+			name:     "unknown network",
+			args:     []string{"--name", "my-peering", "--network", "other-network", "--peer-network", peerNetworkUUID},
+			errorMsg: "could not resolve network: nothing found matching 'other-network'",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			testCmd := CreateCommand()
+			mService := new(smock.Service)
+
+			expected := test.expected
+			mService.On("GetNetworks").Return(networks, nil)
+			mService.On("CreateNetworkPeering", &expected).Return(&upcloud.NetworkPeering{UUID: "0f7984bc-5d72-4aaf-b587-90e6a8f32efc"}, nil)
+
+			c := commands.BuildCommand(testCmd, nil, conf)
+
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				mService.AssertNotCalled(t, "CreateNetworkPeering", mock.Anything)
+			} else {
+				require.NoError(t, err)
+				mService.AssertNumberOfCalls(t, "CreateNetworkPeering", 1)
+			}
+		})
+	}
+}
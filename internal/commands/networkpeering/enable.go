@@ -0,0 +1,72 @@
+package networkpeering
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/spf13/pflag"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// EnableCommand creates the "network-peering enable" command
+func EnableCommand() commands.Command {
+	return &enableCommand{
+		BaseCommand: commands.New(
+			"enable",
+			"Enable a network peering",
+			"upctl network-peering enable 8abc8009-4325-4b23-4321-b1232cd81231",
+			"upctl network-peering enable my-network-peering --wait",
+		),
+	}
+}
+
+type enableCommand struct {
+	*commands.BaseCommand
+	resolver.CachingNetworkPeering
+	completion.NetworkPeering
+
+	wait config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (c *enableCommand) InitCommand() {
+	flags := &pflag.FlagSet{}
+	config.AddToggleFlag(flags, &c.wait, "wait", false, "Wait for network peering to be in active state before returning.")
+	c.AddFlags(flags)
+}
+
+func enablePeering(exec commands.Executor, uuid string, wait bool) (output.Output, error) {
+	svc := exec.All()
+	msg := fmt.Sprintf("Enabling network peering %v", uuid)
+
+	exec.PushProgressStarted(msg)
+
+	peering, err := svc.ModifyNetworkPeering(exec.Context(), &request.ModifyNetworkPeeringRequest{
+		UUID: uuid,
+		NetworkPeering: request.ModifyNetworkPeering{
+			ConfiguredStatus: upcloud.NetworkPeeringConfiguredStatusActive,
+		},
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if wait {
+		waitForNetworkPeeringState(uuid, upcloud.NetworkPeeringStateActive, exec, msg)
+	} else {
+		exec.PushProgressSuccess(msg)
+	}
+
+	return output.OnlyMarshaled{Value: peering}, err
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (c *enableCommand) Execute(exec commands.Executor, arg string) (output.Output, error) {
+	return enablePeering(exec, arg, c.wait.Value())
+}
@@ -0,0 +1,62 @@
+package networkpeering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnableCommand(t *testing.T) {
+	waitPollInterval = time.Millisecond
+
+	uuid := "9cb62e7d-e95f-4eaa-9c8b-9c6f5e2a66db"
+	modifyReq := request.ModifyNetworkPeeringRequest{
+		UUID: uuid,
+		NetworkPeering: request.ModifyNetworkPeering{
+			ConfiguredStatus: upcloud.NetworkPeeringConfiguredStatusActive,
+		},
+	}
+	getReq := request.GetNetworkPeeringRequest{UUID: uuid}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		getCalls int
+	}{
+		{
+			name:     "without wait",
+			args:     []string{uuid},
+			getCalls: 0,
+		},
+		{
+			name:     "with wait",
+			args:     []string{uuid, "--wait"},
+			getCalls: 2,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := smock.Service{}
+			mService.On("ModifyNetworkPeering", &modifyReq).Return(&upcloud.NetworkPeering{UUID: uuid, State: upcloud.NetworkPeeringStateDisabled}, nil)
+			mService.On("GetNetworkPeering", &getReq).Return(&upcloud.NetworkPeering{UUID: uuid, State: upcloud.NetworkPeeringStateProvisioning}, nil).Once()
+			mService.On("GetNetworkPeering", &getReq).Return(&upcloud.NetworkPeering{UUID: uuid, State: upcloud.NetworkPeeringStateActive}, nil)
+
+			conf := config.New()
+			command := commands.BuildCommand(EnableCommand(), nil, conf)
+
+			command.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(command, &mService, conf)
+
+			assert.NoError(t, err)
+			mService.AssertNumberOfCalls(t, "ModifyNetworkPeering", 1)
+			mService.AssertNumberOfCalls(t, "GetNetworkPeering", test.getCalls)
+		})
+	}
+}
@@ -7,12 +7,16 @@ import (
 
 	"github.com/UpCloudLtd/progress/messages"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 )
 
 const deprecatedAliasNetworkPeering = "networkpeering"
 
+// waitPollInterval defines how often the network peering state is polled when waiting for a state change
+var waitPollInterval = 5 * time.Second
+
 // BaseNetworkPeeringCommand creates the base "networkpeering" command
 func BaseNetworkPeeringCommand() commands.Command {
 	baseCmd := commands.New("network-peering", "Manage network peerings")
@@ -35,24 +39,45 @@ func (np *networkpeeringCommand) InitCommand() {
 	commands.SetDeprecationHelp(np.Cobra(), np.DeprecatedAliases())
 }
 
-// waitForNetworkPeeringState waits for network peering to reach given state and updates progress message with key matching given msg. Finally, progress message is updated back to given msg and either done state or timeout warning.
+// colouredNetworkPeeringState returns given state as string coloured with network peering state colours
+func colouredNetworkPeeringState(state upcloud.NetworkPeeringState) string {
+	colours, str, err := format.NetworkPeeringState(state)
+	if err != nil {
+		return string(state)
+	}
+	return colours.Sprint(str)
+}
+
+// waitForNetworkPeeringState waits for network peering to reach given state and updates progress message with key matching given msg. While waiting, the progress message displays the current state of the network peering. Finally, progress message is updated back to given msg and either done state or timeout warning.
 func waitForNetworkPeeringState(uuid string, state upcloud.NetworkPeeringState, exec commands.Executor, msg string) {
-	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for network peering %s to be in %s state", uuid, state))
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for network peering %s to be in %s state", uuid, colouredNetworkPeeringState(state)))
 
 	ctx, cancel := context.WithTimeout(exec.Context(), 15*time.Minute)
 	defer cancel()
 
-	if _, err := exec.All().WaitForNetworkPeeringState(ctx, &request.WaitForNetworkPeeringStateRequest{
-		UUID:         uuid,
-		DesiredState: state,
-	}); err != nil {
-		exec.PushProgressUpdate(messages.Update{
-			Key:     msg,
-			Message: msg,
-			Status:  messages.MessageStatusWarning,
-			Details: "Error: " + err.Error(),
-		})
-		return
+	for {
+		peering, err := exec.All().GetNetworkPeering(ctx, &request.GetNetworkPeeringRequest{UUID: uuid})
+		if err == nil && peering.State == state {
+			break
+		}
+		if err == nil {
+			exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for network peering %s to be in %s state (current state: %s)", uuid, colouredNetworkPeeringState(state), colouredNetworkPeeringState(peering.State)))
+		}
+
+		select {
+		case <-ctx.Done():
+			if err == nil {
+				err = ctx.Err()
+			}
+			exec.PushProgressUpdate(messages.Update{
+				Key:     msg,
+				Message: msg,
+				Status:  messages.MessageStatusWarning,
+				Details: "Error: " + err.Error(),
+			})
+			return
+		case <-time.After(waitPollInterval):
+		}
 	}
 
 	exec.PushProgressUpdateMessage(msg, msg)
@@ -3,8 +3,10 @@ package accesskey
 import (
 	"fmt"
 
+	"github.com/UpCloudLtd/progress/messages"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
@@ -18,6 +20,7 @@ func CreateCommand() commands.Command {
 			"Create an access key for a user in managed object storage service",
 			"upctl object-storage access-key create <service-uuid> --username myuser",
 			"upctl object-storage access-key create my-service --username myuser",
+			"upctl object-storage access-key create my-service --username myuser --save-to-keyring",
 		),
 	}
 }
@@ -26,7 +29,8 @@ type createCommand struct {
 	*commands.BaseCommand
 	completion.ObjectStorage
 	resolver.CachingObjectStorage
-	params request.CreateManagedObjectStorageUserAccessKeyRequest
+	params        request.CreateManagedObjectStorageUserAccessKeyRequest
+	saveToKeyring config.OptionalBoolean
 }
 
 // InitCommand implements Command.InitCommand
@@ -35,6 +39,7 @@ func (s *createCommand) InitCommand() {
 
 	fs := s.Cobra().Flags()
 	fs.StringVar(&s.params.Username, "username", "", "The username to create the access key for.")
+	config.AddToggleFlag(fs, &s.saveToKeyring, "save-to-keyring", false, "Save the secret access key to the system keyring. The saved secret is used by the `object-storage object` commands when the access key is selected with `--access-key-id`.")
 	commands.Must(s.Cobra().MarkFlagRequired("username"))
 }
 
@@ -54,6 +59,22 @@ func (s *createCommand) Execute(exec commands.Executor, serviceUUID string) (out
 
 	exec.PushProgressSuccess(msg)
 
+	if s.saveToKeyring.Value() && res.SecretAccessKey != nil {
+		msg := fmt.Sprintf("Saving secret access key of %v to the system keyring", res.AccessKeyID)
+		exec.PushProgressStarted(msg)
+		// The access key has already been created, so show the secret in the output even if saving it fails.
+		if err := config.SaveObjectStorageSecretToKeyring(res.AccessKeyID, *res.SecretAccessKey); err != nil {
+			exec.PushProgressUpdate(messages.Update{
+				Key:     msg,
+				Message: msg,
+				Status:  messages.MessageStatusWarning,
+				Details: "Error: " + err.Error(),
+			})
+		} else {
+			exec.PushProgressSuccess(msg)
+		}
+	}
+
 	// Handle SecretAccessKey which might be a pointer
 	var secretKey any
 	if res.SecretAccessKey != nil {
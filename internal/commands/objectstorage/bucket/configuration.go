@@ -0,0 +1,227 @@
+package bucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/policy"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3"
+	"github.com/spf13/pflag"
+)
+
+// versioningDisabled is displayed as the versioning status of buckets that have never had versioning enabled.
+const versioningDisabled = "Disabled"
+
+var (
+	lifecycleRuleStatuses = []string{s3.LifecycleRuleEnabled, s3.LifecycleRuleDisabled}
+	publicReadActions     = []string{"s3:GetObject", "s3:Get*", "s3:*", "*"}
+)
+
+// configuration contains the settings of a bucket that are managed over the S3 API.
+type configuration struct {
+	Name           string             `json:"name"`
+	Versioning     string             `json:"versioning"`
+	LifecycleRules []s3.LifecycleRule `json:"lifecycle_rules"`
+	Policy         json.RawMessage    `json:"policy"`
+}
+
+func getConfiguration(ctx context.Context, client *s3.Client, bucket string) (*configuration, error) {
+	versioning, err := client.GetBucketVersioning(ctx, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get versioning status of bucket %s: %w", bucket, err)
+	}
+	if versioning == "" {
+		versioning = versioningDisabled
+	}
+
+	rules, err := client.GetBucketLifecycle(ctx, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get lifecycle configuration of bucket %s: %w", bucket, err)
+	}
+
+	document, err := client.GetBucketPolicy(ctx, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get policy of bucket %s: %w", bucket, err)
+	}
+
+	return &configuration{
+		Name:           bucket,
+		Versioning:     versioning,
+		LifecycleRules: rules,
+		Policy:         document,
+	}, nil
+}
+
+// parseLifecycleRule parses a lifecycle rule from `prefix=logs/,days=30` formatted string.
+func parseLifecycleRule(in string) (s3.LifecycleRule, error) {
+	var disabled config.OptionalBoolean
+	rule := s3.LifecycleRule{}
+
+	fs := &pflag.FlagSet{}
+	fs.StringVar(&rule.ID, "id", "", "")
+	fs.StringVar(&rule.Prefix, "prefix", "", "")
+	fs.IntVar(&rule.ExpirationDays, "days", 0, "")
+	fs.IntVar(&rule.NoncurrentVersionExpirationDays, "noncurrent-days", 0, "")
+	config.AddToggleFlag(fs, &disabled, "disabled", false, "")
+
+	args, err := commands.ParseN(in, 2)
+	if err != nil {
+		return rule, err
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return rule, fmt.Errorf("invalid lifecycle rule %q: %w", in, err)
+	}
+
+	rule.Status = s3.LifecycleRuleEnabled
+	if disabled.Value() {
+		rule.Status = s3.LifecycleRuleDisabled
+	}
+	return rule, nil
+}
+
+type lifecycleFile struct {
+	Rules []s3.LifecycleRule `json:"rules"`
+}
+
+// readLifecycleFile reads lifecycle rules from "rules" key of a YAML or JSON file. Status of the rules defaults to enabled.
+func readLifecycleFile(path string) ([]s3.LifecycleRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f lifecycleFile
+	if err := commands.UnmarshalYAMLStrict(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse lifecycle file: %w", err)
+	}
+
+	for i := range f.Rules {
+		if f.Rules[i].Status == "" {
+			f.Rules[i].Status = s3.LifecycleRuleEnabled
+		}
+	}
+	return f.Rules, nil
+}
+
+// validateLifecycleRules checks that the rules have valid status and at least one expiration.
+func validateLifecycleRules(rules []s3.LifecycleRule) error {
+	var errs []error
+	ids := make(map[string]bool)
+	for i, rule := range rules {
+		prefix := "rule " + strconv.Itoa(i+1)
+		if rule.ID != "" {
+			prefix = fmt.Sprintf("rule %d (%s)", i+1, rule.ID)
+			if ids[rule.ID] {
+				errs = append(errs, fmt.Errorf("%s: id must be unique", prefix))
+			}
+			ids[rule.ID] = true
+		}
+
+		if !slices.Contains(lifecycleRuleStatuses, rule.Status) {
+			errs = append(errs, fmt.Errorf("%s: status must be one of %v, got %q", prefix, lifecycleRuleStatuses, rule.Status))
+		}
+		if rule.ExpirationDays < 0 || rule.NoncurrentVersionExpirationDays < 0 {
+			errs = append(errs, fmt.Errorf("%s: days must not be negative", prefix))
+		}
+		if rule.ExpirationDays == 0 && rule.NoncurrentVersionExpirationDays == 0 {
+			errs = append(errs, fmt.Errorf("%s: either expiration days or noncurrent version expiration days must be defined", prefix))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// readBucketPolicy reads a bucket policy document from given JSON file and validates it. Returns the compacted document.
+func readBucketPolicy(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read policy document: %w", err)
+	}
+
+	var doc policy.Document
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	errs := []error{doc.Validate()}
+	for i, statement := range doc.Statement {
+		if len(statement.Principal) == 0 && len(statement.NotPrincipal) == 0 {
+			errs = append(errs, fmt.Errorf("statement %d: either Principal or NotPrincipal must be defined in bucket policies", i+1))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	compacted := new(bytes.Buffer)
+	if err := json.Compact(compacted, content); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+	return compacted.Bytes(), nil
+}
+
+// publicReadPolicy returns a bucket policy document that allows anyone to read the objects in the bucket.
+func publicReadPolicy(bucket string) []byte {
+	doc := policy.Document{
+		Version: "2012-10-17",
+		Statement: []policy.Statement{{
+			Sid:       "PublicRead",
+			Effect:    "Allow",
+			Principal: json.RawMessage(`"*"`),
+			Action:    []string{"s3:GetObject"},
+			Resource:  []string{"arn:aws:s3:::" + bucket + "/*"},
+		}},
+	}
+
+	// Marshaling a document that only contains strings can not fail.
+	content, _ := json.Marshal(doc)
+	return content
+}
+
+// isPublicRead returns true if the policy has an unconditional statement that allows anyone to read objects.
+func isPublicRead(doc *policy.Document) bool {
+	for _, statement := range doc.Statement {
+		if statement.Effect != "Allow" || len(statement.Condition) > 0 || !isPublicPrincipal(statement.Principal) {
+			continue
+		}
+		for _, action := range statement.Action {
+			if slices.Contains(publicReadActions, action) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isPublicPrincipal returns true if the principal is either `"*"` or `{"AWS": "*"}`.
+func isPublicPrincipal(principal json.RawMessage) bool {
+	var single string
+	if err := json.Unmarshal(principal, &single); err == nil {
+		return single == "*"
+	}
+
+	var principals map[string]json.RawMessage
+	if err := json.Unmarshal(principal, &principals); err != nil {
+		return false
+	}
+	aws, ok := principals["AWS"]
+	if !ok {
+		return false
+	}
+	if err := json.Unmarshal(aws, &single); err == nil {
+		return single == "*"
+	}
+	var values []string
+	if err := json.Unmarshal(aws, &values); err != nil {
+		return false
+	}
+	return slices.Contains(values, "*")
+}
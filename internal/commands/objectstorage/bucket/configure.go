@@ -0,0 +1,183 @@
+package bucket
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/object"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3"
+)
+
+const lifecycleRuleHelp = "Lifecycle expiration rule, multiple can be declared.\n" +
+	"Usage: `--lifecycle-rule prefix=logs/,days=30` or `--lifecycle-rule id=old-versions,noncurrent-days=7`\n" +
+	"Available keys: id, prefix, days (expiration of current object versions), noncurrent-days (expiration of noncurrent object versions), and disabled."
+
+var versioningValues = []string{"enabled", "suspended"}
+
+// ConfigureCommand creates the 'objectstorage bucket configure' command
+func ConfigureCommand() commands.Command {
+	return &configureCommand{
+		BaseCommand: commands.New(
+			"configure",
+			"Configure versioning, lifecycle, and access policy settings of a bucket",
+			"upctl object-storage bucket configure my-service --name my-bucket --versioning enabled",
+			"upctl object-storage bucket configure my-service --name my-bucket --lifecycle-rule prefix=logs/,days=30 --lifecycle-rule noncurrent-days=7",
+			"upctl object-storage bucket configure my-service --name my-bucket --lifecycle-file lifecycle.yaml",
+			"upctl object-storage bucket configure my-service --name my-bucket --public-read",
+			"upctl object-storage bucket configure my-service --name my-bucket --policy policy.json",
+			"upctl object-storage bucket configure my-service --name my-bucket --remove-lifecycle --remove-policy",
+		),
+	}
+}
+
+type configureCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	object.ClientParams
+	name            string
+	versioning      string
+	lifecycleRules  []string
+	lifecycleFile   string
+	removeLifecycle config.OptionalBoolean
+	policyFile      string
+	publicRead      config.OptionalBoolean
+	removePolicy    config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *configureCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Configure versioning, lifecycle, and access policy settings of a bucket
+
+The settings are configured over the S3 API of the service, see ` + "`upctl object-storage object --help`" + ` for how to define the access key to use. Only the settings defined with the flags are modified.
+
+Lifecycle rules defined with ` + "`--lifecycle-rule`" + ` or ` + "`--lifecycle-file`" + ` replace all existing lifecycle rules of the bucket. The lifecycle file should be a YAML or JSON document that contains the rules under "rules" key in the same format as the JSON output of ` + "`upctl object-storage bucket show`" + ` uses. For example:
+
+  rules:
+    - id: logs
+      prefix: logs/
+      expiration_days: 30
+    - id: old-versions
+      noncurrent_version_expiration_days: 7
+
+The access policy defined with ` + "`--policy`" + ` or ` + "`--public-read`" + ` replaces the existing policy of the bucket.`)
+
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.name, "name", "", "The name of the bucket.")
+	fs.StringVar(&s.versioning, "versioning", "", "Versioning status of the bucket: "+strings.Join(versioningValues, ", ")+". Versioning can not be disabled after it has been enabled.")
+	fs.StringArrayVar(&s.lifecycleRules, "lifecycle-rule", nil, lifecycleRuleHelp)
+	fs.StringVar(&s.lifecycleFile, "lifecycle-file", "", "Path to a YAML or JSON file containing the lifecycle rules.")
+	config.AddToggleFlag(fs, &s.removeLifecycle, "remove-lifecycle", false, "Remove all lifecycle rules of the bucket.")
+	fs.StringVar(&s.policyFile, "policy", "", "Path to a JSON file containing the access policy document of the bucket.")
+	config.AddToggleFlag(fs, &s.publicRead, "public-read", false, "Set an access policy that allows anyone to read the objects in the bucket.")
+	config.AddToggleFlag(fs, &s.removePolicy, "remove-policy", false, "Remove the access policy of the bucket.")
+	s.ClientParams.AddFlags(fs)
+
+	commands.Must(fs.SetAnnotation("versioning", commands.FlagAnnotationFixedCompletions, versioningValues))
+	commands.Must(fs.SetAnnotation("lifecycle-rule", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	s.Cobra().MarkFlagsMutuallyExclusive("lifecycle-rule", "lifecycle-file", "remove-lifecycle")
+	s.Cobra().MarkFlagsMutuallyExclusive("policy", "public-read", "remove-policy")
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *configureCommand) ExecuteSingleArgument(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	versioning := ""
+	if s.versioning != "" {
+		if !slices.Contains(versioningValues, strings.ToLower(s.versioning)) {
+			return nil, fmt.Errorf("invalid versioning status %q, must be one of: %s", s.versioning, strings.Join(versioningValues, ", "))
+		}
+		versioning = s3.VersioningEnabled
+		if strings.EqualFold(s.versioning, "suspended") {
+			versioning = s3.VersioningSuspended
+		}
+	}
+
+	var rules []s3.LifecycleRule
+	switch {
+	case len(s.lifecycleRules) > 0:
+		for _, in := range s.lifecycleRules {
+			rule, err := parseLifecycleRule(in)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+	case s.lifecycleFile != "":
+		var err error
+		if rules, err = readLifecycleFile(s.lifecycleFile); err != nil {
+			return nil, err
+		}
+		if len(rules) == 0 {
+			return nil, fmt.Errorf("lifecycle file %s does not contain any rules, use --remove-lifecycle to remove all rules", s.lifecycleFile)
+		}
+	}
+	if err := validateLifecycleRules(rules); err != nil {
+		return nil, fmt.Errorf("invalid lifecycle rules: %w", err)
+	}
+
+	var policyDocument []byte
+	switch {
+	case s.policyFile != "":
+		var err error
+		if policyDocument, err = readBucketPolicy(s.policyFile); err != nil {
+			return nil, err
+		}
+	case s.publicRead.Value():
+		policyDocument = publicReadPolicy(s.name)
+	}
+
+	if versioning == "" && rules == nil && !s.removeLifecycle.Value() && policyDocument == nil && !s.removePolicy.Value() {
+		return nil, fmt.Errorf("no settings to configure, define at least one of the versioning, lifecycle, or policy flags")
+	}
+
+	client, err := s.NewClient(exec, serviceUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Configuring bucket %s in service %s", s.name, serviceUUID)
+	exec.PushProgressStarted(msg)
+
+	ctx := exec.Context()
+	if versioning != "" {
+		if err := client.PutBucketVersioning(ctx, s.name, versioning); err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("cannot set versioning status: %w", err))
+		}
+	}
+
+	switch {
+	case rules != nil:
+		if err := client.PutBucketLifecycle(ctx, s.name, rules); err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("cannot set lifecycle rules: %w", err))
+		}
+	case s.removeLifecycle.Value():
+		if err := client.DeleteBucketLifecycle(ctx, s.name); err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("cannot remove lifecycle rules: %w", err))
+		}
+	}
+
+	switch {
+	case policyDocument != nil:
+		if err := client.PutBucketPolicy(ctx, s.name, policyDocument); err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("cannot set policy: %w", err))
+		}
+	case s.removePolicy.Value():
+		if err := client.DeleteBucketPolicy(ctx, s.name); err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("cannot remove policy: %w", err))
+		}
+	}
+
+	cfg, err := getConfiguration(ctx, client, s.name)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: cfg}, nil
+}
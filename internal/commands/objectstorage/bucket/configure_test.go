@@ -0,0 +1,169 @@
+package bucket
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/object"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/policy"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3/s3test"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupS3Test starts a S3 test server with an empty bucket and returns a mock service that contains a managed object storage service using it.
+func setupS3Test(t *testing.T) *smock.Service {
+	t.Helper()
+	text.DisableColors()
+
+	server := s3test.NewServer()
+	t.Cleanup(server.Close)
+	server.AddBucket("my-bucket")
+
+	originalEndpointURL := object.EndpointURL
+	object.EndpointURL = func(string) string { return server.URL }
+	t.Cleanup(func() { object.EndpointURL = originalEndpointURL })
+
+	t.Setenv("UPCLOUD_OBJECT_STORAGE_ACCESS_KEY_ID", "AKIATEST")
+	t.Setenv("UPCLOUD_OBJECT_STORAGE_SECRET_ACCESS_KEY", "secret")
+
+	mService := new(smock.Service)
+	mService.On("GetManagedObjectStorages", &request.GetManagedObjectStoragesRequest{}).Return([]upcloud.ManagedObjectStorage{{
+		UUID:      "1200ecde-db95-4d1c-9133-6508f3232567",
+		Name:      "my-service",
+		Region:    "europe-1",
+		Endpoints: []upcloud.ManagedObjectStorageEndpoint{{DomainName: "public.example.upcloudobjects.com", Type: "public"}},
+	}}, nil)
+	return mService
+}
+
+func TestConfigureCommand(t *testing.T) {
+	mService := setupS3Test(t)
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	c := commands.BuildCommand(ConfigureCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{
+		"my-service", "--name", "my-bucket",
+		"--versioning", "enabled",
+		"--lifecycle-rule", "id=logs,prefix=logs/,days=30",
+		"--lifecycle-rule", "noncurrent-days=7,disabled",
+		"--public-read",
+	})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	var cfg configuration
+	require.NoError(t, json.Unmarshal([]byte(out), &cfg))
+	assert.Equal(t, s3.VersioningEnabled, cfg.Versioning)
+	assert.Equal(t, []s3.LifecycleRule{
+		{ID: "logs", Prefix: "logs/", Status: s3.LifecycleRuleEnabled, ExpirationDays: 30},
+		{Status: s3.LifecycleRuleDisabled, NoncurrentVersionExpirationDays: 7},
+	}, cfg.LifecycleRules)
+	var doc policy.Document
+	require.NoError(t, json.Unmarshal(cfg.Policy, &doc))
+	assert.True(t, isPublicRead(&doc))
+	assert.Equal(t, []string{"arn:aws:s3:::my-bucket/*"}, []string(doc.Statement[0].Resource))
+
+	conf = config.New()
+	c = commands.BuildCommand(ShowCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"my-service", "--name", "my-bucket"})
+	out, err = mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	assert.Regexp(t, `Versioning:\s+Enabled`, out)
+	assert.Regexp(t, `Public read access:\s+yes`, out)
+	assert.Regexp(t, `logs\s+logs/\s+Enabled\s+30`, out)
+	assert.Contains(t, out, "PublicRead")
+
+	conf = config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	c = commands.BuildCommand(ConfigureCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"my-service", "--name", "my-bucket", "--remove-lifecycle", "--remove-policy"})
+	out, err = mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	cfg = configuration{}
+	require.NoError(t, json.Unmarshal([]byte(out), &cfg))
+	assert.Empty(t, cfg.LifecycleRules)
+	assert.JSONEq(t, "null", string(cfg.Policy))
+}
+
+func TestConfigureCommand_LifecycleFile(t *testing.T) {
+	mService := setupS3Test(t)
+
+	path := filepath.Join(t.TempDir(), "lifecycle.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`rules:
+  - id: tmp
+    prefix: tmp/
+    expiration_days: 1
+    noncurrent_version_expiration_days: 1
+`), 0o600))
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	c := commands.BuildCommand(ConfigureCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"my-service", "--name", "my-bucket", "--lifecycle-file", path})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+
+	var cfg configuration
+	require.NoError(t, json.Unmarshal([]byte(out), &cfg))
+	assert.Equal(t, versioningDisabled, cfg.Versioning)
+	assert.Equal(t, []s3.LifecycleRule{
+		{ID: "tmp", Prefix: "tmp/", Status: s3.LifecycleRuleEnabled, ExpirationDays: 1, NoncurrentVersionExpirationDays: 1},
+	}, cfg.LifecycleRules)
+}
+
+func TestConfigureCommand_Invalid(t *testing.T) {
+	mService := setupS3Test(t)
+
+	policyPath := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(policyPath, []byte(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`), 0o600))
+
+	for _, test := range []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{
+			name: "no settings",
+			args: []string{},
+			err:  "no settings to configure, define at least one of the versioning, lifecycle, or policy flags",
+		},
+		{
+			name: "versioning",
+			args: []string{"--versioning", "disabled"},
+			err:  `invalid versioning status "disabled", must be one of: enabled, suspended`,
+		},
+		{
+			name: "lifecycle rule without expiration",
+			args: []string{"--lifecycle-rule", "id=logs,prefix=logs/"},
+			err:  "invalid lifecycle rules: rule 1 (logs): either expiration days or noncurrent version expiration days must be defined",
+		},
+		{
+			name: "policy without principal",
+			args: []string{"--policy", policyPath},
+			err:  "invalid policy document: statement 1: either Principal or NotPrincipal must be defined in bucket policies",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			c := commands.BuildCommand(ConfigureCommand(), nil, conf)
+			c.Cobra().SetArgs(append([]string{"my-service", "--name", "my-bucket"}, test.args...))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package bucket
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/object"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/policy"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+)
+
+// ShowCommand creates the 'objectstorage bucket show' command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show versioning, lifecycle, and access policy settings of a bucket",
+			"upctl object-storage bucket show <service-uuid> --name my-bucket",
+			"upctl object-storage bucket show my-service --name my-bucket --access-key-id AKIA...",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	object.ClientParams
+	name string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *showCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Show versioning, lifecycle, and access policy settings of a bucket
+
+The settings are read over the S3 API of the service, see ` + "`upctl object-storage object --help`" + ` for how to define the access key to use.`)
+
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.name, "name", "", "The name of the bucket.")
+	s.ClientParams.AddFlags(fs)
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *showCommand) ExecuteSingleArgument(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	client, err := s.NewClient(exec, serviceUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := getConfiguration(exec.Context(), client, s.name)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycleRows := []output.TableRow{}
+	for _, rule := range cfg.LifecycleRules {
+		lifecycleRows = append(lifecycleRows, output.TableRow{
+			rule.ID,
+			rule.Prefix,
+			rule.Status,
+			rule.ExpirationDays,
+			rule.NoncurrentVersionExpirationDays,
+		})
+	}
+
+	publicRead := false
+	statementRows := []output.TableRow{}
+	if len(cfg.Policy) > 0 {
+		var doc policy.Document
+		if err := json.Unmarshal(cfg.Policy, &doc); err != nil {
+			return nil, fmt.Errorf("cannot parse policy of bucket %s: %w", s.name, err)
+		}
+
+		publicRead = isPublicRead(&doc)
+		for _, statement := range doc.Statement {
+			principal := statement.Principal
+			if len(statement.NotPrincipal) > 0 {
+				principal = append(json.RawMessage("NOT "), statement.NotPrincipal...)
+			}
+			statementRows = append(statementRows, output.TableRow{
+				statement.Sid,
+				statement.Effect,
+				string(principal),
+				policy.Negatable(statement.Action, statement.NotAction),
+				policy.Negatable(statement.Resource, statement.NotResource),
+				string(statement.Condition),
+			})
+		}
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: cfg,
+		Output: output.Combined{
+			output.CombinedSection{
+				Contents: output.Details{
+					Sections: []output.DetailSection{
+						{
+							Title: "Overview:",
+							Rows: []output.DetailRow{
+								{Title: "Name:", Value: cfg.Name},
+								{Title: "Versioning:", Value: cfg.Versioning},
+								{Title: "Public read access:", Value: publicRead, Format: format.Boolean},
+							},
+						},
+					},
+				},
+			},
+			output.CombinedSection{
+				Key:   "lifecycle_rules",
+				Title: "Lifecycle rules:",
+				Contents: output.Table{
+					Columns: []output.TableColumn{
+						{Key: "id", Header: "ID", Format: format.PossiblyUnknownString},
+						{Key: "prefix", Header: "Prefix", Format: format.PossiblyUnknownString},
+						{Key: "status", Header: "Status"},
+						{Key: "expiration_days", Header: "Expiration days"},
+						{Key: "noncurrent_version_expiration_days", Header: "Noncurrent version expiration days"},
+					},
+					Rows:         lifecycleRows,
+					EmptyMessage: "No lifecycle rules found for this bucket.",
+				},
+			},
+			output.CombinedSection{
+				Key:   "policy",
+				Title: "Policy statements:",
+				Contents: output.Table{
+					Columns: []output.TableColumn{
+						{Key: "sid", Header: "Sid", Format: format.PossiblyUnknownString},
+						{Key: "effect", Header: "Effect", Format: format.ObjectStoragePolicyStatementEffect},
+						{Key: "principal", Header: "Principal"},
+						{Key: "action", Header: "Actions", Format: format.StringSliceAnd},
+						{Key: "resource", Header: "Resources", Format: format.StringSliceAnd},
+						{Key: "condition", Header: "Condition", Format: format.PossiblyUnknownString},
+					},
+					Rows:         statementRows,
+					EmptyMessage: "No policy found for this bucket.",
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,88 @@
+package customdomain
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// AddCommand creates the 'object-storage custom-domain add' command
+func AddCommand() commands.Command {
+	return &addCommand{
+		BaseCommand: commands.New(
+			"add",
+			"Add a custom domain to a managed object storage service",
+			"upctl object-storage custom-domain add <service-uuid> --domain objects.example.com",
+			"upctl object-storage custom-domain add my-service --domain objects.example.com",
+		),
+	}
+}
+
+type addCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	resolver.CachingObjectStorage
+	params request.CreateManagedObjectStorageCustomDomainRequest
+}
+
+// InitCommand implements Command.InitCommand
+func (s *addCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Add a custom domain to a managed object storage service
+
+After adding the domain, create the DNS records listed in the output to point the domain to the public endpoint of the service.`)
+
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.params.DomainName, "domain", "", "The custom domain name to add.")
+	fs.StringVar(&s.params.Type, "type", publicEndpointType, "The type of the endpoint the custom domain is used for.")
+	commands.Must(fs.SetAnnotation("domain", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("type", commands.FlagAnnotationFixedCompletions, []string{publicEndpointType}))
+	commands.Must(s.Cobra().MarkFlagRequired("domain"))
+}
+
+// Execute implements Command.Execute
+func (s *addCommand) Execute(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	svc := exec.All()
+	req := s.params
+	req.ServiceUUID = serviceUUID
+
+	msg := fmt.Sprintf("Adding custom domain %s to service %s", req.DomainName, serviceUUID)
+	exec.PushProgressStarted(msg)
+
+	objectStorage, err := svc.GetManagedObjectStorage(exec.Context(), &request.GetManagedObjectStorageRequest{UUID: serviceUUID})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if err := svc.CreateManagedObjectStorageCustomDomain(exec.Context(), &req); err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	domain := upcloud.ManagedObjectStorageCustomDomain{DomainName: req.DomainName, Type: req.Type}
+	return output.MarshaledWithHumanOutput{
+		Value: domain,
+		Output: output.Combined{
+			output.CombinedSection{
+				Contents: output.Details{
+					Sections: []output.DetailSection{
+						{
+							Title: "Custom domain:",
+							Rows: []output.DetailRow{
+								{Title: "Domain:", Value: domain.DomainName},
+								{Title: "Type:", Value: domain.Type},
+								{Title: "CNAME target:", Value: CNAMETarget(objectStorage)},
+							},
+						},
+					},
+				},
+			},
+			DNSRecordsSection(objectStorage, []upcloud.ManagedObjectStorageCustomDomain{domain}),
+		},
+	}, nil
+}
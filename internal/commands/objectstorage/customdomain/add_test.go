@@ -0,0 +1,44 @@
+package customdomain
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddCommand(t *testing.T) {
+	text.DisableColors()
+	serviceUUID := "17fbd082-30b0-11eb-adc1-0242ac120003"
+
+	mService := new(smock.Service)
+	mService.On("GetManagedObjectStorage", &request.GetManagedObjectStorageRequest{UUID: serviceUUID}).Return(&upcloud.ManagedObjectStorage{
+		UUID: serviceUUID,
+		Endpoints: []upcloud.ManagedObjectStorageEndpoint{
+			{DomainName: "private.example.upcloudobjects.com", Type: "private"},
+			{DomainName: "public.example.upcloudobjects.com", Type: "public"},
+		},
+	}, nil)
+	mService.On("CreateManagedObjectStorageCustomDomain", &request.CreateManagedObjectStorageCustomDomainRequest{
+		ServiceUUID: serviceUUID,
+		DomainName:  "objects.example.com",
+		Type:        "public",
+	}).Return(nil)
+
+	conf := config.New()
+	c := commands.BuildCommand(AddCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{serviceUUID, "--domain", "objects.example.com"})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+
+	assert.NoError(t, err)
+	mService.AssertNumberOfCalls(t, "CreateManagedObjectStorageCustomDomain", 1)
+	assert.Regexp(t, `objects\.example\.com\s+CNAME\s+public\.example\.upcloudobjects\.com`, out)
+	assert.Regexp(t, `\*\.objects\.example\.com\s+CNAME\s+public\.example\.upcloudobjects\.com`, out)
+	assert.NotContains(t, out, "private.example.upcloudobjects.com")
+}
@@ -0,0 +1,62 @@
+package customdomain
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+)
+
+const publicEndpointType = "public"
+
+// BaseCustomDomainCommand creates the base "object-storage custom-domain" command
+func BaseCustomDomainCommand() commands.Command {
+	return &customDomainCommand{
+		BaseCommand: commands.New("custom-domain", "Manage custom domains in managed object storage services"),
+	}
+}
+
+type customDomainCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (s *customDomainCommand) InitCommand() {
+	s.Cobra().Aliases = []string{"custom-domains"}
+}
+
+// CNAMETarget returns the public endpoint of the service that custom domains should point to.
+func CNAMETarget(objectStorage *upcloud.ManagedObjectStorage) string {
+	for _, endpoint := range objectStorage.Endpoints {
+		if endpoint.Type == publicEndpointType {
+			return endpoint.DomainName
+		}
+	}
+	return ""
+}
+
+// DNSRecordsSection returns a section listing the DNS records needed for given custom domains. The wildcard record is needed for virtual-hosted-style access to the buckets.
+func DNSRecordsSection(objectStorage *upcloud.ManagedObjectStorage, domains []upcloud.ManagedObjectStorageCustomDomain) output.CombinedSection {
+	target := CNAMETarget(objectStorage)
+
+	rows := []output.TableRow{}
+	for _, domain := range domains {
+		rows = append(rows,
+			output.TableRow{domain.DomainName, "CNAME", target},
+			output.TableRow{"*." + domain.DomainName, "CNAME", target},
+		)
+	}
+
+	return output.CombinedSection{
+		Key:   "dns_records",
+		Title: "DNS records:",
+		Contents: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "type", Header: "Type"},
+				{Key: "target", Header: "Target"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No custom domains configured, no DNS records needed.",
+		},
+	}
+}
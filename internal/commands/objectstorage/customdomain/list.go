@@ -0,0 +1,71 @@
+package customdomain
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListCommand creates the 'object-storage custom-domain list' command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List custom domains of a managed object storage service",
+			"upctl object-storage custom-domain list <service-uuid>",
+			"upctl object-storage custom-domain list my-service",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	resolver.CachingObjectStorage
+}
+
+// Execute implements Command.Execute
+func (s *listCommand) Execute(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	svc := exec.All()
+	objectStorage, err := svc.GetManagedObjectStorage(exec.Context(), &request.GetManagedObjectStorageRequest{UUID: serviceUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := svc.GetManagedObjectStorageCustomDomains(exec.Context(), &request.GetManagedObjectStorageCustomDomainsRequest{ServiceUUID: serviceUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	target := CNAMETarget(objectStorage)
+	rows := []output.TableRow{}
+	for _, domain := range domains {
+		rows = append(rows, output.TableRow{
+			domain.DomainName,
+			domain.Type,
+			target,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: domains,
+		Output: output.Combined{
+			output.CombinedSection{
+				Key:   "custom_domains",
+				Title: "Custom domains:",
+				Contents: output.Table{
+					Columns: []output.TableColumn{
+						{Key: "domain_name", Header: "Domain"},
+						{Key: "type", Header: "Type"},
+						{Key: "cname_target", Header: "CNAME target"},
+					},
+					Rows:         rows,
+					EmptyMessage: "No custom domains found for this Managed object storage service.",
+				},
+			},
+			DNSRecordsSection(objectStorage, domains),
+		},
+	}, nil
+}
@@ -0,0 +1,55 @@
+package customdomain
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// RemoveCommand creates the 'object-storage custom-domain remove' command
+func RemoveCommand() commands.Command {
+	return &removeCommand{
+		BaseCommand: commands.New(
+			"remove",
+			"Remove a custom domain from a managed object storage service",
+			"upctl object-storage custom-domain remove <service-uuid> --domain objects.example.com",
+			"upctl object-storage custom-domain remove my-service --domain objects.example.com",
+		),
+	}
+}
+
+type removeCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	resolver.CachingObjectStorage
+	params request.DeleteManagedObjectStorageCustomDomainRequest
+}
+
+// InitCommand implements Command.InitCommand
+func (s *removeCommand) InitCommand() {
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.params.DomainName, "domain", "", "The custom domain name to remove.")
+	commands.Must(fs.SetAnnotation("domain", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(s.Cobra().MarkFlagRequired("domain"))
+}
+
+// Execute implements Command.Execute
+func (s *removeCommand) Execute(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	req := s.params
+	req.ServiceUUID = serviceUUID
+
+	msg := fmt.Sprintf("Removing custom domain %s from service %s", req.DomainName, serviceUUID)
+	exec.PushProgressStarted(msg)
+
+	if err := exec.All().DeleteManagedObjectStorageCustomDomain(exec.Context(), &req); err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
@@ -69,6 +69,7 @@ func TestDeleteCommand(t *testing.T) {
 			mService := smock.Service{}
 			req := test.req
 			mService.On(targetMethod, &req).Return(nil)
+			mService.On("GetManagedObjectStoragePolicies", &request.GetManagedObjectStoragePoliciesRequest{ServiceUUID: objectstorage.UUID}).Return([]upcloud.ManagedObjectStoragePolicy{}, nil)
 
 			conf := config.New()
 			c := commands.BuildCommand(DeleteCommand(), nil, conf)
@@ -0,0 +1,169 @@
+package object
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3"
+	"github.com/spf13/cobra"
+)
+
+// CopyCommand creates the "object-storage object cp" command
+func CopyCommand() commands.Command {
+	return &copyCommand{
+		BaseCommand: commands.New(
+			"cp",
+			"Upload or download objects",
+			"upctl object-storage object cp my-service ./backup.tar.gz s3://my-bucket/backups/",
+			"upctl object-storage object cp my-service s3://my-bucket/backups/backup.tar.gz .",
+			"upctl object-storage object cp my-service ./photos s3://my-bucket/photos --recursive --parallel 8",
+			"upctl object-storage object cp my-service s3://my-bucket/photos ./photos --recursive",
+		),
+	}
+}
+
+type copyCommand struct {
+	*commands.BaseCommand
+	serviceCompletion
+	ClientParams
+	transferParams
+	recursive config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *copyCommand) InitCommand() {
+	s.Cobra().Aliases = []string{"copy"}
+	s.Cobra().Args = cobra.ExactArgs(3)
+	s.Cobra().Long = commands.WrapLongDescription(`Upload or download objects
+
+Either the source or the destination must be an ` + "`s3://<bucket>/<key>`" + ` location and the other a local path. If the destination ends with ` + "`/`" + ` or is an existing directory, the name of the source file or object is appended to it.
+
+Files larger than the part size are uploaded with multipart uploads, where the parts are uploaded in parallel.`)
+
+	fs := s.Cobra().Flags()
+	s.ClientParams.AddFlags(fs)
+	s.transferParams.addFlags(fs)
+	config.AddToggleFlag(fs, &s.recursive, "recursive", false, "Copy all files in the source directory or all objects with the source prefix.")
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s *copyCommand) PositionalArgumentHelp() string {
+	return "<UUID/Name> <source> <destination>"
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *copyCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	args := s.Cobra().Flags().Args()
+	if err := s.transferParams.validate(); err != nil {
+		return nil, err
+	}
+
+	source, destination, err := parseTransferLocations(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.NewClient(exec, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var transfers []transfer
+	if source.remote {
+		transfers, err = s.downloads(exec, client, source, destination)
+	} else {
+		transfers, err = s.uploads(source, destination)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := runTransfers(exec, client, transfers, s.transferParams); err != nil {
+		return nil, err
+	}
+
+	return output.OnlyMarshaled{Value: transfers}, nil
+}
+
+// parseTransferLocations parses source and destination and validates that exactly one of those is a remote location.
+func parseTransferLocations(sourceArg, destinationArg string) (location, location, error) {
+	source, err := parseLocation(sourceArg)
+	if err != nil {
+		return location{}, location{}, err
+	}
+	destination, err := parseLocation(destinationArg)
+	if err != nil {
+		return location{}, location{}, err
+	}
+
+	if source.remote == destination.remote {
+		return location{}, location{}, fmt.Errorf("either source or destination must be a %s location and the other a local path", locationScheme)
+	}
+	return source, destination, nil
+}
+
+func (s *copyCommand) uploads(source, destination location) ([]transfer, error) {
+	info, err := os.Stat(source.path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		key := destination.key
+		if key == "" || strings.HasSuffix(key, "/") {
+			key += filepath.Base(source.path)
+		}
+		return []transfer{newUpload(source.path, destination.bucket, key, info.Size())}, nil
+	}
+
+	if !s.recursive.Value() {
+		return nil, fmt.Errorf("%s is a directory, use --recursive to copy directories", source.path)
+	}
+
+	files, err := localFiles(source.path)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := []transfer{}
+	for _, file := range files {
+		transfers = append(transfers, newUpload(filepath.Join(source.path, filepath.FromSlash(file.name)), destination.bucket, destination.keyPrefix()+file.name, file.size))
+	}
+	return transfers, nil
+}
+
+func (s *copyCommand) downloads(exec commands.Executor, client *s3.Client, source, destination location) ([]transfer, error) {
+	if !s.recursive.Value() {
+		if source.key == "" || strings.HasSuffix(source.key, "/") {
+			return nil, fmt.Errorf("%s is not an object, use --recursive to copy all objects with the prefix", source)
+		}
+
+		object, err := client.HeadObject(exec.Context(), source.bucket, source.key)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get details of %s: %w", source, err)
+		}
+
+		target := destination.path
+		if info, err := os.Stat(target); (err == nil && info.IsDir()) || strings.HasSuffix(target, string(filepath.Separator)) || strings.HasSuffix(target, "/") {
+			target = filepath.Join(target, path.Base(source.key))
+		}
+		return []transfer{newDownload(source.bucket, source.key, target, object.Size)}, nil
+	}
+
+	objects, err := remoteObjects(exec, client, source)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := []transfer{}
+	for _, object := range objects {
+		transfers = append(transfers, newDownload(source.bucket, object.key, filepath.Join(destination.path, filepath.FromSlash(object.name)), object.size))
+	}
+	return transfers, nil
+}
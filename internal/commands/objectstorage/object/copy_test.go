@@ -0,0 +1,123 @@
+package object
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTime = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func writeTestFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+func TestCopyCommand_Upload(t *testing.T) {
+	server, mService := setupTest(t)
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "hello.txt"), []byte("hello"))
+
+	conf := config.New()
+	c := commands.BuildCommand(CopyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"my-service", filepath.Join(dir, "hello.txt"), "s3://my-bucket/greetings/"})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+
+	assert.NoError(t, err)
+	object, ok := server.Object("my-bucket", "greetings/hello.txt")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("hello"), object.Data)
+}
+
+func TestCopyCommand_UploadRecursiveMultipart(t *testing.T) {
+	server, mService := setupTest(t)
+	dir := t.TempDir()
+	large := bytes.Repeat([]byte("x"), 6*1024*1024)
+	writeTestFile(t, filepath.Join(dir, "large.bin"), large)
+	writeTestFile(t, filepath.Join(dir, "sub", "small.txt"), []byte("small"))
+
+	conf := config.New()
+	c := commands.BuildCommand(CopyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"my-service", dir, "s3://my-bucket/data", "--recursive", "--part-size", "5"})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"data/large.bin", "data/sub/small.txt"}, server.Keys("my-bucket"))
+	object, _ := server.Object("my-bucket", "data/large.bin")
+	assert.Equal(t, large, object.Data)
+	assert.Contains(t, server.Requests(), "PUT /my-bucket/data/large.bin?partNumber=2&uploadId=1")
+}
+
+func TestCopyCommand_Download(t *testing.T) {
+	server, mService := setupTest(t)
+	server.PutObject("my-bucket", "backups/db.sql", []byte("CREATE TABLE"), testTime)
+	server.PutObject("my-bucket", "backups/old/db.sql", []byte("DROP TABLE"), testTime)
+	dir := t.TempDir()
+
+	conf := config.New()
+	c := commands.BuildCommand(CopyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"my-service", "s3://my-bucket/backups/db.sql", dir})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+	assert.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "db.sql"))
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATE TABLE", string(data))
+	info, err := os.Stat(filepath.Join(dir, "db.sql"))
+	assert.NoError(t, err)
+	assert.True(t, testTime.Equal(info.ModTime()))
+
+	conf = config.New()
+	c = commands.BuildCommand(CopyCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"my-service", "s3://my-bucket/backups", filepath.Join(dir, "all"), "--recursive"})
+	_, err = mockexecute.MockExecute(c, mService, conf)
+	assert.NoError(t, err)
+
+	data, err = os.ReadFile(filepath.Join(dir, "all", "old", "db.sql"))
+	assert.NoError(t, err)
+	assert.Equal(t, "DROP TABLE", string(data))
+}
+
+func TestCopyCommand_InvalidLocations(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		args  []string
+		error string
+	}{
+		{
+			name:  "both local",
+			args:  []string{"my-service", "a.txt", "b.txt"},
+			error: "either source or destination must be a s3:// location and the other a local path",
+		},
+		{
+			name:  "both remote",
+			args:  []string{"my-service", "s3://my-bucket/a.txt", "s3://my-bucket/b.txt"},
+			error: "either source or destination must be a s3:// location and the other a local path",
+		},
+		{
+			name:  "prefix without recursive",
+			args:  []string{"my-service", "s3://my-bucket/backups/", "."},
+			error: "s3://my-bucket/backups/ is not an object, use --recursive to copy all objects with the prefix",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, mService := setupTest(t)
+
+			conf := config.New()
+			c := commands.BuildCommand(CopyCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			assert.EqualError(t, err, test.error)
+		})
+	}
+}
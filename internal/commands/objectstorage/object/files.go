@@ -0,0 +1,69 @@
+package object
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3"
+)
+
+// entry is a local file or an object. The name is relative to the directory or prefix that was listed and uses `/` as separator.
+type entry struct {
+	name    string
+	key     string
+	size    int64
+	modTime time.Time
+}
+
+// localFiles returns the regular files in the directory and its subdirectories.
+func localFiles(dir string) ([]entry, error) {
+	entries := []entry{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{name: filepath.ToSlash(name), size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	return entries, err
+}
+
+// remoteObjects returns the objects that have the key of the location as prefix. Keys ending with `/`, i.e., directory markers, are skipped.
+func remoteObjects(exec commands.Executor, client *s3.Client, loc location) ([]entry, error) {
+	prefix := loc.keyPrefix()
+	listing, err := client.ListObjects(exec.Context(), loc.bucket, prefix, "")
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []entry{}
+	for _, object := range listing.Objects {
+		if strings.HasSuffix(object.Key, "/") {
+			continue
+		}
+
+		name := strings.TrimPrefix(object.Key, prefix)
+		// Do not allow keys such as `../file` to write outside of the target directory.
+		if !filepath.IsLocal(filepath.FromSlash(name)) {
+			return nil, fmt.Errorf("cannot map object key %q to a local path", object.Key)
+		}
+		entries = append(entries, entry{name: name, key: object.Key, size: object.Size, modTime: object.LastModified})
+	}
+	return entries, nil
+}
@@ -0,0 +1,118 @@
+package object
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// ListCommand creates the "object-storage object ls" command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"ls",
+			"List buckets or objects in a managed object storage service",
+			"upctl object-storage object ls my-service",
+			"upctl object-storage object ls my-service s3://my-bucket",
+			"upctl object-storage object ls my-service s3://my-bucket/photos/ --recursive",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	serviceCompletion
+	ClientParams
+	recursive config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *listCommand) InitCommand() {
+	s.Cobra().Aliases = []string{"list"}
+	s.Cobra().Args = cobra.RangeArgs(1, 2)
+
+	fs := s.Cobra().Flags()
+	s.ClientParams.AddFlags(fs)
+	config.AddToggleFlag(fs, &s.recursive, "recursive", false, "List all objects with the given prefix instead of grouping the keys by `/` delimiter.")
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s *listCommand) PositionalArgumentHelp() string {
+	return "<UUID/Name> [s3://<bucket>[/<prefix>]]"
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *listCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	args := s.Cobra().Flags().Args()
+
+	client, err := s.NewClient(exec, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args) == 1 {
+		buckets, err := client.ListBuckets(exec.Context())
+		if err != nil {
+			return nil, err
+		}
+
+		rows := []output.TableRow{}
+		for _, bucket := range buckets {
+			rows = append(rows, output.TableRow{bucket.Name, bucket.CreationDate})
+		}
+
+		return output.MarshaledWithHumanOutput{
+			Value: buckets,
+			Output: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "name", Header: "Name"},
+					{Key: "creation_date", Header: "Created"},
+				},
+				Rows:         rows,
+				EmptyMessage: "No buckets found.",
+			},
+		}, nil
+	}
+
+	loc, err := parseLocation(args[1])
+	if err != nil {
+		return nil, err
+	}
+	if !loc.remote {
+		return nil, fmt.Errorf("%q is not a %s location", args[1], locationScheme)
+	}
+
+	delimiter := "/"
+	if s.recursive.Value() {
+		delimiter = ""
+	}
+
+	listing, err := client.ListObjects(exec.Context(), loc.bucket, loc.key, delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, prefix := range listing.Prefixes {
+		rows = append(rows, output.TableRow{prefix, "", ""})
+	}
+	for _, object := range listing.Objects {
+		rows = append(rows, output.TableRow{object.Key, object.Size, object.LastModified})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: listing,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "key", Header: "Key"},
+				{Key: "size", Header: "Size"},
+				{Key: "last_modified", Header: "Last modified"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No objects found.",
+		},
+	}, nil
+}
@@ -0,0 +1,168 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	envAccessKeyID     = "UPCLOUD_OBJECT_STORAGE_ACCESS_KEY_ID"
+	envSecretAccessKey = "UPCLOUD_OBJECT_STORAGE_SECRET_ACCESS_KEY"
+
+	locationScheme     = "s3://"
+	publicEndpointType = "public"
+	defaultParallel    = 4
+)
+
+// EndpointURL returns the URL of an endpoint domain. It is a variable to allow replacing it in tests.
+var EndpointURL = func(domainName string) string {
+	return "https://" + domainName
+}
+
+// BaseObjectCommand creates the base "object-storage object" command
+func BaseObjectCommand() commands.Command {
+	return &objectCommand{
+		BaseCommand: commands.New("object", "Manage objects in managed object storage services"),
+	}
+}
+
+type objectCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (s *objectCommand) InitCommand() {
+	s.Cobra().Aliases = []string{"objects"}
+	s.Cobra().Long = commands.WrapLongDescription(`Manage objects in managed object storage services
+
+The object commands use the S3 API of the service. Objects are referred to with ` + "`s3://<bucket>/<key>`" + ` locations and other locations are considered to be local paths.
+
+The requests are signed with an access key of a managed object storage user. Define the access key ID with ` + "`--access-key-id`" + ` flag or ` + "`" + envAccessKeyID + "`" + ` environment variable. The secret access key is read from ` + "`" + envSecretAccessKey + "`" + ` environment variable or from the system keyring, if the access key was created with ` + "`upctl object-storage access-key create --save-to-keyring`" + `.`)
+}
+
+// ClientParams contains the flags and logic for resolving the service and creating a S3 client for it. It is used by the object commands and by the bucket commands that use the S3 API.
+type ClientParams struct {
+	resolver.CachingObjectStorage
+	accessKeyID string
+}
+
+// AddFlags adds the access key flag to given flag set.
+func (p *ClientParams) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&p.accessKeyID, "access-key-id", "", "ID of the access key to use. Defaults to the value of "+envAccessKeyID+" environment variable.")
+	commands.Must(fs.SetAnnotation("access-key-id", commands.FlagAnnotationNoFileCompletions, nil))
+}
+
+func (p *ClientParams) credentials() (s3.Credentials, error) {
+	accessKeyID := p.accessKeyID
+	if accessKeyID == "" {
+		accessKeyID = os.Getenv(envAccessKeyID)
+	}
+	if accessKeyID == "" {
+		return s3.Credentials{}, fmt.Errorf("access key is not defined, use --access-key-id flag or %s environment variable", envAccessKeyID)
+	}
+
+	secretAccessKey := os.Getenv(envSecretAccessKey)
+	if secretAccessKey == "" {
+		var err error
+		secretAccessKey, err = config.GetObjectStorageSecretFromKeyring(accessKeyID)
+		if err != nil {
+			return s3.Credentials{}, fmt.Errorf("secret access key for %s not found from %s environment variable or from the system keyring: %w", accessKeyID, envSecretAccessKey, err)
+		}
+	}
+
+	return s3.Credentials{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey}, nil
+}
+
+// NewClient resolves the service from given argument and returns a S3 client for its public endpoint.
+func (p *ClientParams) NewClient(exec commands.Executor, arg string) (*s3.Client, error) {
+	objectStorage, err := namedargs.Get(&p.CachingObjectStorage, exec, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	domainName := publicEndpoint(objectStorage)
+	if domainName == "" {
+		return nil, fmt.Errorf("managed object storage service %s does not have a public endpoint", objectStorage.UUID)
+	}
+
+	credentials, err := p.credentials()
+	if err != nil {
+		return nil, err
+	}
+
+	return s3.New(EndpointURL(domainName), objectStorage.Region, credentials)
+}
+
+func publicEndpoint(objectStorage upcloud.ManagedObjectStorage) string {
+	for _, endpoint := range objectStorage.Endpoints {
+		if endpoint.Type == publicEndpointType {
+			return endpoint.DomainName
+		}
+	}
+	return ""
+}
+
+// serviceCompletion completes the service argument. The position of the completed argument is not known, so local paths are completed as well.
+type serviceCompletion struct {
+	completion.ObjectStorage
+}
+
+// CompleteArgument implements completion.Provider
+func (s serviceCompletion) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if strings.HasPrefix(toComplete, locationScheme) {
+		return completion.None(toComplete)
+	}
+
+	values, _ := s.ObjectStorage.CompleteArgument(ctx, svc, toComplete)
+	return values, cobra.ShellCompDirectiveDefault
+}
+
+// location is either a local path or a bucket and key prefixed with s3://.
+type location struct {
+	remote bool
+	bucket string
+	key    string
+	path   string
+}
+
+func parseLocation(arg string) (location, error) {
+	rest, ok := strings.CutPrefix(arg, locationScheme)
+	if !ok {
+		return location{path: arg}, nil
+	}
+
+	bucket, key, _ := strings.Cut(rest, "/")
+	if bucket == "" {
+		return location{}, fmt.Errorf("bucket name is missing from %q", arg)
+	}
+	return location{remote: true, bucket: bucket, key: key}, nil
+}
+
+// String returns the location in the format it was given in.
+func (l location) String() string {
+	if l.remote {
+		return locationScheme + l.bucket + "/" + l.key
+	}
+	return l.path
+}
+
+// keyPrefix returns the key as a prefix that can be used to list the objects "in a directory".
+func (l location) keyPrefix() string {
+	if l.key == "" || strings.HasSuffix(l.key, "/") {
+		return l.key
+	}
+	return l.key + "/"
+}
@@ -0,0 +1,122 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3/s3test"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+const testServiceUUID = "1200ecde-db95-4d1c-9133-6508f3232567"
+
+// setupTest starts a S3 test server and returns it with a mock service that contains a managed object storage service using it.
+func setupTest(t *testing.T) (*s3test.Server, *smock.Service) {
+	t.Helper()
+	text.DisableColors()
+
+	server := s3test.NewServer()
+	t.Cleanup(server.Close)
+	server.AddBucket("my-bucket")
+
+	originalEndpointURL := EndpointURL
+	EndpointURL = func(string) string { return server.URL }
+	t.Cleanup(func() { EndpointURL = originalEndpointURL })
+
+	t.Setenv(envAccessKeyID, "AKIATEST")
+	t.Setenv(envSecretAccessKey, "secret")
+
+	mService := new(smock.Service)
+	mService.On("GetManagedObjectStorages", &request.GetManagedObjectStoragesRequest{}).Return([]upcloud.ManagedObjectStorage{{
+		UUID:   testServiceUUID,
+		Name:   "my-service",
+		Region: "europe-1",
+		Endpoints: []upcloud.ManagedObjectStorageEndpoint{
+			{DomainName: "private.example.upcloudobjects.com", Type: "private"},
+			{DomainName: "public.example.upcloudobjects.com", Type: "public"},
+		},
+	}}, nil)
+
+	return server, mService
+}
+
+func TestListCommand(t *testing.T) {
+	server, mService := setupTest(t)
+	server.PutObject("my-bucket", "index.html", []byte("<html></html>"), testTime)
+	server.PutObject("my-bucket", "assets/style.css", []byte("body {}"), testTime)
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "buckets",
+			args:     []string{"my-service"},
+			expected: []string{"my-bucket"},
+		},
+		{
+			name:     "objects",
+			args:     []string{"my-service", "s3://my-bucket"},
+			expected: []string{"assets/", "index.html"},
+		},
+		{
+			name:     "recursive",
+			args:     []string{"my-service", "s3://my-bucket", "--recursive"},
+			expected: []string{"assets/style.css", "index.html"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			c := commands.BuildCommand(ListCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			out, err := mockexecute.MockExecute(c, mService, conf)
+
+			assert.NoError(t, err)
+			for _, expected := range test.expected {
+				assert.Contains(t, out, expected)
+			}
+		})
+	}
+}
+
+func TestClientParams_MissingCredentials(t *testing.T) {
+	_, mService := setupTest(t)
+	t.Setenv(envAccessKeyID, "")
+
+	conf := config.New()
+	c := commands.BuildCommand(ListCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"my-service"})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+
+	assert.EqualError(t, err, "access key is not defined, use --access-key-id flag or UPCLOUD_OBJECT_STORAGE_ACCESS_KEY_ID environment variable")
+}
+
+func TestParseLocation(t *testing.T) {
+	for _, test := range []struct {
+		arg      string
+		expected location
+		err      string
+	}{
+		{arg: "./file.txt", expected: location{path: "./file.txt"}},
+		{arg: "s3://my-bucket", expected: location{remote: true, bucket: "my-bucket"}},
+		{arg: "s3://my-bucket/dir/file.txt", expected: location{remote: true, bucket: "my-bucket", key: "dir/file.txt"}},
+		{arg: "s3:///file.txt", err: `bucket name is missing from "s3:///file.txt"`},
+	} {
+		t.Run(test.arg, func(t *testing.T) {
+			loc, err := parseLocation(test.arg)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, loc)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresignCommand(t *testing.T) {
+	_, mService := setupTest(t)
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected []string
+		err      string
+	}{
+		{
+			name:     "get",
+			args:     []string{"my-service", "my-bucket/reports/2024.pdf"},
+			expected: []string{"/my-bucket/reports/2024.pdf?X-Amz-Algorithm=AWS4-HMAC-SHA256", "X-Amz-Expires=3600", "X-Amz-Signature="},
+		},
+		{
+			name:     "put with scheme",
+			args:     []string{"my-service", "s3://my-bucket/data.csv", "--method", "put", "--expires-in", "15m"},
+			expected: []string{"/my-bucket/data.csv?", "X-Amz-Expires=900"},
+		},
+		{
+			name: "invalid method",
+			args: []string{"my-service", "my-bucket/data.csv", "--method", "DELETE"},
+			err:  `invalid method "DELETE", must be one of: GET, PUT`,
+		},
+		{
+			name: "missing key",
+			args: []string{"my-service", "my-bucket"},
+			err:  `"my-bucket" does not define an object, use <bucket>/<key> format`,
+		},
+		{
+			name: "too long expiration",
+			args: []string{"my-service", "my-bucket/data.csv", "--expires-in", "200h"},
+			err:  "expiration must be between one second and seven days",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			conf := config.New()
+			c := commands.BuildCommand(PresignCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			out, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			for _, expected := range test.expected {
+				assert.Contains(t, out, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package object
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// RemoveCommand creates the "object-storage object rm" command
+func RemoveCommand() commands.Command {
+	return &removeCommand{
+		BaseCommand: commands.New(
+			"rm",
+			"Delete objects",
+			"upctl object-storage object rm my-service s3://my-bucket/backups/backup.tar.gz",
+			"upctl object-storage object rm my-service s3://my-bucket/a.txt s3://my-bucket/b.txt",
+			"upctl object-storage object rm my-service s3://my-bucket/tmp/ --recursive",
+		),
+	}
+}
+
+type removeCommand struct {
+	*commands.BaseCommand
+	serviceCompletion
+	ClientParams
+	parallel  int
+	recursive config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *removeCommand) InitCommand() {
+	s.Cobra().Aliases = []string{"delete"}
+	s.Cobra().Args = cobra.MinimumNArgs(2)
+
+	fs := s.Cobra().Flags()
+	s.ClientParams.AddFlags(fs)
+	fs.IntVar(&s.parallel, "parallel", defaultParallel, "Number of objects to delete in parallel.")
+	config.AddToggleFlag(fs, &s.recursive, "recursive", false, "Delete all objects with the given prefix.")
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s *removeCommand) PositionalArgumentHelp() string {
+	return "<UUID/Name> <s3://bucket/key>..."
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *removeCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	args := s.Cobra().Flags().Args()
+	if err := validateParallel(s.parallel); err != nil {
+		return nil, err
+	}
+
+	locations := []location{}
+	for _, arg := range args[1:] {
+		loc, err := parseLocation(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !loc.remote {
+			return nil, fmt.Errorf("%q is not a %s location", arg, locationScheme)
+		}
+		if !s.recursive.Value() && (loc.key == "" || strings.HasSuffix(loc.key, "/")) {
+			return nil, fmt.Errorf("%s is not an object, use --recursive to delete all objects with the prefix", loc)
+		}
+		locations = append(locations, loc)
+	}
+
+	client, err := s.NewClient(exec, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := []transfer{}
+	for _, loc := range locations {
+		if !s.recursive.Value() {
+			transfers = append(transfers, newDelete(loc.bucket, loc.key))
+			continue
+		}
+
+		// Directory markers are not included in remoteObjects, so list the objects directly.
+		listing, err := client.ListObjects(exec.Context(), loc.bucket, loc.keyPrefix(), "")
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range listing.Objects {
+			transfers = append(transfers, newDelete(loc.bucket, object.Key))
+		}
+	}
+
+	if err := runTransfers(exec, client, transfers, transferParams{parallel: s.parallel}); err != nil {
+		return nil, err
+	}
+
+	return output.OnlyMarshaled{Value: transfers}, nil
+}
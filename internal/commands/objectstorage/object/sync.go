@@ -0,0 +1,181 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// SyncCommand creates the "object-storage object sync" command
+func SyncCommand() commands.Command {
+	return &syncCommand{
+		BaseCommand: commands.New(
+			"sync",
+			"Synchronize a local directory and a bucket prefix",
+			"upctl object-storage object sync my-service ./site s3://my-bucket/site",
+			"upctl object-storage object sync my-service ./site s3://my-bucket/site --delete --dry-run",
+			"upctl object-storage object sync my-service s3://my-bucket/backups ./backups",
+		),
+	}
+}
+
+type syncCommand struct {
+	*commands.BaseCommand
+	serviceCompletion
+	ClientParams
+	transferParams
+	deleteExtra config.OptionalBoolean
+	dryRun      config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *syncCommand) InitCommand() {
+	s.Cobra().Args = cobra.ExactArgs(3)
+	s.Cobra().Long = commands.WrapLongDescription(`Synchronize a local directory and a bucket prefix
+
+Copies the files that are missing from the destination, have a different size, or have been modified after the destination was last modified. Either the source or the destination must be an ` + "`s3://<bucket>/<prefix>`" + ` location and the other a local directory.`)
+
+	fs := s.Cobra().Flags()
+	s.ClientParams.AddFlags(fs)
+	s.transferParams.addFlags(fs)
+	config.AddToggleFlag(fs, &s.deleteExtra, "delete", false, "Delete files or objects that do not exist in the source from the destination.")
+	config.AddToggleFlag(fs, &s.dryRun, "dry-run", false, "Only list the operations that would be performed.")
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s *syncCommand) PositionalArgumentHelp() string {
+	return "<UUID/Name> <source> <destination>"
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *syncCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	args := s.Cobra().Flags().Args()
+	if err := s.transferParams.validate(); err != nil {
+		return nil, err
+	}
+
+	source, destination, err := parseTransferLocations(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.NewClient(exec, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var sourceEntries, destinationEntries []entry
+	if source.remote {
+		if sourceEntries, err = remoteObjects(exec, client, source); err != nil {
+			return nil, err
+		}
+		if destinationEntries, err = localFilesIfExists(destination.path); err != nil {
+			return nil, err
+		}
+	} else {
+		info, err := os.Stat(source.path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("%s is not a directory", source.path)
+		}
+		if sourceEntries, err = localFiles(source.path); err != nil {
+			return nil, err
+		}
+		if destinationEntries, err = remoteObjects(exec, client, destination); err != nil {
+			return nil, err
+		}
+	}
+
+	transfers := syncTransfers(source, destination, sourceEntries, destinationEntries, s.deleteExtra.Value())
+
+	if s.dryRun.Value() {
+		rows := []output.TableRow{}
+		for _, t := range transfers {
+			rows = append(rows, output.TableRow{t.Operation, t.Source, t.Target})
+		}
+
+		return output.MarshaledWithHumanOutput{
+			Value: transfers,
+			Output: output.Table{
+				Columns: []output.TableColumn{
+					{Key: "operation", Header: "Operation"},
+					{Key: "source", Header: "Source"},
+					{Key: "target", Header: "Target"},
+				},
+				Rows:         rows,
+				EmptyMessage: "Destination is up to date.",
+			},
+		}, nil
+	}
+
+	if err := runTransfers(exec, client, transfers, s.transferParams); err != nil {
+		return nil, err
+	}
+
+	return output.OnlyMarshaled{Value: transfers}, nil
+}
+
+// syncTransfers compares the source and destination entries and returns the operations needed to make the destination match the source.
+func syncTransfers(source, destination location, sourceEntries, destinationEntries []entry, deleteExtra bool) []transfer {
+	existing := make(map[string]entry, len(destinationEntries))
+	for _, e := range destinationEntries {
+		existing[e.name] = e
+	}
+
+	transfers := []transfer{}
+	for _, e := range sourceEntries {
+		if current, ok := existing[e.name]; ok && !needsSync(e, current) {
+			continue
+		}
+
+		if source.remote {
+			transfers = append(transfers, newDownload(source.bucket, e.key, filepath.Join(destination.path, filepath.FromSlash(e.name)), e.size))
+		} else {
+			transfers = append(transfers, newUpload(filepath.Join(source.path, filepath.FromSlash(e.name)), destination.bucket, destination.keyPrefix()+e.name, e.size))
+		}
+	}
+
+	if deleteExtra {
+		inSource := make(map[string]bool, len(sourceEntries))
+		for _, e := range sourceEntries {
+			inSource[e.name] = true
+		}
+
+		for _, e := range destinationEntries {
+			if inSource[e.name] {
+				continue
+			}
+
+			if destination.remote {
+				transfers = append(transfers, newDelete(destination.bucket, e.key))
+			} else {
+				transfers = append(transfers, newLocalDelete(filepath.Join(destination.path, filepath.FromSlash(e.name))))
+			}
+		}
+	}
+
+	return transfers
+}
+
+// needsSync returns true if the source has different size or has been modified after the destination. Object modification times have second precision, so the times are compared with second precision.
+func needsSync(source, destination entry) bool {
+	return source.size != destination.size || source.modTime.Truncate(time.Second).After(destination.modTime.Truncate(time.Second))
+}
+
+func localFilesIfExists(dir string) ([]entry, error) {
+	files, err := localFiles(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []entry{}, nil
+	}
+	return files, err
+}
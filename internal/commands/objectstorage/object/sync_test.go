@@ -0,0 +1,110 @@
+package object
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSyncTest(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "unchanged.txt"), []byte("unchanged"))
+	writeTestFile(t, filepath.Join(dir, "modified.txt"), []byte("modified"))
+	writeTestFile(t, filepath.Join(dir, "sub", "new.txt"), []byte("new"))
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "unchanged.txt"), testTime, testTime))
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "modified.txt"), testTime.Add(time.Hour), testTime.Add(time.Hour)))
+	return dir
+}
+
+func TestSyncCommand_Upload(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		flags      []string
+		keys       []string
+		operations []string
+	}{
+		{
+			name: "default",
+			keys: []string{"site/extra.txt", "site/modified.txt", "site/sub/new.txt", "site/unchanged.txt"},
+			operations: []string{
+				"upload s3://my-bucket/site/modified.txt",
+				"upload s3://my-bucket/site/sub/new.txt",
+			},
+		},
+		{
+			name:  "delete",
+			flags: []string{"--delete"},
+			keys:  []string{"site/modified.txt", "site/sub/new.txt", "site/unchanged.txt"},
+			operations: []string{
+				"upload s3://my-bucket/site/modified.txt",
+				"upload s3://my-bucket/site/sub/new.txt",
+				"delete s3://my-bucket/site/extra.txt",
+			},
+		},
+		{
+			name:  "dry-run",
+			flags: []string{"--delete", "--dry-run"},
+			keys:  []string{"site/extra.txt", "site/modified.txt", "site/unchanged.txt"},
+			operations: []string{
+				"upload s3://my-bucket/site/modified.txt",
+				"upload s3://my-bucket/site/sub/new.txt",
+				"delete s3://my-bucket/site/extra.txt",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server, mService := setupTest(t)
+			dir := setupSyncTest(t)
+			server.PutObject("my-bucket", "site/unchanged.txt", []byte("unchanged"), testTime)
+			server.PutObject("my-bucket", "site/modified.txt", []byte("original"), testTime)
+			server.PutObject("my-bucket", "site/extra.txt", []byte("extra"), testTime)
+
+			conf := config.New()
+			conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+			c := commands.BuildCommand(SyncCommand(), nil, conf)
+			c.Cobra().SetArgs(append([]string{"my-service", dir, "s3://my-bucket/site"}, test.flags...))
+			out, err := mockexecute.MockExecute(c, mService, conf)
+			assert.NoError(t, err)
+
+			var transfers []transfer
+			require.NoError(t, json.Unmarshal([]byte(out), &transfers))
+			operations := []string{}
+			for _, tr := range transfers {
+				operations = append(operations, fmt.Sprintf("%s %s", tr.Operation, tr.Target))
+			}
+
+			assert.ElementsMatch(t, test.operations, operations)
+			assert.Equal(t, test.keys, server.Keys("my-bucket"))
+		})
+	}
+}
+
+func TestSyncCommand_Download(t *testing.T) {
+	server, mService := setupTest(t)
+	dir := setupSyncTest(t)
+	server.PutObject("my-bucket", "site/unchanged.txt", []byte("unchanged"), testTime)
+	server.PutObject("my-bucket", "site/modified.txt", []byte("changed remotely"), testTime.Add(2*time.Hour))
+
+	conf := config.New()
+	c := commands.BuildCommand(SyncCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"my-service", "s3://my-bucket/site/", dir, "--delete"})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+	assert.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "modified.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "changed remotely", string(data))
+	assert.NoFileExists(t, filepath.Join(dir, "sub", "new.txt"))
+	assert.NotContains(t, server.Requests(), "GET /my-bucket/site/unchanged.txt")
+}
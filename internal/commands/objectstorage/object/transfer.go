@@ -0,0 +1,250 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+	"github.com/spf13/pflag"
+	"golang.org/x/sync/errgroup"
+)
+
+type operation string
+
+const (
+	operationUpload   operation = "upload"
+	operationDownload operation = "download"
+	operationDelete   operation = "delete"
+)
+
+// transfer is a single upload, download, or delete operation.
+type transfer struct {
+	Operation operation `json:"operation"`
+	Source    string    `json:"source,omitempty"`
+	Target    string    `json:"target"`
+	Size      int64     `json:"size,omitempty"`
+
+	bucket string
+	key    string
+	path   string
+}
+
+func newUpload(path, bucket, key string, size int64) transfer {
+	return transfer{Operation: operationUpload, Source: path, Target: locationScheme + bucket + "/" + key, Size: size, bucket: bucket, key: key, path: path}
+}
+
+func newDownload(bucket, key, path string, size int64) transfer {
+	return transfer{Operation: operationDownload, Source: locationScheme + bucket + "/" + key, Target: path, Size: size, bucket: bucket, key: key, path: path}
+}
+
+func newDelete(bucket, key string) transfer {
+	return transfer{Operation: operationDelete, Target: locationScheme + bucket + "/" + key, bucket: bucket, key: key}
+}
+
+func newLocalDelete(path string) transfer {
+	return transfer{Operation: operationDelete, Target: path, path: path}
+}
+
+func (t transfer) message() string {
+	switch t.Operation {
+	case operationUpload:
+		return fmt.Sprintf("Uploading %s to %s", t.Source, t.Target)
+	case operationDownload:
+		return fmt.Sprintf("Downloading %s to %s", t.Source, t.Target)
+	default:
+		return fmt.Sprintf("Deleting %s", t.Target)
+	}
+}
+
+type transferParams struct {
+	parallel int
+	partSize int
+}
+
+func (p *transferParams) addFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&p.parallel, "parallel", defaultParallel, "Number of objects to transfer in parallel.")
+	fs.IntVar(&p.partSize, "part-size", int(s3.DefaultPartSize/1024/1024), "Part size in mebibytes for multipart uploads. Files larger than the part size are uploaded in parts.")
+}
+
+func (p *transferParams) validate() error {
+	if err := validateParallel(p.parallel); err != nil {
+		return err
+	}
+	// S3 requires all parts, except the last one, to be at least 5 MiB.
+	if p.partSize < 5 {
+		return fmt.Errorf("invalid value %d for --part-size, must be at least 5", p.partSize)
+	}
+	return nil
+}
+
+func validateParallel(parallel int) error {
+	if parallel < 1 {
+		return fmt.Errorf("invalid value %d for --parallel, must be at least 1", parallel)
+	}
+	return nil
+}
+
+// runTransfers runs the transfers in parallel. Failing transfers are reported in the progress log and do not stop the other transfers.
+func runTransfers(exec commands.Executor, client *s3.Client, transfers []transfer, params transferParams) error {
+	var failed atomic.Int64
+	var eg errgroup.Group
+	eg.SetLimit(params.parallel)
+	for _, t := range transfers {
+		eg.Go(func() error {
+			msg := t.message()
+			exec.PushProgressStarted(msg)
+			if err := t.run(exec, client, msg, params); err != nil {
+				failed.Add(1)
+				_, _ = commands.HandleError(exec, msg, err)
+				return nil
+			}
+			exec.PushProgressSuccess(msg)
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	if n := failed.Load(); n > 0 {
+		return fmt.Errorf("%d of %d operations failed", n, len(transfers))
+	}
+	return nil
+}
+
+func (t transfer) run(exec commands.Executor, client *s3.Client, msg string, params transferParams) error {
+	ctx := exec.Context()
+	switch t.Operation {
+	case operationUpload:
+		return t.upload(ctx, exec, client, msg, int64(params.partSize)*1024*1024)
+	case operationDownload:
+		return t.download(ctx, exec, client, msg)
+	default:
+		if t.path != "" {
+			return os.Remove(t.path)
+		}
+		return client.DeleteObject(ctx, t.bucket, t.key)
+	}
+}
+
+func (t transfer) upload(ctx context.Context, exec commands.Executor, client *s3.Client, msg string, partSize int64) error {
+	file, err := os.Open(t.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	source := &readerAtCounter{source: file}
+	stop := reportProgress(exec, msg, t.Size, &source.read)
+	defer stop()
+
+	return client.Upload(ctx, t.bucket, t.key, source, t.Size, s3.UploadOptions{PartSize: partSize})
+}
+
+// download writes the object into a temporary file next to the target path and renames it to the target path after the download is complete.
+func (t transfer) download(ctx context.Context, exec commands.Executor, client *s3.Client, msg string) error {
+	body, object, err := client.GetObject(ctx, t.bucket, t.key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	dir := filepath.Dir(t.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(dir, "."+filepath.Base(t.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	// The temporary file does not exist anymore, if it was renamed to the target path.
+	defer os.Remove(file.Name())
+
+	source := &readerCounter{source: body}
+	stop := reportProgress(exec, msg, object.Size, &source.read)
+	_, err = io.Copy(file, source)
+	stop()
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.Rename(file.Name(), t.path); err != nil {
+		return err
+	}
+	// Use the modification time of the object, so that the file is not considered changed by sync.
+	if !object.LastModified.IsZero() {
+		return os.Chtimes(t.path, object.LastModified, object.LastModified)
+	}
+	return nil
+}
+
+type readerCounter struct {
+	source io.Reader
+	read   atomic.Int64
+}
+
+// Read implements io.Reader
+func (s *readerCounter) Read(p []byte) (n int, err error) {
+	n, err = s.source.Read(p)
+	s.read.Add(int64(n))
+	return n, err
+}
+
+type readerAtCounter struct {
+	source io.ReaderAt
+	read   atomic.Int64
+}
+
+// ReadAt implements io.ReaderAt
+func (s *readerAtCounter) ReadAt(p []byte, off int64) (n int, err error) {
+	n, err = s.source.ReadAt(p, off)
+	s.read.Add(int64(n))
+	return n, err
+}
+
+// reportProgress updates the transfer progress to the progress log until the returned stop function is called.
+func reportProgress(exec commands.Executor, msg string, size int64, transferred *atomic.Int64) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	startTime := time.Now()
+
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(300 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				n := transferred.Load()
+				if size <= 0 || n <= 0 {
+					continue
+				}
+				bps := float64(n) / time.Since(startTime).Seconds()
+				exec.PushProgressUpdate(messages.Update{
+					Key: msg,
+					ProgressMessage: fmt.Sprintf(
+						"- %.2f%% (%sBps)",
+						min(float64(n)/float64(size)*100, 100),
+						ui.AbbrevNumBinaryPrefix(uint(bps)),
+					),
+				})
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
@@ -0,0 +1,75 @@
+package policy
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// CreateCommand creates the 'object-storage policy create' command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a policy in managed object storage service",
+			"upctl object-storage policy create <service-uuid> --name mypolicy --document policy.json",
+			`upctl object-storage policy create my-service --name mypolicy --description "Read-only access to my-bucket" --document policy.json`,
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	resolver.CachingObjectStorage
+	params   request.CreateManagedObjectStoragePolicyRequest
+	document string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Create a policy in managed object storage service
+
+The policy document is read from a JSON file and validated before it is uploaded. The document must define ` + "`Version`" + ` and at least one statement with ` + "`Effect`" + `, ` + "`Action`" + `, and ` + "`Resource`" + `.`)
+
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.params.Name, "name", "", "The name of the policy.")
+	fs.StringVar(&s.params.Description, "description", "", "The description of the policy.")
+	fs.StringVar(&s.document, "document", "", "Path to a JSON file containing the policy document.")
+	commands.Must(fs.SetAnnotation("name", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(fs.SetAnnotation("description", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("document"))
+}
+
+// Execute implements Command.Execute
+func (s *createCommand) Execute(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	document, err := ReadDocument(s.document)
+	if err != nil {
+		return nil, err
+	}
+
+	req := s.params
+	req.ServiceUUID = serviceUUID
+	req.Document = document
+
+	msg := fmt.Sprintf("Creating policy %s in service %s", req.Name, serviceUUID)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateManagedObjectStoragePolicy(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "Name", Value: res.Name},
+		{Title: "ARN", Value: res.ARN},
+		{Title: "Default version", Value: res.DefaultVersionID},
+	}}, nil
+}
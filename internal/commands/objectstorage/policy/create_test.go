@@ -0,0 +1,76 @@
+package policy
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateCommand(t *testing.T) {
+	targetMethod := "CreateManagedObjectStoragePolicy"
+	serviceUUID := "17fbd082-30b0-11eb-adc1-0242ac120003"
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.json")
+	assert.NoError(t, os.WriteFile(valid, []byte(`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]
+}`), 0o600))
+
+	invalid := filepath.Join(dir, "invalid.json")
+	assert.NoError(t, os.WriteFile(invalid, []byte(`{"Version": "2012-10-17", "Statement": []}`), 0o600))
+
+	for _, test := range []struct {
+		name  string
+		args  []string
+		error string
+	}{
+		{
+			name: "valid document",
+			args: []string{"--name", "mypolicy", "--description", "Full access", "--document", valid},
+		},
+		{
+			name:  "invalid document",
+			args:  []string{"--name", "mypolicy", "--document", invalid},
+			error: "invalid policy document: document must contain at least one statement",
+		},
+		{
+			name:  "missing document",
+			args:  []string{"--name", "mypolicy", "--document", filepath.Join(dir, "missing.json")},
+			error: "cannot read policy document: open " + filepath.Join(dir, "missing.json") + ": no such file or directory",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := new(smock.Service)
+			mService.On(targetMethod, &request.CreateManagedObjectStoragePolicyRequest{
+				ServiceUUID: serviceUUID,
+				Name:        "mypolicy",
+				Description: "Full access",
+				Document:    url.QueryEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`),
+			}).Return(&upcloud.ManagedObjectStoragePolicy{Name: "mypolicy"}, nil)
+
+			conf := config.New()
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(append(test.args, serviceUUID))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				mService.AssertNotCalled(t, targetMethod, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				mService.AssertNumberOfCalls(t, targetMethod, 1)
+			}
+		})
+	}
+}
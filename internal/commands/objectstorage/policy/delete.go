@@ -0,0 +1,63 @@
+package policy
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// DeleteCommand creates the 'object-storage policy delete' command
+func DeleteCommand() commands.Command {
+	return &deleteCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a policy from managed object storage service",
+			"upctl object-storage policy delete <service-uuid> --name mypolicy",
+			"upctl object-storage policy delete my-service --name mypolicy",
+		),
+	}
+}
+
+type deleteCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	resolver.CachingObjectStorage
+	params request.DeleteManagedObjectStoragePolicyRequest
+}
+
+// InitCommand implements Command.InitCommand
+func (s *deleteCommand) InitCommand() {
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.params.Name, "name", "", "The name of the policy to delete.")
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *deleteCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.ObjectStoragePolicy{ObjectStorage: args[0]}
+	}, cfg)))
+}
+
+// Execute implements Command.Execute
+func (s *deleteCommand) Execute(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	req := s.params
+	req.ServiceUUID = serviceUUID
+
+	msg := fmt.Sprintf("Deleting policy %s from service %s", req.Name, serviceUUID)
+	exec.PushProgressStarted(msg)
+
+	err := exec.All().DeleteManagedObjectStoragePolicy(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
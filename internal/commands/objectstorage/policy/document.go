@@ -0,0 +1,162 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+)
+
+var (
+	documentVersions = []string{"2012-10-17", "2008-10-17"}
+	statementEffects = []string{"Allow", "Deny"}
+)
+
+// Document is the parsed form of an IAM policy document.
+type Document struct {
+	Version   string      `json:"Version"`
+	ID        string      `json:"Id,omitempty"`
+	Statement []Statement `json:"Statement"`
+}
+
+// Statement is a single statement of an IAM policy document. Principal and NotPrincipal are only used in bucket policies.
+type Statement struct {
+	Sid          string          `json:"Sid,omitempty"`
+	Effect       string          `json:"Effect"`
+	Principal    json.RawMessage `json:"Principal,omitempty"`
+	NotPrincipal json.RawMessage `json:"NotPrincipal,omitempty"`
+	Action       stringOrSlice   `json:"Action,omitempty"`
+	NotAction    stringOrSlice   `json:"NotAction,omitempty"`
+	Resource     stringOrSlice   `json:"Resource,omitempty"`
+	NotResource  stringOrSlice   `json:"NotResource,omitempty"`
+	Condition    json.RawMessage `json:"Condition,omitempty"`
+}
+
+// stringOrSlice handles policy fields that can be defined either as a single string or as a list of strings.
+type stringOrSlice []string
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *stringOrSlice) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*s = stringOrSlice{single}
+		return nil
+	}
+
+	var slice []string
+	if err := json.Unmarshal(b, &slice); err != nil {
+		return fmt.Errorf("expected a string or a list of strings")
+	}
+	*s = slice
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Statement can be defined either as a single object or as a list of objects.
+func (d *Document) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Version   string          `json:"Version"`
+		ID        string          `json:"Id"`
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	d.Version = raw.Version
+	d.ID = raw.ID
+	d.Statement = nil
+
+	statement := bytes.TrimSpace(raw.Statement)
+	switch {
+	case len(statement) == 0:
+		return nil
+	case statement[0] == '{':
+		var single Statement
+		if err := json.Unmarshal(statement, &single); err != nil {
+			return fmt.Errorf("invalid statement: %w", err)
+		}
+		d.Statement = []Statement{single}
+	default:
+		if err := json.Unmarshal(statement, &d.Statement); err != nil {
+			return fmt.Errorf("invalid statement: %w", err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the document has the fields required by the object storage service.
+func (d *Document) Validate() error {
+	var errs []error
+	if !slices.Contains(documentVersions, d.Version) {
+		errs = append(errs, fmt.Errorf("version must be one of %v, got %q", documentVersions, d.Version))
+	}
+
+	if len(d.Statement) == 0 {
+		errs = append(errs, errors.New("document must contain at least one statement"))
+	}
+
+	for i, statement := range d.Statement {
+		prefix := fmt.Sprintf("statement %d", i+1)
+		if statement.Sid != "" {
+			prefix = fmt.Sprintf("statement %d (%s)", i+1, statement.Sid)
+		}
+
+		if !slices.Contains(statementEffects, statement.Effect) {
+			errs = append(errs, fmt.Errorf("%s: effect must be one of %v, got %q", prefix, statementEffects, statement.Effect))
+		}
+		if len(statement.Action) == 0 && len(statement.NotAction) == 0 {
+			errs = append(errs, fmt.Errorf("%s: either Action or NotAction must be defined", prefix))
+		}
+		if len(statement.Resource) == 0 && len(statement.NotResource) == 0 {
+			errs = append(errs, fmt.Errorf("%s: either Resource or NotResource must be defined", prefix))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ReadDocument reads a policy document from given JSON file and validates it. Returns the document in the URL encoded format expected by the API.
+func ReadDocument(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("cannot read policy document: %w", err)
+	}
+
+	return EncodeDocument(content)
+}
+
+// EncodeDocument validates given policy document JSON and returns it in the URL encoded format expected by the API.
+func EncodeDocument(content []byte) (string, error) {
+	var doc Document
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return "", fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	if err := doc.Validate(); err != nil {
+		return "", fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	compacted := new(bytes.Buffer)
+	if err := json.Compact(compacted, content); err != nil {
+		return "", fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	return url.QueryEscape(compacted.String()), nil
+}
+
+// DecodeDocument parses a policy document returned by the API.
+func DecodeDocument(document string) (*Document, error) {
+	unescaped, err := url.QueryUnescape(document)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode policy document: %w", err)
+	}
+
+	var doc Document
+	if err := json.Unmarshal([]byte(unescaped), &doc); err != nil {
+		return nil, fmt.Errorf("cannot parse policy document: %w", err)
+	}
+	return &doc, nil
+}
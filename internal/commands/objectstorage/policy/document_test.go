@@ -0,0 +1,70 @@
+package policy
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDocument(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		document string
+		expected string
+		error    string
+	}{
+		{
+			name: "statement list",
+			document: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "read", "Effect": "Allow", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "arn:aws:s3:::my-bucket/*"}
+  ]
+}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Sid":"read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"arn:aws:s3:::my-bucket/*"}]}`,
+		},
+		{
+			name:     "single statement with negated fields",
+			document: `{"Version": "2012-10-17", "Statement": {"Effect": "Deny", "NotAction": "s3:GetObject", "NotResource": ["arn:aws:s3:::public/*"]}}`,
+			expected: `{"Version":"2012-10-17","Statement":{"Effect":"Deny","NotAction":"s3:GetObject","NotResource":["arn:aws:s3:::public/*"]}}`,
+		},
+		{
+			name:     "invalid json",
+			document: `{"Version": "2012-10-17",`,
+			error:    "invalid policy document: unexpected end of JSON input",
+		},
+		{
+			name:     "missing version and statements",
+			document: `{}`,
+			error:    "invalid policy document: version must be one of [2012-10-17 2008-10-17], got \"\"\ndocument must contain at least one statement",
+		},
+		{
+			name:     "invalid statement",
+			document: `{"Version": "2012-10-17", "Statement": [{"Sid": "bad", "Effect": "Permit"}]}`,
+			error:    "invalid policy document: statement 1 (bad): effect must be one of [Allow Deny], got \"Permit\"\nstatement 1 (bad): either Action or NotAction must be defined\nstatement 1 (bad): either Resource or NotResource must be defined",
+		},
+		{
+			name:     "invalid action type",
+			document: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": 1, "Resource": "*"}]}`,
+			error:    "invalid policy document: invalid statement: expected a string or a list of strings",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := EncodeDocument([]byte(test.document))
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				return
+			}
+
+			assert.NoError(t, err)
+			decoded, err := url.QueryUnescape(encoded)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, decoded)
+
+			doc, err := DecodeDocument(encoded)
+			assert.NoError(t, err)
+			assert.NoError(t, doc.Validate())
+		})
+	}
+}
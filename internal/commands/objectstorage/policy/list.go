@@ -0,0 +1,64 @@
+package policy
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListCommand creates the 'object-storage policy list' command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List policies in managed object storage service",
+			"upctl object-storage policy list <service-uuid>",
+			"upctl object-storage policy list my-service",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	resolver.CachingObjectStorage
+}
+
+// Execute implements Command.Execute
+func (s *listCommand) Execute(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	policies, err := exec.All().GetManagedObjectStoragePolicies(exec.Context(), &request.GetManagedObjectStoragePoliciesRequest{ServiceUUID: serviceUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, policy := range policies {
+		rows = append(rows, output.TableRow{
+			policy.Name,
+			policy.Description,
+			policy.DefaultVersionID,
+			policy.AttachmentCount,
+			policy.System,
+			policy.CreatedAt,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: policies,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "name", Header: "Name"},
+				{Key: "description", Header: "Description", Format: format.PossiblyUnknownString},
+				{Key: "default_version_id", Header: "Default version"},
+				{Key: "attachment_count", Header: "Attachments"},
+				{Key: "system", Header: "System", Format: format.Boolean},
+				{Key: "created_at", Header: "Created"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No policies found.",
+		},
+	}, nil
+}
@@ -0,0 +1,21 @@
+package policy
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+)
+
+// BasePolicyCommand creates the base "object-storage policy" command
+func BasePolicyCommand() commands.Command {
+	return &policyCommand{
+		BaseCommand: commands.New("policy", "Manage policies in managed object storage services"),
+	}
+}
+
+type policyCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (s *policyCommand) InitCommand() {
+	s.Cobra().Aliases = []string{"policies"}
+}
@@ -0,0 +1,144 @@
+package policy
+
+import (
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ShowCommand creates the 'object-storage policy show' command
+func ShowCommand() commands.Command {
+	return &showCommand{
+		BaseCommand: commands.New(
+			"show",
+			"Show policy details in managed object storage service",
+			"upctl object-storage policy show <service-uuid> --name mypolicy",
+			"upctl object-storage policy show my-service --name mypolicy --version v2",
+		),
+	}
+}
+
+type showCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	resolver.CachingObjectStorage
+	name      string
+	versionID string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *showCommand) InitCommand() {
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.name, "name", "", "The name of the policy.")
+	fs.StringVar(&s.versionID, "version", "", "The version of the policy document to show. Defaults to the default version of the policy.")
+	commands.Must(fs.SetAnnotation("version", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *showCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.ObjectStoragePolicy{ObjectStorage: args[0]}
+	}, cfg)))
+}
+
+// Execute implements Command.Execute
+func (s *showCommand) Execute(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	svc := exec.All()
+	policy, err := svc.GetManagedObjectStoragePolicy(exec.Context(), &request.GetManagedObjectStoragePolicyRequest{
+		ServiceUUID: serviceUUID,
+		Name:        s.name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := s.versionID
+	if versionID == "" {
+		versionID = policy.DefaultVersionID
+	}
+
+	version, err := svc.GetManagedObjectStoragePolicyVersion(exec.Context(), &request.GetManagedObjectStoragePolicyVersionRequest{
+		ServiceUUID: serviceUUID,
+		Name:        s.name,
+		VersionID:   versionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := DecodeDocument(version.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	statementRows := []output.TableRow{}
+	for _, statement := range doc.Statement {
+		statementRows = append(statementRows, output.TableRow{
+			statement.Sid,
+			statement.Effect,
+			Negatable(statement.Action, statement.NotAction),
+			Negatable(statement.Resource, statement.NotResource),
+			string(statement.Condition),
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: policy,
+		Output: output.Combined{
+			output.CombinedSection{
+				Contents: output.Details{
+					Sections: []output.DetailSection{
+						{
+							Title: "Overview:",
+							Rows: []output.DetailRow{
+								{Title: "Name:", Value: policy.Name},
+								{Title: "ARN:", Value: policy.ARN},
+								{Title: "Description:", Value: policy.Description, Format: format.PossiblyUnknownString},
+								{Title: "Default version:", Value: policy.DefaultVersionID},
+								{Title: "Shown version:", Value: version.VersionID},
+								{Title: "Attachments:", Value: policy.AttachmentCount},
+								{Title: "System:", Value: policy.System, Format: format.Boolean},
+								{Title: "Created:", Value: policy.CreatedAt},
+								{Title: "Updated:", Value: policy.UpdatedAt},
+							},
+						},
+					},
+				},
+			},
+			output.CombinedSection{
+				Title: "Statements:",
+				Contents: output.Table{
+					Columns: []output.TableColumn{
+						{Key: "sid", Header: "Sid", Format: format.PossiblyUnknownString},
+						{Key: "effect", Header: "Effect", Format: format.ObjectStoragePolicyStatementEffect},
+						{Key: "action", Header: "Actions", Format: format.StringSliceAnd},
+						{Key: "resource", Header: "Resources", Format: format.StringSliceAnd},
+						{Key: "condition", Header: "Condition", Format: format.PossiblyUnknownString},
+					},
+					Rows:         statementRows,
+					EmptyMessage: "No statements found.",
+				},
+			},
+		},
+	}, nil
+}
+
+// Negatable returns the values of a field that has a negated counterpart, e.g. Action and NotAction. Negated values are prefixed with "NOT ".
+func Negatable(values, notValues []string) []string {
+	if len(values) > 0 {
+		return values
+	}
+
+	negated := make([]string, 0, len(notValues))
+	for _, value := range notValues {
+		negated = append(negated, "NOT "+strings.TrimSpace(value))
+	}
+	return negated
+}
@@ -0,0 +1,39 @@
+package policy
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShowCommand(t *testing.T) {
+	text.DisableColors()
+	serviceUUID := "17fbd082-30b0-11eb-adc1-0242ac120003"
+	document := `{"Version":"2012-10-17","Statement":[{"Sid":"read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"arn:aws:s3:::my-bucket/*"},{"Effect":"Deny","NotAction":"s3:GetObject","Resource":"*"}]}`
+
+	mService := new(smock.Service)
+	mService.On("GetManagedObjectStoragePolicy", &request.GetManagedObjectStoragePolicyRequest{ServiceUUID: serviceUUID, Name: "mypolicy"}).
+		Return(&upcloud.ManagedObjectStoragePolicy{Name: "mypolicy", DefaultVersionID: "v2"}, nil)
+	mService.On("GetManagedObjectStoragePolicyVersion", &request.GetManagedObjectStoragePolicyVersionRequest{ServiceUUID: serviceUUID, Name: "mypolicy", VersionID: "v2"}).
+		Return(&upcloud.ManagedObjectStoragePolicyVersion{VersionID: "v2", IsDefault: true, Document: url.QueryEscape(document)}, nil)
+
+	conf := config.New()
+	c := commands.BuildCommand(ShowCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{serviceUUID, "--name", "mypolicy"})
+	out, err := mockexecute.MockExecute(c, mService, conf)
+
+	assert.NoError(t, err)
+	assert.Contains(t, out, "Statements:")
+	assert.Contains(t, out, "s3:ListBucket")
+	assert.Contains(t, out, "arn:aws:s3:::my-bucket/*")
+	assert.Contains(t, out, "NOT s3:GetObject")
+	mService.AssertNumberOfCalls(t, "GetManagedObjectStoragePolicyVersion", 1)
+}
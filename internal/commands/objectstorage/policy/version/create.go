@@ -0,0 +1,79 @@
+package version
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/policy"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// CreateCommand creates the 'object-storage policy version create' command
+func CreateCommand() commands.Command {
+	return &createCommand{
+		BaseCommand: commands.New(
+			"create",
+			"Create a new version of a policy in managed object storage service",
+			"upctl object-storage policy version create <service-uuid> --name mypolicy --document policy.json",
+			"upctl object-storage policy version create my-service --name mypolicy --document policy.json --set-default",
+		),
+	}
+}
+
+type createCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	resolver.CachingObjectStorage
+	params     request.CreateManagedObjectStoragePolicyVersionRequest
+	document   string
+	setDefault config.OptionalBoolean
+}
+
+// InitCommand implements Command.InitCommand
+func (s *createCommand) InitCommand() {
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.params.Name, "name", "", "The name of the policy.")
+	fs.StringVar(&s.document, "document", "", "Path to a JSON file containing the policy document.")
+	config.AddToggleFlag(fs, &s.setDefault, "set-default", false, "Set the new version as the default version of the policy.")
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("document"))
+}
+
+func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.ObjectStoragePolicy{ObjectStorage: args[0]}
+	}, cfg)))
+}
+
+// Execute implements Command.Execute
+func (s *createCommand) Execute(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	document, err := policy.ReadDocument(s.document)
+	if err != nil {
+		return nil, err
+	}
+
+	req := s.params
+	req.ServiceUUID = serviceUUID
+	req.Document = document
+	req.IsDefault = s.setDefault.Value()
+
+	msg := fmt.Sprintf("Creating new version of policy %s in service %s", req.Name, serviceUUID)
+	exec.PushProgressStarted(msg)
+
+	res, err := exec.All().CreateManagedObjectStoragePolicyVersion(exec.Context(), &req)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "Version", Value: res.VersionID},
+		{Title: "Default", Value: res.IsDefault},
+	}}, nil
+}
@@ -0,0 +1,76 @@
+package version
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListCommand creates the 'object-storage policy version list' command
+func ListCommand() commands.Command {
+	return &listCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List versions of a policy in managed object storage service",
+			"upctl object-storage policy version list <service-uuid> --name mypolicy",
+			"upctl object-storage policy version list my-service --name mypolicy",
+		),
+	}
+}
+
+type listCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	resolver.CachingObjectStorage
+	params request.GetManagedObjectStoragePolicyVersionsRequest
+}
+
+// InitCommand implements Command.InitCommand
+func (s *listCommand) InitCommand() {
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.params.Name, "name", "", "The name of the policy.")
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+}
+
+func (s *listCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.ObjectStoragePolicy{ObjectStorage: args[0]}
+	}, cfg)))
+}
+
+// Execute implements Command.Execute
+func (s *listCommand) Execute(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	req := s.params
+	req.ServiceUUID = serviceUUID
+
+	versions, err := exec.All().GetManagedObjectStoragePolicyVersions(exec.Context(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []output.TableRow{}
+	for _, version := range versions {
+		rows = append(rows, output.TableRow{
+			version.VersionID,
+			version.IsDefault,
+			version.CreatedAt,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: versions,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "version_id", Header: "Version"},
+				{Key: "is_default", Header: "Default", Format: format.Boolean},
+				{Key: "created_at", Header: "Created"},
+			},
+			Rows: rows,
+		},
+	}, nil
+}
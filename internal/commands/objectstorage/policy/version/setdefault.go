@@ -0,0 +1,92 @@
+package version
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// SetDefaultCommand creates the 'object-storage policy version set-default' command
+func SetDefaultCommand() commands.Command {
+	return &setDefaultCommand{
+		BaseCommand: commands.New(
+			"set-default",
+			"Set the default version of a policy in managed object storage service",
+			"upctl object-storage policy version set-default <service-uuid> --name mypolicy --version v1",
+			"upctl object-storage policy version set-default my-service --name mypolicy --version v1",
+		),
+	}
+}
+
+type setDefaultCommand struct {
+	*commands.BaseCommand
+	completion.ObjectStorage
+	resolver.CachingObjectStorage
+	name      string
+	versionID string
+}
+
+// InitCommand implements Command.InitCommand
+func (s *setDefaultCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Set the default version of a policy in managed object storage service
+
+The API does not allow changing the default flag of an existing version. Instead, the document of the given version is published as a new version which is set as the default version of the policy. The previous versions are left untouched.`)
+
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.name, "name", "", "The name of the policy.")
+	fs.StringVar(&s.versionID, "version", "", "The version to set as the default version.")
+	commands.Must(fs.SetAnnotation("version", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(s.Cobra().MarkFlagRequired("name"))
+	commands.Must(s.Cobra().MarkFlagRequired("version"))
+}
+
+func (s *setDefaultCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("name", namedargs.CompletionFuncWithArgs(func(args []string) completion.Provider {
+		return completion.ObjectStoragePolicy{ObjectStorage: args[0]}
+	}, cfg)))
+}
+
+// Execute implements Command.Execute
+func (s *setDefaultCommand) Execute(exec commands.Executor, serviceUUID string) (output.Output, error) {
+	svc := exec.All()
+	msg := fmt.Sprintf("Setting version %s as the default version of policy %s in service %s", s.versionID, s.name, serviceUUID)
+	exec.PushProgressStarted(msg)
+
+	version, err := svc.GetManagedObjectStoragePolicyVersion(exec.Context(), &request.GetManagedObjectStoragePolicyVersionRequest{
+		ServiceUUID: serviceUUID,
+		Name:        s.name,
+		VersionID:   s.versionID,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	if version.IsDefault {
+		exec.PushProgressSuccess(msg)
+		return output.OnlyMarshaled{Value: version}, nil
+	}
+
+	res, err := svc.CreateManagedObjectStoragePolicyVersion(exec.Context(), &request.CreateManagedObjectStoragePolicyVersionRequest{
+		ServiceUUID: serviceUUID,
+		Name:        s.name,
+		Document:    version.Document,
+		IsDefault:   true,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.MarshaledWithHumanDetails{Value: res, Details: []output.DetailRow{
+		{Title: "Version", Value: res.VersionID},
+		{Title: "Copied from version", Value: version.VersionID},
+		{Title: "Default", Value: res.IsDefault},
+	}}, nil
+}
@@ -0,0 +1,62 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetDefaultCommand(t *testing.T) {
+	targetMethod := "CreateManagedObjectStoragePolicyVersion"
+	serviceUUID := "17fbd082-30b0-11eb-adc1-0242ac120003"
+
+	for _, test := range []struct {
+		name      string
+		isDefault bool
+		calls     int
+	}{
+		{
+			name:  "publishes the document as a new default version",
+			calls: 1,
+		},
+		{
+			name:      "already default",
+			isDefault: true,
+			calls:     0,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := new(smock.Service)
+			mService.On("GetManagedObjectStoragePolicyVersion", &request.GetManagedObjectStoragePolicyVersionRequest{
+				ServiceUUID: serviceUUID,
+				Name:        "mypolicy",
+				VersionID:   "v1",
+			}).Return(&upcloud.ManagedObjectStoragePolicyVersion{VersionID: "v1", Document: "doc", IsDefault: test.isDefault}, nil)
+			mService.On(targetMethod, &request.CreateManagedObjectStoragePolicyVersionRequest{
+				ServiceUUID: serviceUUID,
+				Name:        "mypolicy",
+				Document:    "doc",
+				IsDefault:   true,
+			}).Return(&upcloud.ManagedObjectStoragePolicyVersion{VersionID: "v3", Document: "doc", IsDefault: true}, nil)
+
+			conf := config.New()
+			c := commands.BuildCommand(SetDefaultCommand(), nil, conf)
+			c.Cobra().SetArgs([]string{serviceUUID, "--name", "mypolicy", "--version", "v1"})
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			assert.NoError(t, err)
+			if test.calls == 0 {
+				mService.AssertNotCalled(t, targetMethod, mock.Anything)
+			} else {
+				mService.AssertNumberOfCalls(t, targetMethod, test.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package version
+
+import (
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+)
+
+// BaseVersionCommand creates the base "object-storage policy version" command
+func BaseVersionCommand() commands.Command {
+	return &versionCommand{
+		BaseCommand: commands.New("version", "Manage versions of policies in managed object storage services"),
+	}
+}
+
+type versionCommand struct {
+	*commands.BaseCommand
+}
+
+// InitCommand implements Command.InitCommand
+func (s *versionCommand) InitCommand() {
+	s.Cobra().Aliases = []string{"versions"}
+}
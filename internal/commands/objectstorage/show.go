@@ -2,6 +2,7 @@ package objectstorage
 
 import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/objectstorage/customdomain"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
@@ -49,6 +50,11 @@ func (c *showCommand) Execute(exec commands.Executor, uuid string) (output.Outpu
 		return nil, err
 	}
 
+	customDomains, err := svc.GetManagedObjectStorageCustomDomains(exec.Context(), &request.GetManagedObjectStorageCustomDomainsRequest{ServiceUUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
 	endpointRows := make([]output.TableRow, 0)
 	for _, endpoint := range objectStorage.Endpoints {
 		endpointRows = append(endpointRows, output.TableRow{
@@ -62,6 +68,22 @@ func (c *showCommand) Execute(exec commands.Executor, uuid string) (output.Outpu
 		{Key: "type", Header: "Type"},
 	}
 
+	cnameTarget := customdomain.CNAMETarget(objectStorage)
+	customDomainRows := make([]output.TableRow, 0)
+	for _, customDomain := range customDomains {
+		customDomainRows = append(customDomainRows, output.TableRow{
+			customDomain.DomainName,
+			customDomain.Type,
+			cnameTarget,
+		})
+	}
+
+	customDomainColumns := []output.TableColumn{
+		{Key: "domain_name", Header: "Domain"},
+		{Key: "type", Header: "Type"},
+		{Key: "cname_target", Header: "CNAME target"},
+	}
+
 	networkRows := make([]output.TableRow, 0)
 	for _, network := range objectStorage.Networks {
 		networkUUID := ""
@@ -147,6 +169,15 @@ func (c *showCommand) Execute(exec commands.Executor, uuid string) (output.Outpu
 					EmptyMessage: "No endpoints found for this Managed object storage service.",
 				},
 			},
+			output.CombinedSection{
+				Key:   "custom_domains",
+				Title: "Custom domains:",
+				Contents: output.Table{
+					Columns:      customDomainColumns,
+					Rows:         customDomainRows,
+					EmptyMessage: "No custom domains found for this Managed object storage service.",
+				},
+			},
 			output.CombinedSection{
 				Key:   "networks",
 				Title: "Networks:",
@@ -10,6 +10,11 @@ import (
 	internal "github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
 )
 
+// RunCommand runs the command with the given service the same way as the RunE function set by BuildCommand, but without creating the service from the configuration.
+func RunCommand(command Command, service internal.AllServices, config *config.Config, args []string) error {
+	return commandRunE(command, service, config, args)
+}
+
 func commandRunE(command Command, service internal.AllServices, config *config.Config, args []string) error {
 	// Cobra validations were successful
 	command.Cobra().SilenceUsage = true
@@ -126,6 +131,29 @@ func execute(command Command, executor Executor, args []string, mode resolveMode
 		return nil, fmt.Errorf("cannot resolve command line arguments: %w", err)
 	}
 
+	outputs := executeInWorkers(executor, resolvedArgs, parallelRuns, executeCommand)
+
+	executor.Debug("execute done")
+	// We're done, update ui for the last time and render the results
+	executor.StopProgressLog()
+	return outputs, nil
+}
+
+// ExecuteInParallel executes executeCommand for each of the args using at most parallelRuns concurrent workers. Errors are logged to the progress log and returned as output.Error outputs.
+// The outputs are returned in the order the executions finished.
+func ExecuteInParallel(executor Executor, args []string, parallelRuns int, executeCommand func(exec Executor, arg string) (output.Output, error)) []output.Output {
+	if len(args) == 0 {
+		return nil
+	}
+
+	resolvedArgs := make([]resolvedArgument, 0, len(args))
+	for _, arg := range args {
+		resolvedArgs = append(resolvedArgs, resolvedArgument{Resolved: arg, Original: arg})
+	}
+	return executeInWorkers(executor, resolvedArgs, parallelRuns, executeCommand)
+}
+
+func executeInWorkers(executor Executor, resolvedArgs []resolvedArgument, parallelRuns int, executeCommand func(exec Executor, arg string) (output.Output, error)) []output.Output {
 	returnChan := make(chan executeResult)
 	workerCount := parallelRuns
 	workerQueue := make(chan int, workerCount)
@@ -189,10 +217,7 @@ func execute(command Command, executor Executor, args []string, mode resolveMode
 			}
 
 			if len(outputs) >= len(resolvedArgs) {
-				executor.Debug("execute done")
-				// We're done, update ui for the last time and render the results
-				executor.StopProgressLog()
-				return outputs, nil
+				return outputs
 			}
 		}
 	}
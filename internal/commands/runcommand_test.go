@@ -297,3 +297,59 @@ func TestExecute_Error(t *testing.T) {
 		}
 	}
 }
+
+func TestExecuteInParallel(t *testing.T) {
+	mService := &smock.Service{}
+	cfg := config.New()
+	cfg.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	executor := NewExecutor(cfg, mService, cfg.NewLogger("test"))
+
+	assert.Empty(t, ExecuteInParallel(executor, nil, 2, func(_ Executor, arg string) (output.Output, error) {
+		return output.OnlyMarshaled{Value: arg}, nil
+	}))
+
+	outputs := ExecuteInParallel(executor, []string{"fi-hel1", "de-fra1", "fail"}, 2, func(_ Executor, arg string) (output.Output, error) {
+		if arg == "fail" {
+			return nil, fmt.Errorf("MOCKFAIL")
+		}
+		return output.OnlyMarshaled{Value: arg}, nil
+	})
+	assert.Len(t, outputs, 3)
+
+	values := map[string]struct{}{}
+	for _, o := range outputs {
+		switch typedO := o.(type) {
+		case output.OnlyMarshaled:
+			values[typedO.Value.(string)] = struct{}{}
+		case output.Error:
+			assert.Equal(t, "fail", typedO.Original)
+			assert.EqualError(t, typedO.Value, "MOCKFAIL")
+		}
+	}
+	assert.Equal(t, map[string]struct{}{"fi-hel1": {}, "de-fra1": {}}, values)
+}
+
+func TestExecute_CommandStopsProgressLog(t *testing.T) {
+	cmd := &mockNone{Command: &cobra.Command{}}
+	cmd.On("ExecuteWithoutArguments", mock.Anything).Run(func(args mock.Arguments) {
+		exec := args.Get(0).(Executor)
+		// Commands that launch interactive programs stop the progress log before handing over the terminal and might still run workers after that.
+		exec.StopProgressLog()
+		outputs := ExecuteInParallel(exec, []string{"fail"}, 1, func(_ Executor, _ string) (output.Output, error) {
+			return nil, fmt.Errorf("MOCKFAIL")
+		})
+		assert.Len(t, outputs, 1)
+		exec.StopProgressLog()
+	}).Return(output.OnlyMarshaled{Value: "mock"}, nil)
+
+	mService := &smock.Service{}
+	mService.On("GetAccount").Return(nil, nil)
+	cfg := config.New()
+	cfg.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+
+	assert.NotPanics(t, func() {
+		err := commandRunE(cmd, mService, cfg, []string{})
+		assert.NoError(t, err)
+	})
+	cmd.AssertNumberOfCalls(t, "ExecuteWithoutArguments", 1)
+}
@@ -0,0 +1,286 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	osexec "os/exec"
+	"os/signal"
+	"strconv"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+// runViewer is a variable to allow replacing it in tests.
+var runViewer = func(cmd *osexec.Cmd) error { return cmd.Run() }
+
+// ConsoleCommand creates the "server console" command
+func ConsoleCommand() commands.Command {
+	return &consoleCommand{
+		BaseCommand: commands.New(
+			"console",
+			"Open a VNC console to a server",
+			"upctl server console my_server",
+			"upctl server console my_server --launch-viewer --disable-after",
+			"upctl server console my_server --proxy-port 5900",
+		),
+	}
+}
+
+type consoleCommand struct {
+	*commands.BaseCommand
+	resolver.CachingServer
+	completion.Server
+	password     string
+	launchViewer config.OptionalBoolean
+	viewer       string
+	proxyPort    int
+	disableAfter config.OptionalBoolean
+}
+
+type consoleOutput struct {
+	UUID     string `json:"uuid"`
+	Type     string `json:"type"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Password string `json:"password"`
+	URI      string `json:"uri"`
+}
+
+// InitCommand implements Command.InitCommand
+func (s *consoleCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Open a VNC console to a server
+
+Enables remote access of the server, if it is not already enabled, and outputs the VNC URI for connecting to the console of the server.
+
+With ` + "`--launch-viewer`" + `, a local VNC viewer is launched and connected to the console. With ` + "`--proxy-port`" + `, the console is proxied to the given port on localhost until the command is interrupted. If both are given, the viewer is connected through the proxy.
+
+With ` + "`--disable-after`" + `, remote access is disabled when the viewer exits or the proxy is stopped.`)
+
+	flags := &pflag.FlagSet{}
+	flags.StringVar(&s.password, "password", "", "Remote access password to set. By default, the current password is kept.")
+	config.AddToggleFlag(flags, &s.launchViewer, "launch-viewer", false, "Launch a local VNC viewer connected to the console.")
+	flags.StringVar(&s.viewer, "viewer", "vncviewer", "Path to the VNC viewer binary to use with `--launch-viewer`.")
+	flags.IntVar(&s.proxyPort, "proxy-port", 0, "Proxy the console to this port on localhost.")
+	config.AddToggleFlag(flags, &s.disableAfter, "disable-after", false, "Disable remote access after the viewer exits or the proxy is stopped.")
+	commands.Must(flags.SetAnnotation("password", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(flags.SetAnnotation("proxy-port", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(flags)
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *consoleCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	if s.disableAfter.Value() && !s.launchViewer.Value() && s.proxyPort == 0 {
+		return nil, fmt.Errorf("--disable-after requires --launch-viewer or --proxy-port")
+	}
+
+	viewer := ""
+	if s.launchViewer.Value() {
+		var err error
+		if viewer, err = lookPath(s.viewer); err != nil {
+			return nil, fmt.Errorf("could not find %s viewer from PATH, install a VNC viewer or define the viewer with --viewer", s.viewer)
+		}
+	}
+
+	details, err := s.enableRemoteAccess(exec, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	out := consoleOutput{
+		UUID:     uuid,
+		Type:     details.RemoteAccessType,
+		Host:     details.RemoteAccessHost,
+		Port:     details.RemoteAccessPort,
+		Password: details.RemoteAccessPassword,
+		URI:      vncURI(details.RemoteAccessHost, details.RemoteAccessPort, details.RemoteAccessPassword),
+	}
+
+	if s.launchViewer.Value() || s.proxyPort != 0 {
+		exec.StopProgressLog()
+		err := s.runSession(exec, viewer, out)
+		if s.disableAfter.Value() {
+			err = errors.Join(err, disableRemoteAccess(exec, uuid))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output.MarshaledWithHumanDetails{Value: out, Details: []output.DetailRow{
+		{Title: "Host", Value: out.Host},
+		{Title: "Port", Value: out.Port},
+		{Title: "Password", Value: out.Password},
+		{Title: "URI", Value: out.URI},
+	}}, nil
+}
+
+// enableRemoteAccess enables VNC remote access of the server, if needed, and returns the server details with the remote access details.
+func (s *consoleCommand) enableRemoteAccess(exec commands.Executor, uuid string) (*upcloud.ServerDetails, error) {
+	svc := exec.Server()
+	details, err := svc.GetServerDetails(exec.Context(), &request.GetServerDetailsRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	if details.RemoteAccessEnabled.Bool() && details.RemoteAccessType == upcloud.RemoteAccessTypeVNC && (s.password == "" || s.password == details.RemoteAccessPassword) {
+		return details, nil
+	}
+
+	msg := fmt.Sprintf("Enabling remote access of server %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	res, err := svc.ModifyServer(exec.Context(), &request.ModifyServerRequest{
+		UUID:                 uuid,
+		RemoteAccessEnabled:  upcloud.True,
+		RemoteAccessType:     upcloud.RemoteAccessTypeVNC,
+		RemoteAccessPassword: s.password,
+	})
+	if err != nil {
+		_, _ = commands.HandleError(exec, msg, err)
+		return nil, err
+	}
+
+	exec.PushProgressSuccess(msg)
+	return res, nil
+}
+
+func disableRemoteAccess(exec commands.Executor, uuid string) error {
+	// The session might have been ended with an interrupt, which cancels the context of the executor.
+	ctx := context.WithoutCancel(exec.Context())
+	_, err := exec.Server().ModifyServer(ctx, &request.ModifyServerRequest{
+		UUID:                uuid,
+		RemoteAccessEnabled: upcloud.False,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot disable remote access of server %s: %w", uuid, err)
+	}
+	return nil
+}
+
+// runSession proxies the console and launches the viewer, and returns when the viewer exits or when the command is interrupted.
+func (s *consoleCommand) runSession(exec commands.Executor, viewer string, out consoleOutput) error {
+	ctx, stop := signal.NotifyContext(exec.Context(), os.Interrupt)
+	defer stop()
+
+	host, port := out.Host, out.Port
+	if s.proxyPort != 0 {
+		proxy, err := startConsoleProxy(ctx, net.JoinHostPort("127.0.0.1", strconv.Itoa(s.proxyPort)), net.JoinHostPort(out.Host, strconv.Itoa(out.Port)))
+		if err != nil {
+			return err
+		}
+		defer proxy.Close()
+
+		host, port = "127.0.0.1", s.proxyPort
+		fmt.Fprintf(s.Cobra().ErrOrStderr(), "Proxying console of server %s to %s, press Ctrl+C to stop.\n", out.UUID, vncURI(host, port, out.Password))
+	}
+
+	if viewer == "" {
+		<-ctx.Done()
+		return nil
+	}
+
+	fmt.Fprintf(s.Cobra().ErrOrStderr(), "Launching %s, the password of the console is %s\n", viewer, out.Password)
+	cmd := osexec.CommandContext(ctx, viewer, fmt.Sprintf("%s::%d", host, port)) //gosec:disable G204 -- viewer binary is either chosen by the user or looked up from PATH
+	cmd.Stdout = s.Cobra().OutOrStdout()
+	cmd.Stderr = s.Cobra().ErrOrStderr()
+
+	exec.Debug("launching vnc viewer", "viewer", viewer, "args", cmd.Args)
+	if err := runViewer(cmd); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("%s exited with error: %w", viewer, err)
+	}
+	return nil
+}
+
+func vncURI(host string, port int, password string) string {
+	u := url.URL{
+		Scheme: "vnc",
+		User:   url.UserPassword("", password),
+		Host:   net.JoinHostPort(host, strconv.Itoa(port)),
+	}
+	return u.String()
+}
+
+// consoleProxy forwards TCP connections from a local port to the remote access host of the server.
+type consoleProxy struct {
+	listener net.Listener
+	target   string
+}
+
+func startConsoleProxy(ctx context.Context, listenAddress, target string) (*consoleProxy, error) {
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("cannot start console proxy: %w", err)
+	}
+
+	p := &consoleProxy{listener: listener, target: target}
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go p.forward(ctx, conn)
+		}
+	}()
+	return p, nil
+}
+
+// Addr returns the address the proxy listens to.
+func (p *consoleProxy) Addr() net.Addr {
+	return p.listener.Addr()
+}
+
+// Close stops accepting new connections. Connections already established are closed when the context of the proxy is cancelled.
+func (p *consoleProxy) Close() error {
+	err := p.listener.Close()
+	if errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+func (p *consoleProxy) forward(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	var d net.Dialer
+	remote, err := d.DialContext(ctx, "tcp", p.target)
+	if err != nil {
+		return
+	}
+	defer remote.Close()
+
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+		_ = remote.Close()
+	}()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(remote, conn)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, remote)
+		done <- struct{}{}
+	}()
+	<-done
+}
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	osexec "os/exec"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsoleCommand(t *testing.T) {
+	uuid := "00b5a3d6-7a2c-4c4a-9d9c-3c4fbbe8b0f1"
+	disabled := upcloud.ServerDetails{
+		Server:              upcloud.Server{UUID: uuid, State: upcloud.ServerStateStarted},
+		RemoteAccessEnabled: upcloud.False,
+	}
+	enabled := disabled
+	enabled.RemoteAccessEnabled = upcloud.True
+	enabled.RemoteAccessType = upcloud.RemoteAccessTypeVNC
+	enabled.RemoteAccessHost = "fi-hel1.vnc.upcloud.com"
+	enabled.RemoteAccessPort = 3000
+	enabled.RemoteAccessPassword = "aabbccdd"
+
+	var ran []string
+	origLookPath, origRunViewer := lookPath, runViewer
+	defer func() { lookPath, runViewer = origLookPath, origRunViewer }()
+	lookPath = func(file string) (string, error) {
+		return "/usr/bin/" + file, nil
+	}
+	runViewer = func(cmd *osexec.Cmd) error {
+		ran = cmd.Args
+		return nil
+	}
+
+	for _, test := range []struct {
+		name         string
+		details      upcloud.ServerDetails
+		args         []string
+		modifyCalls  int
+		disableCalls int
+		viewerArgs   []string
+		error        string
+	}{
+		{
+			name:        "enable remote access",
+			details:     disabled,
+			modifyCalls: 1,
+		},
+		{
+			name:    "remote access already enabled",
+			details: enabled,
+		},
+		{
+			name:         "launch viewer and disable after",
+			details:      disabled,
+			args:         []string{"--launch-viewer", "--disable-after"},
+			modifyCalls:  1,
+			disableCalls: 1,
+			viewerArgs:   []string{"/usr/bin/vncviewer", "fi-hel1.vnc.upcloud.com::3000"},
+		},
+		{
+			name:    "disable after without session",
+			details: enabled,
+			args:    []string{"--disable-after"},
+			error:   "--disable-after requires --launch-viewer or --proxy-port",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ran = nil
+			mService := smock.Service{}
+			mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: uuid}).Return(&test.details, nil)
+			mService.On("ModifyServer", &request.ModifyServerRequest{
+				UUID:                uuid,
+				RemoteAccessEnabled: upcloud.True,
+				RemoteAccessType:    upcloud.RemoteAccessTypeVNC,
+			}).Return(&enabled, nil)
+			mService.On("ModifyServer", &request.ModifyServerRequest{
+				UUID:                uuid,
+				RemoteAccessEnabled: upcloud.False,
+			}).Return(&disabled, nil)
+
+			conf := config.New()
+			conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+			c := commands.BuildCommand(ConsoleCommand(), nil, conf)
+			c.Cobra().SetArgs(append([]string{uuid}, test.args...))
+			out, err := mockexecute.MockExecute(c, &mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Contains(t, out, `"uri": "vnc://:aabbccdd@fi-hel1.vnc.upcloud.com:3000"`)
+			assert.Equal(t, test.viewerArgs, ran)
+			mService.AssertNumberOfCalls(t, "ModifyServer", test.modifyCalls+test.disableCalls)
+		})
+	}
+}
+
+func TestConsoleCommand_RunCommand(t *testing.T) {
+	uuid := "00b5a3d6-7a2c-4c4a-9d9c-3c4fbbe8b0f1"
+	enabled := upcloud.ServerDetails{
+		Server:               upcloud.Server{UUID: uuid, Title: "web-1", Hostname: "web-1", State: upcloud.ServerStateStarted},
+		RemoteAccessEnabled:  upcloud.True,
+		RemoteAccessType:     upcloud.RemoteAccessTypeVNC,
+		RemoteAccessHost:     "fi-hel1.vnc.upcloud.com",
+		RemoteAccessPort:     3000,
+		RemoteAccessPassword: "aabbccdd",
+	}
+
+	origLookPath, origRunViewer := lookPath, runViewer
+	defer func() { lookPath, runViewer = origLookPath, origRunViewer }()
+	lookPath = func(file string) (string, error) {
+		return "/usr/bin/" + file, nil
+	}
+	runViewer = func(_ *osexec.Cmd) error {
+		return nil
+	}
+
+	mService := smock.Service{}
+	mService.On("GetServers").Return(&upcloud.Servers{Servers: []upcloud.Server{enabled.Server}}, nil)
+	mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: uuid}).Return(&enabled, nil)
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	c := commands.BuildCommand(ConsoleCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"web-1", "--launch-viewer"})
+
+	// The command stops the progress log before launching the viewer, and the log is stopped again after the command has returned.
+	var out string
+	var err error
+	assert.NotPanics(t, func() {
+		out, err = mockexecute.MockExecuteRunCommand(c, &mService, conf)
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, out, `"uri": "vnc://:aabbccdd@fi-hel1.vnc.upcloud.com:3000"`)
+}
+
+func TestConsoleProxy(t *testing.T) {
+	remote, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer remote.Close()
+	go func() {
+		conn, err := remote.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		fmt.Fprintf(conn, "echo: %s", line)
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	proxy, err := startConsoleProxy(ctx, "127.0.0.1:0", remote.Addr().String())
+	require.NoError(t, err)
+	defer proxy.Close()
+
+	conn, err := net.Dial("tcp", proxy.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	fmt.Fprint(conn, "RFB 003.008\n")
+	response, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "echo: RFB 003.008\n", response)
+}
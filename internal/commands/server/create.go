@@ -1,14 +1,21 @@
 package server
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"text/template"
+	"time"
 
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/ipaddress"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/storage"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
@@ -36,6 +43,10 @@ func CreateCommand() commands.Command {
 			"upctl server create --zone fi-hel1 --hostname myapp --ssh-keys ~/.ssh/id_*.pub --plan custom --cores 2 --memory 4096",
 			"upctl server create --zone fi-hel1 --hostname myapp --password-delivery email --os \"Debian GNU/Linux 10 (Buster)\" --server-group a4643646-8342-4324-4134-364138712378",
 			"upctl server create --zone fi-hel1 --hostname myapp --ssh-keys ~/.ssh/id_*.pub --network type=private,network=037a530b-533e-4cef-b6ad-6af8094bb2bc,ip-address=10.0.0.1",
+			"upctl server create --zone fi-hel1 --hostname myapp --ssh-keys ~/.ssh/id_*.pub --user-data @cloud-config.yaml --user-data @setup.sh --var environment=staging",
+			"upctl server create --zone fi-hel1 --hostname myapp --ssh-keys ~/.ssh/id_*.pub --os \"Debian GNU/Linux 12 (Bookworm)\" --enable-metadata",
+			"upctl server create --from-file servers.yaml --wait",
+			"upctl server create --count 4 --hostname \"worker-{{.Index}}\" --zone fi-hel1 --zone fi-hel2 --server-group 0b5a1c33-9d1b-4fcb-8f3b-1a2f0f5b0d5e --ssh-keys ~/.ssh/id_*.pub --atomic",
 		),
 	}
 }
@@ -47,19 +58,16 @@ var defaultCreateParams = &createParams{
 		Plan:             "1xCPU-2GB",
 		PasswordDelivery: request.PasswordDeliveryNone,
 	},
-	firewall:       false,
-	metadata:       false,
-	os:             "Ubuntu Server 24.04 LTS (Noble Numbat)",
-	osStorageSize:  0,
-	sshKeys:        nil,
-	username:       "",
-	createPassword: false,
+	os:            "Ubuntu Server 24.04 LTS (Noble Numbat)",
+	osStorageSize: 0,
+	sshKeys:       nil,
+	username:      "",
 }
 
 type createParams struct {
 	request.CreateServerRequest
-	firewall           bool
-	metadata           bool
+	firewall           config.OptionalBoolean
+	metadata           config.OptionalBoolean
 	os                 string
 	osStorageSize      int
 	osStorageEncrypted config.OptionalBoolean
@@ -68,10 +76,17 @@ type createParams struct {
 	storages []string
 	networks []string
 
+	// storageDevices and interfaces contain storages and networks defined in a spec file.
+	storageDevices []request.CreateServerStorageDevice
+	interfaces     []request.CreateServerInterface
+
 	sshKeys        []string
 	username       string
-	createPassword bool
-	remoteAccess   bool
+	createPassword config.OptionalBoolean
+	remoteAccess   config.OptionalBoolean
+
+	userData []string
+	vars     []string
 }
 
 func (s *createParams) processParams(exec commands.Executor) error {
@@ -165,6 +180,11 @@ func (s *createParams) handleStorage(in string, exec commands.Executor) (*reques
 		sd.Encrypted = *encrypted
 	}
 
+	return s.resolveStorage(sd, exec)
+}
+
+// resolveStorage resolves the storage UUID of the storage device and validates the device. Default title is generated for clones.
+func (s *createParams) resolveStorage(sd *request.CreateServerStorageDevice, exec commands.Executor) (*request.CreateServerStorageDevice, error) {
 	if sd.Action != request.CreateServerStorageDeviceActionCreate {
 		if sd.Storage == "" {
 			return nil, fmt.Errorf("storage UUID or Title must be provided for %s operation", sd.Action)
@@ -209,6 +229,16 @@ func (s *createParams) handleNetwork(in string) (*request.CreateServerInterface,
 	if err != nil {
 		return nil, err
 	}
+
+	return newServerInterface(serverInterface.Type, serverInterface.Network, ipFamily, ipAddress, bootable.AsUpcloudBoolean(), sourceIPFiltering.AsUpcloudBoolean())
+}
+
+// newServerInterface validates the network interface parameters and builds the interface for the create server request.
+func newServerInterface(networkType, network, ipFamily, ipAddress string, bootable, sourceIPFiltering upcloud.Boolean) (*request.CreateServerInterface, error) {
+	serverInterface := &request.CreateServerInterface{
+		Type:    networkType,
+		Network: network,
+	}
 	if serverInterface.Type == "" {
 		return nil, fmt.Errorf("network type is required")
 	}
@@ -225,8 +255,8 @@ func (s *createParams) handleNetwork(in string) (*request.CreateServerInterface,
 		ipFamily = defaultIPAddressFamily
 	}
 
-	serverInterface.Bootable = bootable.AsUpcloudBoolean()
-	serverInterface.SourceIPFiltering = sourceIPFiltering.AsUpcloudBoolean()
+	serverInterface.Bootable = bootable
+	serverInterface.SourceIPFiltering = sourceIPFiltering
 	serverInterface.IPAddresses = append(serverInterface.IPAddresses,
 		request.CreateServerIPAddress{
 			Family:  ipFamily,
@@ -248,12 +278,12 @@ func (s *createParams) handleSSHKey() error {
 
 type createCommand struct {
 	*commands.BaseCommand
-	params         createParams
-	firewall       config.OptionalBoolean
-	metadata       config.OptionalBoolean
-	remoteAccess   config.OptionalBoolean
-	createPassword config.OptionalBoolean
-	wait           config.OptionalBoolean
+	params   createParams
+	zones    []string
+	count    int
+	atomic   config.OptionalBoolean
+	fromFile string
+	wait     config.OptionalBoolean
 }
 
 // InitCommand implements Command.InitCommand
@@ -262,18 +292,25 @@ func (s *createCommand) InitCommand() {
 
 	s.Cobra().Long = commands.WrapLongDescription(`Create a new server
 
-Note that the default template, Ubuntu Server 24.04 LTS (Noble Numbat), only supports SSH key based authentication. Use ` + "`" + `--ssh-keys` + "`" + ` option to provide the keys when creating a server with the default template. The examples below use public key from the ` + "`" + `~/.ssh` + "`" + ` directory. If you want to use different authentication method, use ` + "`" + `--os` + "`" + ` parameter to specify a different template.`)
+Note that the default template, Ubuntu Server 24.04 LTS (Noble Numbat), only supports SSH key based authentication. Use ` + "`" + `--ssh-keys` + "`" + ` option to provide the keys when creating a server with the default template. The examples below use public key from the ` + "`" + `~/.ssh` + "`" + ` directory. If you want to use different authentication method, use ` + "`" + `--os` + "`" + ` parameter to specify a different template.
+
+Multiple identical servers can be created with ` + "`" + `--count` + "`" + `. The hostname and title are rendered as Go templates for each server, e.g. ` + "`" + `worker-{{.Index}}` + "`" + `, where ` + "`" + `.Index` + "`" + ` is the number of the server starting from one and ` + "`" + `.Zone` + "`" + ` is the zone of the server. If multiple zones are given, the servers are distributed across the zones in round-robin order. If a server group is given, all servers are added to the group, so that its anti-affinity policy is applied to the created servers. With ` + "`" + `--atomic` + "`" + `, the servers that were already created are deleted, if any of the servers fails to be created.
+
+Servers can also be defined in a YAML or JSON spec file with ` + "`" + `--from-file` + "`" + `. The keys of the spec match the names of the flags, with dashes replaced by underscores, and storages and networks are defined as lists of objects. A file can contain multiple YAML documents to create multiple servers in parallel. Use ` + "`" + `upctl server show --output spec` + "`" + ` to output the spec of an existing server.`)
 
 	fs := &pflag.FlagSet{}
 	s.params = createParams{CreateServerRequest: request.CreateServerRequest{}}
 	def := defaultCreateParams
+	config.AddToggleFlag(fs, &s.atomic, "atomic", false, "Delete the servers that were already created, if any of the servers fails to be created.")
 	fs.Int64Var(&s.params.AvoidHostID, "avoid-host", def.AvoidHostID, avoidHostDescription)
 	fs.StringVar(&s.params.BootOrder, "boot-order", def.BootOrder, "The boot device order, disk / cdrom / network or comma separated combination.")
 	fs.IntVar(&s.params.CoreNumber, "cores", def.CoreNumber, "Number of cores. Only allowed if `plan` option is set to \"custom\".")
-	config.AddToggleFlag(fs, &s.createPassword, "create-password", def.createPassword, "Create an admin password.")
-	config.AddEnableOrDisableFlag(fs, &s.firewall, def.firewall, "firewall", "firewall")
-	config.AddEnableOrDisableFlag(fs, &s.metadata, def.metadata, "metadata", "metadata service. The metadata service will be enabled by default, if the selected OS template uses cloud-init and thus requires metadata service")
-	config.AddEnableOrDisableFlag(fs, &s.remoteAccess, def.remoteAccess, "remote-access", "remote access")
+	fs.IntVar(&s.count, "count", 1, "Number of servers to create. Use templates in hostname and title to give each server an unique name, e.g. worker-{{.Index}}.")
+	config.AddToggleFlag(fs, &s.params.createPassword, "create-password", def.createPassword.Value(), "Create an admin password.")
+	config.AddEnableOrDisableFlag(fs, &s.params.firewall, def.firewall.Value(), "firewall", "firewall")
+	fs.StringVar(&s.fromFile, "from-file", "", "Create servers from a YAML or JSON spec file. Cannot be combined with other flags than --wait and --atomic.")
+	config.AddEnableOrDisableFlag(fs, &s.params.metadata, def.metadata.Value(), "metadata", "metadata service. The metadata service will be enabled by default, if the selected OS template uses cloud-init and thus requires metadata service")
+	config.AddEnableOrDisableFlag(fs, &s.params.remoteAccess, def.remoteAccess.Value(), "remote-access", "remote access")
 	fs.Int64Var(&s.params.HostID, "host", def.HostID, hostDescription)
 	fs.StringVar(&s.params.Hostname, "hostname", def.Hostname, "Server hostname.")
 	fs.StringArrayVar(&s.params.labels, "label", def.labels, "Labels to describe the server in `key=value` format, multiple can be declared.\nUsage: --label env=dev\n\n--label owner=operations")
@@ -292,24 +329,22 @@ Note that the default template, Ubuntu Server 24.04 LTS (Noble Numbat), only sup
 	fs.StringArrayVar(&s.params.storages, "storage", def.storages, "A storage connected to the server, multiple can be declared.\nUsage: --storage action=attach,storage=01000000-0000-4000-8000-000020010301,type=cdrom")
 	fs.StringVar(&s.params.TimeZone, "time-zone", def.TimeZone, "Time zone to set the RTC to.")
 	fs.StringVar(&s.params.Title, "title", def.Title, "A short, informational description.")
-	fs.StringVar(&s.params.UserData, "user-data", def.UserData, "Defines URL for a server setup script, or the script body itself.")
+	fs.StringArrayVar(&s.params.userData, "user-data", def.userData, "Defines URL for a server setup script, the script body itself, or a file to read the script from when prefixed with `@`. Multiple values are combined into a multi-part cloud-init user data.\nUsage: --user-data @cloud-config.yaml\n\n--user-data @setup.sh")
 	fs.StringVar(&s.params.username, "username", def.username, "Admin account username.")
+	fs.StringArrayVar(&s.params.vars, "var", def.vars, "Variable to render the user data with in `key=value` format, multiple can be declared. When variables are defined, user data is rendered as a Go template, e.g. `{{.key}}`.\nUsage: --var environment=staging")
 	fs.StringVar(&s.params.VideoModel, "video-model", def.VideoModel, "Video interface model of the server. Available: "+strings.Join(videoModels, ", "))
 	config.AddToggleFlag(fs, &s.wait, "wait", false, "Wait for server to be in started state before returning.")
-	fs.StringVar(&s.params.Zone, "zone", def.Zone, namedargs.ZoneDescription("server"))
+	fs.StringArrayVar(&s.zones, "zone", nil, namedargs.ZoneDescription("server")+" Multiple zones can be declared with --count to distribute the servers across the zones.")
 	// fs.BoolVar(&s.params.firewall, "firewall", def.firewall, "Enables the firewall. You can manage firewall rules with the firewall command.")
-	// fs.BoolVar(&s.params.metadata, "metadata", def.metadata, "Enable metadata service.")
 	// fs.BoolVar(&s.params.remoteAccess, "remote-access-enabled", def.remoteAccess, "Enables or disables the remote access.")
 	s.AddFlags(fs)
 
-	commands.Must(s.Cobra().MarkFlagRequired("hostname"))
-	commands.Must(s.Cobra().MarkFlagRequired("zone"))
 	commands.Must(s.Cobra().RegisterFlagCompletionFunc("password-delivery", cobra.FixedCompletions(passwordDeliveries, cobra.ShellCompDirectiveNoFileComp)))
 	commands.Must(s.Cobra().RegisterFlagCompletionFunc("remote-access-type", cobra.FixedCompletions(remoteAccessTypes, cobra.ShellCompDirectiveNoFileComp)))
 	commands.Must(s.Cobra().RegisterFlagCompletionFunc("video-model", cobra.FixedCompletions(videoModels, cobra.ShellCompDirectiveNoFileComp)))
 	for _, flag := range []string{
-		"boot-order", flagCores, flagHostname, "label", flagMemory, "network", "os", "os-storage-size", "remote-access-password",
-		"simple-backup", flagStorage, flagTitle, "user-data", "username",
+		"boot-order", "count", flagCores, flagHostname, "label", flagMemory, "network", "os", "os-storage-size", "remote-access-password",
+		"simple-backup", flagStorage, flagTitle, "user-data", "username", "var",
 	} {
 		commands.Must(s.Cobra().RegisterFlagCompletionFunc(flag, cobra.NoFileCompletions))
 	}
@@ -326,43 +361,293 @@ func (s *createCommand) InitCommandWithConfig(cfg *config.Config) {
 
 // ExecuteWithoutArguments implements commands.NoArgumentCommand
 func (s *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
-	if s.params.os == defaultCreateParams.os && s.params.PasswordDelivery == "none" && s.params.sshKeys == nil {
+	if s.fromFile != "" {
+		return s.createFromFile(exec)
+	}
+
+	// Hostname and zone are required only when the server is not defined in a spec file.
+	missing := []string{}
+	for _, flag := range []string{flagHostname, "zone"} {
+		if !s.Cobra().Flags().Changed(flag) {
+			missing = append(missing, fmt.Sprintf(`"%s"`, flag))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("required flag(s) %s not set", strings.Join(missing, ", "))
+	}
+
+	if s.count < 1 {
+		return nil, fmt.Errorf("--count must be at least 1")
+	}
+	if len(s.zones) > 1 && s.count == 1 {
+		return nil, fmt.Errorf("multiple zones can only be used with --count")
+	}
+
+	params, err := s.params.expand(s.count, s.zones)
+	if err != nil {
+		return nil, err
+	}
+	if len(params) == 1 {
+		return params[0].create(exec, s.wait.Value())
+	}
+
+	if s.params.ServerGroup != "" {
+		// Validate the server group once, instead of failing the creation of each server.
+		if _, err := exec.All().GetServerGroup(exec.Context(), &request.GetServerGroupRequest{UUID: s.params.ServerGroup}); err != nil {
+			return nil, fmt.Errorf("cannot get server group %s: %w", s.params.ServerGroup, err)
+		}
+	}
+
+	return createServers(exec, params, s.wait.Value(), s.atomic.Value())
+}
+
+type nameTemplateData struct {
+	Index int
+	Zone  string
+}
+
+// expand returns params for count servers with hostname and title rendered from templates. Zones are assigned in round-robin order.
+func (s *createParams) expand(count int, zones []string) ([]createParams, error) {
+	hostname, err := template.New("hostname").Parse(s.Hostname)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse hostname template: %w", err)
+	}
+	title, err := template.New("title").Parse(s.Title)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse title template: %w", err)
+	}
+
+	params := make([]createParams, 0, count)
+	hostnames := make(map[string]bool, count)
+	for i := range count {
+		p := *s
+		p.Zone = zones[i%len(zones)]
+		data := nameTemplateData{Index: i + 1, Zone: p.Zone}
+
+		if p.Hostname, err = renderNameTemplate(hostname, data); err != nil {
+			return nil, fmt.Errorf("cannot render hostname template: %w", err)
+		}
+		if p.Title, err = renderNameTemplate(title, data); err != nil {
+			return nil, fmt.Errorf("cannot render title template: %w", err)
+		}
+
+		if hostnames[p.Hostname] {
+			return nil, fmt.Errorf(`hostname "%s" is used by multiple servers, use a template to give each server an unique hostname, e.g. worker-{{.Index}}`, p.Hostname)
+		}
+		hostnames[p.Hostname] = true
+		params = append(params, p)
+	}
+	return params, nil
+}
+
+func renderNameTemplate(tmpl *template.Template, data nameTemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+type createResult struct {
+	Hostname   string                 `json:"hostname"`
+	Server     *upcloud.ServerDetails `json:"server,omitempty"`
+	RolledBack bool                   `json:"rolled_back,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// createFromFile creates the servers defined in the spec file in parallel.
+func (s *createCommand) createFromFile(exec commands.Executor) (output.Output, error) {
+	conflicting := []string{}
+	s.Cobra().LocalFlags().VisitAll(func(flag *pflag.Flag) {
+		if flag.Changed && flag.Name != "from-file" && flag.Name != "wait" && flag.Name != "atomic" {
+			conflicting = append(conflicting, "--"+flag.Name)
+		}
+	})
+	if len(conflicting) > 0 {
+		return nil, fmt.Errorf("--from-file cannot be used with %s", strings.Join(conflicting, ", "))
+	}
+
+	specs, err := readServerSpecs(s.fromFile)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]createParams, 0, len(specs))
+	for i, spec := range specs {
+		p, err := spec.createParams()
+		if err != nil {
+			return nil, fmt.Errorf("invalid server spec %d: %w", i+1, err)
+		}
+		params = append(params, p)
+	}
+
+	return createServers(exec, params, s.wait.Value(), s.atomic.Value())
+}
+
+// createServers creates the servers in parallel. If atomic is true and any of the servers fails to be created, the servers that were created are deleted.
+func createServers(exec commands.Executor, params []createParams, wait, atomic bool) (output.Output, error) {
+	args := make([]string, 0, len(params))
+	for i := range params {
+		args = append(args, strconv.Itoa(i))
+	}
+
+	results := make([]createResult, len(params))
+	for i, p := range params {
+		results[i].Hostname = p.Hostname
+	}
+
+	// Each execution writes only to its own result, so the results can be written without locking.
+	outputs := commands.ExecuteInParallel(exec, args, maxServerActions, func(exec commands.Executor, arg string) (output.Output, error) {
+		i, _ := strconv.Atoi(arg)
+		out, err := params[i].create(exec, wait)
+		if details, ok := out.(output.MarshaledWithHumanDetails); ok {
+			results[i].Server, _ = details.Value.(*upcloud.ServerDetails)
+		}
+		return out, err
+	})
+
+	failed := 0
+	for _, out := range outputs {
+		if typed, ok := out.(output.Error); ok {
+			failed++
+			i, _ := strconv.Atoi(typed.Original)
+			results[i].Error = typed.Value.Error()
+		}
+	}
+
+	if atomic && failed > 0 {
+		rollbackServers(exec, results)
+	}
+
+	columns := []output.TableColumn{
+		{Key: "hostname", Header: "Hostname"},
+		{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+		{Key: "state", Header: "State", Format: format.ServerState},
+	}
+	if atomic {
+		columns = append(columns, output.TableColumn{Key: "rolled_back", Header: "Rolled back", Format: format.Boolean})
+	}
+	columns = append(columns, output.TableColumn{Key: "error", Header: "Error"})
+
+	rows := []output.TableRow{}
+	for _, result := range results {
+		row := output.TableRow{result.Hostname, "", ""}
+		if result.Server != nil {
+			row = output.TableRow{result.Hostname, result.Server.UUID, result.Server.State}
+		}
+		if atomic {
+			row = append(row, result.RolledBack)
+		}
+		rows = append(rows, append(row, result.Error))
+	}
+
+	var out output.Output = output.MarshaledWithHumanOutput{
+		Value: results,
+		Output: output.Table{
+			Columns: columns,
+			Rows:    rows,
+		},
+	}
+	if failed > 0 {
+		out = output.WithError{Output: out, Err: &clierrors.CommandFailedError{FailedCount: failed}}
+	}
+	return out, nil
+}
+
+// rollbackServers deletes the created servers and their storages. The servers are waited to be started before deleting, as servers cannot be stopped while they are being created.
+func rollbackServers(exec commands.Executor, results []createResult) {
+	args := []string{}
+	for i, result := range results {
+		if result.Server != nil {
+			args = append(args, strconv.Itoa(i))
+		}
+	}
+
+	// As in createServers, each execution writes only to its own result.
+	commands.ExecuteInParallel(exec, args, maxServerActions, func(exec commands.Executor, arg string) (output.Output, error) {
+		i, _ := strconv.Atoi(arg)
+		uuid := results[i].Server.UUID
+
+		msg := fmt.Sprintf("Rolling back server %v", uuid)
+		exec.PushProgressStarted(msg)
+
+		err := waitForServerStarted(exec, uuid, msg)
+		if err == nil {
+			_, err = Delete(exec, uuid, upcloud.ServerStateStarted, true, true)
+		}
+		if err != nil {
+			err = fmt.Errorf("rollback failed, server %s (%s) was not deleted and needs to be deleted manually: %w", results[i].Hostname, uuid, err)
+			results[i].Error = err.Error()
+			return commands.HandleError(exec, msg, err)
+		}
+
+		results[i].RolledBack = true
+		exec.PushProgressSuccess(msg)
+		return output.None{}, nil
+	})
+}
+
+// waitForServerStarted waits for the server to be started. Unlike waitForServerState, the error is returned to the caller instead of logging it as a warning.
+func waitForServerStarted(exec commands.Executor, uuid, msg string) error {
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("%s: waiting for server to be in %s state", msg, upcloud.ServerStateStarted))
+
+	ctx, cancel := context.WithTimeout(exec.Context(), 15*time.Minute)
+	defer cancel()
+
+	_, err := exec.All().WaitForServerState(ctx, &request.WaitForServerStateRequest{
+		UUID:         uuid,
+		DesiredState: upcloud.ServerStateStarted,
+	})
+	return err
+}
+
+// create validates the params and creates a server. Progress is logged with the hostname of the server.
+func (s *createParams) create(exec commands.Executor, wait bool) (output.Output, error) {
+	if s.os == defaultCreateParams.os && s.PasswordDelivery == "none" && s.sshKeys == nil {
 		return nil, fmt.Errorf("a password-delivery method, ssh-keys or a custom image must be specified")
 	}
 
-	if !s.createPassword.Value() && s.params.PasswordDelivery != "none" {
+	if !s.createPassword.Value() && s.PasswordDelivery != "none" {
 		_ = s.createPassword.Set("true")
 	}
 
-	if s.params.Title == "" {
-		s.params.Title = s.params.Hostname
+	if s.Title == "" {
+		s.Title = s.Hostname
 	}
 
-	if s.params.CoreNumber != 0 || s.params.MemoryAmount != 0 || s.params.Plan == customPlan {
-		if s.params.CoreNumber == 0 || s.params.MemoryAmount == 0 {
+	if s.CoreNumber != 0 || s.MemoryAmount != 0 || s.Plan == customPlan {
+		if s.CoreNumber == 0 || s.MemoryAmount == 0 {
 			return nil, fmt.Errorf("both --cores and --memory must be defined for custom plans")
 		}
 
-		if s.params.Plan != customPlan {
+		if s.Plan != customPlan {
 			return nil, fmt.Errorf("--plan needs to be 'custom' when --cores and --memory are specified")
 		}
 	}
 
+	userData, err := buildUserData(s.userData, s.vars)
+	if err != nil {
+		return nil, err
+	}
+	s.UserData = userData
+
 	svc := exec.All()
-	msg := fmt.Sprintf("Creating server %v", s.params.Hostname)
+	msg := fmt.Sprintf("Creating server %v", s.Hostname)
 	exec.PushProgressStarted(msg)
 
-	if err := s.params.processParams(exec); err != nil {
+	if err := s.processParams(exec); err != nil {
 		return nil, err
 	}
 
-	req := s.params.CreateServerRequest
+	req := s.CreateServerRequest
 	// TODO: refactor when go-api parameter is refactored
 	if s.firewall.Value() {
 		req.Firewall = "on"
 	}
 	if req.Metadata.Empty() {
 		req.Metadata = s.metadata.AsUpcloudBoolean()
+	} else if s.metadata.IsSet() && !s.metadata.Value() {
+		return nil, fmt.Errorf("metadata service cannot be disabled, the selected OS template uses cloud-init and thus requires metadata service")
 	}
 	req.RemoteAccessEnabled = s.remoteAccess.AsUpcloudBoolean()
 	if s.createPassword.Value() {
@@ -370,9 +655,9 @@ func (s *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.
 	}
 
 	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("%s: creating network interfaces", msg))
-	var iFaces []request.CreateServerInterface
-	for _, network := range s.params.networks {
-		_interface, err := s.params.handleNetwork(network)
+	iFaces := s.interfaces
+	for _, network := range s.networks {
+		_interface, err := s.handleNetwork(network)
 		if err != nil {
 			return nil, err
 		}
@@ -380,15 +665,22 @@ func (s *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.
 	}
 
 	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("%s: creating storage devices", msg))
-	for _, strg := range s.params.storages {
-		strg, err := s.params.handleStorage(strg, exec)
+	for _, strg := range s.storages {
+		strg, err := s.handleStorage(strg, exec)
+		if err != nil {
+			return nil, err
+		}
+		req.StorageDevices = append(req.StorageDevices, *strg)
+	}
+	for _, sd := range s.storageDevices {
+		strg, err := s.resolveStorage(&sd, exec)
 		if err != nil {
 			return nil, err
 		}
 		req.StorageDevices = append(req.StorageDevices, *strg)
 	}
 
-	if err := s.params.handleSSHKey(); err != nil {
+	if err := s.handleSSHKey(); err != nil {
 		return nil, err
 	}
 
@@ -402,7 +694,7 @@ func (s *createCommand) ExecuteWithoutArguments(exec commands.Executor) (output.
 		return commands.HandleError(exec, msg, err)
 	}
 
-	if s.wait.Value() {
+	if wait {
 		waitForServerState(res.UUID, upcloud.ServerStateStarted, exec, msg)
 	} else {
 		exec.PushProgressSuccess(msg)
@@ -1,9 +1,13 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands/storage"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
@@ -14,6 +18,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -441,6 +446,95 @@ func TestCreateServer(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "metadata enabled for non-cloud-init template",
+			args: []string{
+				"--hostname", "example.com",
+				"--title", "test-server",
+				"--zone", "uk-lon1",
+				"--os", StorageUbuntu2004.Title,
+				"--ssh-keys", sshKey,
+				"--enable-metadata",
+			},
+			createServerReq: request.CreateServerRequest{
+				VideoModel:       "vga",
+				TimeZone:         "UTC",
+				Plan:             "1xCPU-2GB",
+				Hostname:         "example.com",
+				Title:            "test-server",
+				Zone:             "uk-lon1",
+				Metadata:         upcloud.True,
+				PasswordDelivery: "none",
+				LoginUser: &request.LoginUser{
+					CreatePassword: "no",
+					SSHKeys:        []string{sshKey},
+				},
+				StorageDevices: request.CreateServerStorageDeviceSlice{request.CreateServerStorageDevice{
+					Action:  "clone",
+					Address: "virtio",
+					Storage: StorageUbuntu2004.UUID,
+					Title:   "example.com-OS",
+					Size:    50,
+					Type:    upcloud.StorageTypeDisk,
+				}},
+			},
+		},
+		{
+			name: "metadata disabled for cloud-init template",
+			args: []string{
+				"--hostname", "example.com",
+				"--title", "test-server",
+				"--zone", "uk-lon1",
+				"--ssh-keys", sshKey,
+				"--disable-metadata",
+			},
+			error: "metadata service cannot be disabled, the selected OS template uses cloud-init and thus requires metadata service",
+		},
+		{
+			name: "user data rendered with variables",
+			args: []string{
+				"--hostname", "example.com",
+				"--title", "test-server",
+				"--zone", "uk-lon1",
+				"--ssh-keys", sshKey,
+				"--user-data", "#cloud-config\nfqdn: {{.hostname}}.example.com\n",
+				"--var", "hostname=web",
+			},
+			createServerReq: request.CreateServerRequest{
+				VideoModel:       "vga",
+				TimeZone:         "UTC",
+				Plan:             "1xCPU-2GB",
+				Hostname:         "example.com",
+				Title:            "test-server",
+				Zone:             "uk-lon1",
+				Metadata:         upcloud.True,
+				PasswordDelivery: "none",
+				UserData:         "#cloud-config\nfqdn: web.example.com\n",
+				LoginUser: &request.LoginUser{
+					CreatePassword: "no",
+					SSHKeys:        []string{sshKey},
+				},
+				StorageDevices: request.CreateServerStorageDeviceSlice{request.CreateServerStorageDevice{
+					Action:  "clone",
+					Address: "virtio",
+					Storage: StorageUbuntu2404.UUID,
+					Title:   "example.com-OS",
+					Size:    50,
+					Type:    upcloud.StorageTypeDisk,
+				}},
+			},
+		},
+		{
+			name: "invalid cloud-config user data",
+			args: []string{
+				"--hostname", "example.com",
+				"--title", "test-server",
+				"--zone", "uk-lon1",
+				"--ssh-keys", sshKey,
+				"--user-data", "#cloud-config\npackages: [nginx\n",
+			},
+			error: "user data part-1 is not valid cloud-config: yaml: line 1: did not find expected ',' or ']'",
+		},
 		{
 			name: "networks type missing",
 			args: []string{
@@ -523,3 +617,397 @@ func TestCreateServer(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateServer_FromFile(t *testing.T) {
+	sshKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHicO0RGJyOSGeMtrmXK1upkkrL5yOrRdjNFl0FLwV00 Example public key"
+	template := upcloud.Storage{
+		UUID:         "01000000-0000-4000-8000-000030240200",
+		Title:        "Ubuntu Server 24.04 LTS (Noble Numbat)",
+		Access:       "public",
+		State:        "online",
+		Type:         "template",
+		Size:         4,
+		TemplateType: "cloud-init",
+	}
+	disk := upcloud.Storage{
+		UUID:   UUID3,
+		Title:  "db-disk",
+		Access: "private",
+		State:  "online",
+		Type:   "normal",
+		Zone:   "fi-hel1",
+		Size:   50,
+	}
+
+	spec := fmt.Sprintf(`hostname: web1.example.com
+zone: fi-hel1
+ssh_keys:
+  - %s
+labels:
+  role: web
+networks:
+  - type: public
+  - type: private
+    network: %s
+    ip_address: 10.0.0.1
+---
+hostname: db1.example.com
+zone: fi-hel1
+os: ""
+plan: 2xCPU-4GB
+password_delivery: email
+firewall: true
+storages:
+  - action: clone
+    storage: db-disk
+    title: db1-disk
+    size: 100
+---
+hostname: broken.example.com
+zone: fi-hel1
+os: missing-template
+password_delivery: email
+`, sshKey, PrivateNetworkUUID)
+	path := filepath.Join(t.TempDir(), "servers.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(spec), 0o600))
+
+	webReq := request.CreateServerRequest{
+		VideoModel:       "vga",
+		TimeZone:         "UTC",
+		Plan:             "1xCPU-2GB",
+		Hostname:         "web1.example.com",
+		Title:            "web1.example.com",
+		Zone:             "fi-hel1",
+		Metadata:         upcloud.True,
+		PasswordDelivery: "none",
+		LoginUser: &request.LoginUser{
+			CreatePassword: "no",
+			SSHKeys:        []string{sshKey},
+		},
+		StorageDevices: request.CreateServerStorageDeviceSlice{request.CreateServerStorageDevice{
+			Action:  "clone",
+			Address: "virtio",
+			Storage: template.UUID,
+			Title:   "web1.example.com-OS",
+			Size:    50,
+			Type:    upcloud.StorageTypeDisk,
+		}},
+		Labels: &upcloud.LabelSlice{upcloud.Label{Key: "role", Value: "web"}},
+		Networking: &request.CreateServerNetworking{Interfaces: request.CreateServerInterfaceSlice{
+			request.CreateServerInterface{
+				IPAddresses: request.CreateServerIPAddressSlice{request.CreateServerIPAddress{Family: upcloud.IPAddressFamilyIPv4}},
+				Type:        upcloud.NetworkTypePublic,
+			},
+			request.CreateServerInterface{
+				IPAddresses: request.CreateServerIPAddressSlice{request.CreateServerIPAddress{Family: upcloud.IPAddressFamilyIPv4, Address: MockPrivateIPv4}},
+				Type:        upcloud.NetworkTypePrivate,
+				Network:     PrivateNetworkUUID,
+			},
+		}},
+	}
+	dbReq := request.CreateServerRequest{
+		VideoModel:       "vga",
+		TimeZone:         "UTC",
+		Plan:             "2xCPU-4GB",
+		Hostname:         "db1.example.com",
+		Title:            "db1.example.com",
+		Zone:             "fi-hel1",
+		Firewall:         "on",
+		PasswordDelivery: "email",
+		LoginUser:        &request.LoginUser{CreatePassword: "yes"},
+		StorageDevices: request.CreateServerStorageDeviceSlice{request.CreateServerStorageDevice{
+			Action:  "clone",
+			Storage: disk.UUID,
+			Title:   "db1-disk",
+			Size:    100,
+		}},
+	}
+
+	mService := new(smock.Service)
+	mService.On("GetPlans", mock.Anything).Return(&upcloud.Plans{Plans: []upcloud.Plan{{Name: "1xCPU-2GB", StorageSize: 50}}}, nil)
+	mService.On("GetStorages", mock.Anything).Return(&upcloud.Storages{Storages: []upcloud.Storage{template, disk}}, nil)
+	mService.On("CreateServer", &webReq).Return(&upcloud.ServerDetails{Server: upcloud.Server{UUID: UUID1, Hostname: webReq.Hostname, State: upcloud.ServerStateMaintenance}}, nil)
+	mService.On("CreateServer", &dbReq).Return(&upcloud.ServerDetails{Server: upcloud.Server{UUID: UUID3, Hostname: dbReq.Hostname, State: upcloud.ServerStateMaintenance}}, nil)
+
+	storage.CachedStorages = nil
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	c := commands.BuildCommand(CreateCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"--from-file", path})
+	output, err := mockexecute.MockExecute(c, mService, conf)
+
+	var failedErr *clierrors.CommandFailedError
+	require.ErrorAs(t, err, &failedErr)
+	assert.Equal(t, 1, failedErr.FailedCount)
+	mService.AssertNumberOfCalls(t, "GetStorages", 1)
+	mService.AssertNumberOfCalls(t, "CreateServer", 2)
+
+	var results []struct {
+		Hostname string `json:"hostname"`
+		Server   *struct {
+			UUID string `json:"uuid"`
+		} `json:"server"`
+		Error string `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &results))
+	require.Len(t, results, 3)
+	assert.Equal(t, "web1.example.com", results[0].Hostname)
+	assert.Equal(t, UUID1, results[0].Server.UUID)
+	assert.Equal(t, "db1.example.com", results[1].Hostname)
+	assert.Equal(t, UUID3, results[1].Server.UUID)
+	assert.Equal(t, "broken.example.com", results[2].Hostname)
+	assert.Nil(t, results[2].Server)
+	assert.Equal(t, `no storage with uuid, name or title "missing-template" was found`, results[2].Error)
+}
+
+func TestCreateServer_FromFileErrors(t *testing.T) {
+	dir := t.TempDir()
+	unknownField := filepath.Join(dir, "unknown-field.yaml")
+	require.NoError(t, os.WriteFile(unknownField, []byte("hostname: example.com\nzone: fi-hel1\ncpus: 2\n"), 0o600))
+	missingZone := filepath.Join(dir, "missing-zone.yaml")
+	require.NoError(t, os.WriteFile(missingZone, []byte("hostname: example.com\n---\nhostname: example.net\n"), 0o600))
+	empty := filepath.Join(dir, "empty.yaml")
+	require.NoError(t, os.WriteFile(empty, []byte("---\n"), 0o600))
+
+	for _, test := range []struct {
+		name  string
+		args  []string
+		error string
+	}{
+		{
+			name:  "from-file with other flags",
+			args:  []string{"--from-file", unknownField, "--zone", "fi-hel1", "--plan", "2xCPU-4GB"},
+			error: "--from-file cannot be used with --plan, --zone",
+		},
+		{
+			name:  "unknown field",
+			args:  []string{"--from-file", unknownField},
+			error: `failed to parse server spec 1: json: unknown field "cpus"`,
+		},
+		{
+			name:  "missing zone",
+			args:  []string{"--from-file", missingZone},
+			error: "invalid server spec 1: hostname and zone must be defined",
+		},
+		{
+			name:  "empty file",
+			args:  []string{"--from-file", empty},
+			error: fmt.Sprintf("no servers defined in %s", empty),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := new(smock.Service)
+			conf := config.New()
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			assert.EqualError(t, err, test.error)
+			mService.AssertNotCalled(t, "CreateServer", mock.Anything)
+		})
+	}
+}
+
+func TestCreateServer_Count(t *testing.T) {
+	sshKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHicO0RGJyOSGeMtrmXK1upkkrL5yOrRdjNFl0FLwV00 Example public key"
+	serverGroupUUID := "0b5a1c33-9d1b-4fcb-8f3b-1a2f0f5b0d5e"
+	template := upcloud.Storage{
+		UUID:         "01000000-0000-4000-8000-000030240200",
+		Title:        "Ubuntu Server 24.04 LTS (Noble Numbat)",
+		Access:       "public",
+		State:        "online",
+		Type:         "template",
+		Size:         4,
+		TemplateType: "cloud-init",
+	}
+
+	mService := new(smock.Service)
+	mService.On("GetPlans", mock.Anything).Return(&upcloud.Plans{Plans: []upcloud.Plan{{Name: "1xCPU-2GB", StorageSize: 50}}}, nil)
+	mService.On("GetStorages", mock.Anything).Return(&upcloud.Storages{Storages: []upcloud.Storage{template}}, nil)
+	mService.On("GetServerGroup", &request.GetServerGroupRequest{UUID: serverGroupUUID}).Return(&upcloud.ServerGroup{
+		UUID:               serverGroupUUID,
+		AntiAffinityPolicy: upcloud.ServerGroupAntiAffinityPolicyStrict,
+	}, nil)
+
+	zones := []string{"fi-hel1", "de-fra1", "fi-hel1"}
+	for i, uuid := range []string{UUID1, UUID3, "0045ef93-5f3d-4b5c-94c4-cd1a4a4e5a2c"} {
+		hostname := fmt.Sprintf("worker-%d", i+1)
+		req := request.CreateServerRequest{
+			VideoModel:       "vga",
+			TimeZone:         "UTC",
+			Plan:             "1xCPU-2GB",
+			Hostname:         hostname,
+			Title:            fmt.Sprintf("Worker %d in %s", i+1, zones[i]),
+			Zone:             zones[i],
+			ServerGroup:      serverGroupUUID,
+			Metadata:         upcloud.True,
+			PasswordDelivery: "none",
+			LoginUser: &request.LoginUser{
+				CreatePassword: "no",
+				SSHKeys:        []string{sshKey},
+			},
+			StorageDevices: request.CreateServerStorageDeviceSlice{request.CreateServerStorageDevice{
+				Action:  "clone",
+				Address: "virtio",
+				Storage: template.UUID,
+				Title:   hostname + "-OS",
+				Size:    50,
+				Type:    upcloud.StorageTypeDisk,
+			}},
+		}
+		mService.On("CreateServer", &req).Return(&upcloud.ServerDetails{Server: upcloud.Server{UUID: uuid, Hostname: hostname, Zone: zones[i], State: upcloud.ServerStateMaintenance}}, nil)
+	}
+
+	storage.CachedStorages = nil
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	c := commands.BuildCommand(CreateCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{
+		"--count", "3",
+		"--hostname", "worker-{{.Index}}",
+		"--title", "Worker {{.Index}} in {{.Zone}}",
+		"--zone", "fi-hel1",
+		"--zone", "de-fra1",
+		"--server-group", serverGroupUUID,
+		"--ssh-keys", sshKey,
+	})
+	output, err := mockexecute.MockExecute(c, mService, conf)
+	require.NoError(t, err)
+	mService.AssertNumberOfCalls(t, "GetServerGroup", 1)
+	mService.AssertNumberOfCalls(t, "CreateServer", 3)
+
+	var results []struct {
+		Hostname string `json:"hostname"`
+		Server   *struct {
+			Zone string `json:"zone"`
+		} `json:"server"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &results))
+	require.Len(t, results, 3)
+	for i, result := range results {
+		assert.Equal(t, fmt.Sprintf("worker-%d", i+1), result.Hostname)
+		assert.Equal(t, zones[i], result.Server.Zone)
+	}
+}
+
+func TestCreateServer_CountAtomic(t *testing.T) {
+	mService := new(smock.Service)
+	mService.On("CreateServer", mock.MatchedBy(func(req *request.CreateServerRequest) bool {
+		return req.Hostname == "worker-1"
+	})).Return(&upcloud.ServerDetails{Server: upcloud.Server{UUID: UUID1, Hostname: "worker-1", State: upcloud.ServerStateMaintenance}}, nil)
+	mService.On("CreateServer", mock.MatchedBy(func(req *request.CreateServerRequest) bool {
+		return req.Hostname == "worker-2"
+	})).Return(nil, fmt.Errorf("insufficient resources"))
+	mService.On("WaitForServerState", &request.WaitForServerStateRequest{UUID: UUID1, DesiredState: upcloud.ServerStateStarted}).Return(&upcloud.ServerDetails{}, nil)
+	mService.On("StopServer", &request.StopServerRequest{UUID: UUID1, StopType: "hard"}).Return(&upcloud.ServerDetails{}, nil)
+	mService.On("WaitForServerState", &request.WaitForServerStateRequest{UUID: UUID1, DesiredState: upcloud.ServerStateStopped}).Return(&upcloud.ServerDetails{}, nil)
+	mService.On("DeleteServerAndStorages", &request.DeleteServerAndStoragesRequest{UUID: UUID1}).Return(nil)
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	c := commands.BuildCommand(CreateCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{
+		"--count", "2",
+		"--hostname", "worker-{{.Index}}",
+		"--zone", "fi-hel1",
+		"--os", "",
+		"--password-delivery", "email",
+		"--atomic",
+	})
+	output, err := mockexecute.MockExecute(c, mService, conf)
+
+	var failedErr *clierrors.CommandFailedError
+	require.ErrorAs(t, err, &failedErr)
+	assert.Equal(t, 1, failedErr.FailedCount)
+	mService.AssertCalled(t, "DeleteServerAndStorages", &request.DeleteServerAndStoragesRequest{UUID: UUID1})
+
+	var results []struct {
+		Hostname   string `json:"hostname"`
+		RolledBack bool   `json:"rolled_back"`
+		Error      string `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &results))
+	require.Len(t, results, 2)
+	assert.True(t, results[0].RolledBack)
+	assert.Empty(t, results[0].Error)
+	assert.False(t, results[1].RolledBack)
+	assert.Equal(t, "insufficient resources", results[1].Error)
+}
+
+func TestCreateServer_CountErrors(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		args  []string
+		error string
+	}{
+		{
+			name:  "count less than one",
+			args:  []string{"--count", "0"},
+			error: "--count must be at least 1",
+		},
+		{
+			name:  "multiple zones without count",
+			args:  []string{"--zone", "de-fra1"},
+			error: "multiple zones can only be used with --count",
+		},
+		{
+			name:  "hostname without template",
+			args:  []string{"--count", "2"},
+			error: `hostname "example.com" is used by multiple servers, use a template to give each server an unique hostname, e.g. worker-{{.Index}}`,
+		},
+		{
+			name:  "invalid template",
+			args:  []string{"--count", "2", "--title", "worker-{{.Name}}"},
+			error: `cannot render title template: template: title:1:9: executing "title" at <.Name>: can't evaluate field Name in type server.nameTemplateData`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := new(smock.Service)
+			conf := config.New()
+			c := commands.BuildCommand(CreateCommand(), nil, conf)
+			c.Cobra().SetArgs(append([]string{"--hostname", "example.com", "--zone", "fi-hel1", "--os", "", "--password-delivery", "email"}, test.args...))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			assert.EqualError(t, err, test.error)
+			mService.AssertNotCalled(t, "CreateServer", mock.Anything)
+		})
+	}
+}
+
+func TestCreateServer_CountAtomicRollbackFails(t *testing.T) {
+	mService := new(smock.Service)
+	mService.On("CreateServer", mock.MatchedBy(func(req *request.CreateServerRequest) bool {
+		return req.Hostname == "worker-1"
+	})).Return(&upcloud.ServerDetails{Server: upcloud.Server{UUID: UUID1, Hostname: "worker-1", State: upcloud.ServerStateMaintenance}}, nil)
+	mService.On("CreateServer", mock.MatchedBy(func(req *request.CreateServerRequest) bool {
+		return req.Hostname == "worker-2"
+	})).Return(nil, fmt.Errorf("insufficient resources"))
+	mService.On("WaitForServerState", &request.WaitForServerStateRequest{UUID: UUID1, DesiredState: upcloud.ServerStateStarted}).Return(nil, fmt.Errorf("server entered error state"))
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+	c := commands.BuildCommand(CreateCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{
+		"--count", "2",
+		"--hostname", "worker-{{.Index}}",
+		"--zone", "fi-hel1",
+		"--os", "",
+		"--password-delivery", "email",
+		"--atomic",
+	})
+	output, err := mockexecute.MockExecute(c, mService, conf)
+
+	var failedErr *clierrors.CommandFailedError
+	require.ErrorAs(t, err, &failedErr)
+	mService.AssertNotCalled(t, "StopServer", mock.Anything)
+	mService.AssertNotCalled(t, "DeleteServerAndStorages", mock.Anything)
+
+	var results []struct {
+		RolledBack bool   `json:"rolled_back"`
+		Error      string `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output), &results))
+	require.Len(t, results, 2)
+	assert.False(t, results[0].RolledBack)
+	assert.Equal(t, fmt.Sprintf("rollback failed, server worker-1 (%s) was not deleted and needs to be deleted manually: server entered error state", UUID1), results[0].Error)
+}
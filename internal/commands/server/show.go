@@ -7,6 +7,7 @@ import (
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/labels"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
@@ -28,6 +29,7 @@ func ShowCommand() commands.Command {
 			"upctl server show 21aeb3b7-cd89-4123-a376-559b0e75be8b 0053a6f5-e6d1-4b0b-b9dc-b90d0894e8d0",
 			"upctl server show myhostname",
 			"upctl server show my_server1 my_server2",
+			"upctl server show my_server --output spec > server.yaml",
 		),
 	}
 }
@@ -36,11 +38,23 @@ type showCommand struct {
 	*commands.BaseCommand
 	resolver.CachingServer
 	completion.Server
+	cfg *config.Config
 }
 
 func (s *showCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Show server details
+
+With ` + "`--output spec`" + `, the server is output as a spec that can be used to create a similar server with ` + "`upctl server create --from-file`" + `. The OS storage is defined with the template it was created from, if the template still exists, and other disks are defined as new empty disks. SSH keys, passwords, and user data can not be read from an existing server and need to be added to the spec manually.`)
+}
+
+// InitCommandWithConfig implements Command.InitCommandWithConfig
+func (s *showCommand) InitCommandWithConfig(cfg *config.Config) {
+	s.cfg = cfg
 }
 
+// SupportsSpecOutput implements commands.SpecOutputCommand
+func (s *showCommand) SupportsSpecOutput() {}
+
 // Execute implements commands.MultipleArgumentCommand
 func (s *showCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
 	var (
@@ -51,6 +65,19 @@ func (s *showCommand) Execute(exec commands.Executor, uuid string) (output.Outpu
 	serverSvc := exec.Server()
 	firewallSvc := exec.Firewall()
 
+	if s.cfg != nil && s.cfg.Output() == config.ValueOutputSpec {
+		server, err := serverSvc.GetServerDetails(exec.Context(), &request.GetServerDetailsRequest{UUID: uuid})
+		if err != nil {
+			return nil, err
+		}
+
+		spec, err := serverSpecFromDetails(exec, server)
+		if err != nil {
+			return nil, err
+		}
+		return specOutput(spec)
+	}
+
 	wg.Add(1)
 	var firewallRules *upcloud.FirewallRules
 	go func() {
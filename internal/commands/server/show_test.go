@@ -222,3 +222,139 @@ func TestServerHumanOutput(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, output)
 }
+
+func TestServerSpecOutput(t *testing.T) {
+	uuid := "0077fa3d-32db-4b09-9f5f-30d9e9afb565"
+	osDiskUUID := "012580a1-32a1-466e-a323-689ca16f2d43"
+	templateUUID := "01000000-0000-4000-8000-000030240200"
+	srv := &upcloud.ServerDetails{
+		Server: upcloud.Server{
+			CoreNumber:   2,
+			Hostname:     "web1.example.com",
+			MemoryAmount: 4096,
+			State:        "started",
+			Plan:         "custom",
+			Title:        "Web server 1",
+			UUID:         uuid,
+			Zone:         "fi-hel1",
+			Labels:       []upcloud.Label{{Key: "env", Value: "prod"}},
+		},
+		BootOrder: "disk",
+		Firewall:  "on",
+		Metadata:  upcloud.True,
+		Networking: upcloud.ServerNetworking{
+			Interfaces: []upcloud.ServerInterface{
+				{
+					Index:       1,
+					IPAddresses: []upcloud.IPAddress{{Address: "94.237.0.207", Family: "IPv4"}},
+					Network:     "037fcf2a-6745-45dd-867e-f9479ea8c044",
+					Type:        "public",
+				},
+				{
+					Index:             2,
+					IPAddresses:       []upcloud.IPAddress{{Address: "10.0.0.2", Family: "IPv4"}},
+					Network:           PrivateNetworkUUID,
+					Type:              "private",
+					SourceIPFiltering: upcloud.True,
+				},
+				{
+					Index:       3,
+					IPAddresses: []upcloud.IPAddress{{Address: "10.6.3.95", Family: "IPv4"}},
+					Network:     "03000000-0000-4000-8045-000000000000",
+					Type:        "utility",
+				},
+			},
+		},
+		SimpleBackup: "0100,dailies",
+		StorageDevices: []upcloud.ServerStorageDevice{
+			{
+				Address: "virtio:0",
+				UUID:    osDiskUUID,
+				Size:    50,
+				Title:   "web1-OS",
+				Type:    "disk",
+			},
+			{
+				Address:   "virtio:1",
+				UUID:      "01f7a8b2-2a7c-4b38-9a2b-1c0f5e0c1a21",
+				Size:      100,
+				Title:     "web1-data",
+				Type:      "disk",
+				Encrypted: upcloud.True,
+			},
+			{
+				Address: "ide:0:0",
+				UUID:    "01000000-0000-4000-8000-000020070100",
+				Type:    "cdrom",
+			},
+		},
+		Timezone:   "UTC",
+		VideoModel: "vga",
+	}
+
+	expected := `---
+boot_order: disk
+cores: 2
+firewall: true
+hostname: web1.example.com
+labels:
+    env: prod
+memory: 4096
+metadata: true
+networks:
+    - family: IPv4
+      type: public
+    - family: IPv4
+      network: 03b5b0a0-ad4c-4817-9632-dafdb3ace5d9
+      source_ip_filtering: true
+      type: private
+    - family: IPv4
+      type: utility
+os: 01000000-0000-4000-8000-000030240200
+os_storage_size: 50
+plan: custom
+simple_backup: 0100,dailies
+storages:
+    - action: create
+      address: virtio
+      encrypt: true
+      size: 100
+      title: web1-data
+      type: disk
+    - action: attach
+      address: ide
+      storage: 01000000-0000-4000-8000-000020070100
+      type: cdrom
+time_zone: UTC
+title: Web server 1
+video_model: vga
+zone: fi-hel1
+`
+
+	mService := smock.Service{}
+	mService.On("GetServers").Return(&upcloud.Servers{Servers: []upcloud.Server{srv.Server}}, nil)
+	mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: uuid}).Return(srv, nil)
+	mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: osDiskUUID}).Return(&upcloud.StorageDetails{
+		Storage: upcloud.Storage{UUID: osDiskUUID, Type: upcloud.StorageTypeNormal, Origin: templateUUID},
+	}, nil)
+	mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: templateUUID}).Return(&upcloud.StorageDetails{
+		Storage: upcloud.Storage{UUID: templateUUID, Type: upcloud.StorageTypeTemplate},
+	}, nil)
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputSpec)
+
+	command := commands.BuildCommand(ShowCommand(), nil, conf)
+
+	// get resolver to initialize command cache
+	_, err := command.(*showCommand).Get(context.TODO(), &mService)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	command.Cobra().SetArgs([]string{uuid})
+	output, err := mockexecute.MockExecute(command, &mService, conf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, output)
+}
@@ -0,0 +1,337 @@
+package server
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"go.yaml.in/yaml/v3"
+)
+
+// serverSpec is a declarative definition of a server. The keys match the flags of `server create` command.
+type serverSpec struct {
+	Hostname             string            `json:"hostname"`
+	Title                string            `json:"title,omitempty"`
+	Zone                 string            `json:"zone"`
+	Plan                 string            `json:"plan,omitempty"`
+	Cores                int               `json:"cores,omitempty"`
+	Memory               int               `json:"memory,omitempty"`
+	OS                   *string           `json:"os,omitempty"`
+	OSStorageSize        int               `json:"os_storage_size,omitempty"`
+	OSStorageEncrypt     bool              `json:"os_storage_encrypt,omitempty"`
+	Storages             []storageSpec     `json:"storages,omitempty"`
+	Networks             []networkSpec     `json:"networks,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty"`
+	SSHKeys              []string          `json:"ssh_keys,omitempty"`
+	Username             string            `json:"username,omitempty"`
+	PasswordDelivery     string            `json:"password_delivery,omitempty"`
+	CreatePassword       *bool             `json:"create_password,omitempty"`
+	UserData             []string          `json:"user_data,omitempty"`
+	Vars                 map[string]string `json:"vars,omitempty"`
+	Metadata             *bool             `json:"metadata,omitempty"`
+	Firewall             *bool             `json:"firewall,omitempty"`
+	RemoteAccess         *bool             `json:"remote_access,omitempty"`
+	RemoteAccessType     string            `json:"remote_access_type,omitempty"`
+	RemoteAccessPassword string            `json:"remote_access_password,omitempty"`
+	ServerGroup          string            `json:"server_group,omitempty"`
+	SimpleBackup         string            `json:"simple_backup,omitempty"`
+	TimeZone             string            `json:"time_zone,omitempty"`
+	VideoModel           string            `json:"video_model,omitempty"`
+	BootOrder            string            `json:"boot_order,omitempty"`
+	Host                 int64             `json:"host,omitempty"`
+	AvoidHost            int64             `json:"avoid_host,omitempty"`
+}
+
+// storageSpec matches the parameters of `--storage` flag.
+type storageSpec struct {
+	Action  string `json:"action"`
+	Address string `json:"address,omitempty"`
+	Encrypt bool   `json:"encrypt,omitempty"`
+	Storage string `json:"storage,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Tier    string `json:"tier,omitempty"`
+	Title   string `json:"title,omitempty"`
+	Size    int    `json:"size,omitempty"`
+}
+
+// networkSpec matches the parameters of `--network` flag.
+type networkSpec struct {
+	Type              string `json:"type"`
+	Family            string `json:"family,omitempty"`
+	Network           string `json:"network,omitempty"`
+	IPAddress         string `json:"ip_address,omitempty"`
+	Bootable          *bool  `json:"bootable,omitempty"`
+	SourceIPFiltering *bool  `json:"source_ip_filtering,omitempty"`
+}
+
+// readServerSpecs reads server specs from a YAML or JSON file. The file can contain multiple YAML documents, each defining a server.
+func readServerSpecs(path string) ([]serverSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := []serverSpec{}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for i := 1; ; i++ {
+		var raw any
+		if err := dec.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse server spec file: %w", err)
+		}
+		if raw == nil {
+			continue
+		}
+
+		var spec serverSpec
+		if err := commands.DecodeYAMLStrict(raw, &spec); err != nil {
+			return nil, fmt.Errorf("failed to parse server spec %d: %w", i, err)
+		}
+		specs = append(specs, spec)
+	}
+
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no servers defined in %s", path)
+	}
+	return specs, nil
+}
+
+// createParams maps the spec to create params. Values not defined in the spec use the defaults of `server create` command.
+func (spec serverSpec) createParams() (createParams, error) {
+	if spec.Hostname == "" || spec.Zone == "" {
+		return createParams{}, fmt.Errorf("hostname and zone must be defined")
+	}
+
+	def := defaultCreateParams
+	p := createParams{
+		CreateServerRequest: request.CreateServerRequest{
+			AvoidHostID:          spec.AvoidHost,
+			BootOrder:            spec.BootOrder,
+			CoreNumber:           spec.Cores,
+			HostID:               spec.Host,
+			Hostname:             spec.Hostname,
+			MemoryAmount:         spec.Memory,
+			PasswordDelivery:     cmp.Or(spec.PasswordDelivery, def.PasswordDelivery),
+			Plan:                 cmp.Or(spec.Plan, def.Plan),
+			RemoteAccessPassword: spec.RemoteAccessPassword,
+			RemoteAccessType:     spec.RemoteAccessType,
+			ServerGroup:          spec.ServerGroup,
+			SimpleBackup:         spec.SimpleBackup,
+			TimeZone:             cmp.Or(spec.TimeZone, def.TimeZone),
+			Title:                spec.Title,
+			VideoModel:           cmp.Or(spec.VideoModel, def.VideoModel),
+			Zone:                 spec.Zone,
+		},
+		os:            def.os,
+		osStorageSize: spec.OSStorageSize,
+		sshKeys:       spec.SSHKeys,
+		username:      spec.Username,
+		userData:      spec.UserData,
+		vars:          keyValuePairs(spec.Vars),
+		labels:        keyValuePairs(spec.Labels),
+	}
+	if spec.OS != nil {
+		p.os = *spec.OS
+	}
+	if spec.OSStorageEncrypt {
+		p.osStorageEncrypted = config.True
+	}
+	setOptionalBoolean(&p.createPassword, spec.CreatePassword)
+	setOptionalBoolean(&p.firewall, spec.Firewall)
+	setOptionalBoolean(&p.metadata, spec.Metadata)
+	setOptionalBoolean(&p.remoteAccess, spec.RemoteAccess)
+
+	for _, s := range spec.Storages {
+		sd := request.CreateServerStorageDevice{
+			Action:  s.Action,
+			Address: s.Address,
+			Storage: s.Storage,
+			Type:    s.Type,
+			Tier:    s.Tier,
+			Title:   s.Title,
+			Size:    s.Size,
+		}
+		if s.Encrypt {
+			sd.Encrypted = upcloud.True
+		}
+		p.storageDevices = append(p.storageDevices, sd)
+	}
+
+	for _, n := range spec.Networks {
+		iface, err := newServerInterface(n.Type, n.Network, n.Family, n.IPAddress, upcloudBoolean(n.Bootable), upcloudBoolean(n.SourceIPFiltering))
+		if err != nil {
+			return createParams{}, err
+		}
+		p.interfaces = append(p.interfaces, *iface)
+	}
+
+	return p, nil
+}
+
+// serverSpecFromDetails builds a spec that can be used to create a server similar to the given server. The boot disk is defined with the template the disk was cloned from, if the template still exists. Otherwise, the boot disk is cloned. Other disks are defined as new empty disks.
+func serverSpecFromDetails(exec commands.Executor, server *upcloud.ServerDetails) (serverSpec, error) {
+	spec := serverSpec{
+		Hostname:     server.Hostname,
+		Title:        server.Title,
+		Zone:         server.Zone,
+		Plan:         server.Plan,
+		OS:           new(string),
+		ServerGroup:  server.ServerGroup,
+		SimpleBackup: server.SimpleBackup,
+		TimeZone:     server.Timezone,
+		VideoModel:   server.VideoModel,
+		BootOrder:    server.BootOrder,
+		Metadata:     boolPtr(server.Metadata.Bool()),
+		Firewall:     boolPtr(server.Firewall == "on"),
+	}
+	if server.Plan == customPlan {
+		spec.Cores = server.CoreNumber
+		spec.Memory = server.MemoryAmount
+	}
+	if server.RemoteAccessEnabled.Bool() {
+		spec.RemoteAccess = boolPtr(true)
+		spec.RemoteAccessType = server.RemoteAccessType
+	}
+	if len(server.Labels) > 0 {
+		spec.Labels = make(map[string]string)
+		for _, label := range server.Labels {
+			spec.Labels[label.Key] = label.Value
+		}
+	}
+
+	bootDiskFound := false
+	for _, device := range server.StorageDevices {
+		bus, _, _ := strings.Cut(device.Address, ":")
+		switch {
+		case device.Type == upcloud.StorageTypeDisk && !bootDiskFound:
+			bootDiskFound = true
+			template, err := originTemplate(exec, device.UUID)
+			if err != nil {
+				return serverSpec{}, err
+			}
+			if template != "" {
+				spec.OS = &template
+				spec.OSStorageSize = device.Size
+				spec.OSStorageEncrypt = device.Encrypted.Bool()
+				continue
+			}
+			spec.Storages = append(spec.Storages, storageSpec{
+				Action:  request.CreateServerStorageDeviceActionClone,
+				Address: bus,
+				Encrypt: device.Encrypted.Bool(),
+				Storage: device.UUID,
+				Title:   device.Title,
+				Size:    device.Size,
+			})
+		case device.Type == upcloud.StorageTypeDisk:
+			spec.Storages = append(spec.Storages, storageSpec{
+				Action:  request.CreateServerStorageDeviceActionCreate,
+				Address: bus,
+				Encrypt: device.Encrypted.Bool(),
+				Type:    upcloud.StorageTypeDisk,
+				Title:   device.Title,
+				Size:    device.Size,
+			})
+		case device.UUID != "":
+			spec.Storages = append(spec.Storages, storageSpec{
+				Action:  request.CreateServerStorageDeviceActionAttach,
+				Address: bus,
+				Storage: device.UUID,
+				Type:    device.Type,
+			})
+		}
+	}
+
+	for _, iface := range server.Networking.Interfaces {
+		n := networkSpec{Type: iface.Type}
+		if len(iface.IPAddresses) > 0 {
+			n.Family = iface.IPAddresses[0].Family
+		}
+		if iface.Type == upcloud.NetworkTypePrivate {
+			n.Network = iface.Network
+		}
+		if iface.Bootable.Bool() {
+			n.Bootable = boolPtr(true)
+		}
+		if iface.SourceIPFiltering.Bool() {
+			n.SourceIPFiltering = boolPtr(true)
+		}
+		spec.Networks = append(spec.Networks, n)
+	}
+
+	return spec, nil
+}
+
+// originTemplate returns the UUID of the template the storage was cloned from. Empty string is returned if the storage was not cloned from a template or the template does not exist anymore.
+func originTemplate(exec commands.Executor, uuid string) (string, error) {
+	disk, err := exec.Storage().GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: uuid})
+	if err != nil {
+		return "", err
+	}
+	if disk.Origin == "" {
+		return "", nil
+	}
+
+	origin, err := exec.Storage().GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: disk.Origin})
+	// Template might have been deleted after the disk was cloned from it. In that case, the disk needs to be cloned instead.
+	if err != nil || origin.Type != upcloud.StorageTypeTemplate {
+		return "", nil
+	}
+	return origin.UUID, nil
+}
+
+// specOutput renders the spec as a YAML document.
+func specOutput(spec serverSpec) (output.Output, error) {
+	jsonData, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	yamlData, err := output.JSONToYAML(jsonData)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Raw{Source: io.NopCloser(bytes.NewReader(append([]byte("---\n"), yamlData...)))}, nil
+}
+
+func keyValuePairs(m map[string]string) []string {
+	pairs := []string{}
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, m[key]))
+	}
+	return pairs
+}
+
+func setOptionalBoolean(target *config.OptionalBoolean, value *bool) {
+	if value != nil {
+		_ = target.Set(strconv.FormatBool(*value))
+	}
+}
+
+func upcloudBoolean(value *bool) upcloud.Boolean {
+	if value == nil {
+		return upcloud.Empty
+	}
+	return upcloud.FromBool(*value)
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,392 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	osexec "os/exec"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/pflag"
+)
+
+const (
+	addressTypePublicIPv4 = "public-ipv4"
+	addressTypePublicIPv6 = "public-ipv6"
+	addressTypeUtility    = "utility"
+
+	defaultSSHUser = "root"
+)
+
+var addressTypes = []string{addressTypePublicIPv4, addressTypePublicIPv6, addressTypeUtility}
+
+// templateSSHUsers contains the default users of the templates that do not allow logging in as root. The users are matched against the title of the template the server was created from.
+var templateSSHUsers = []struct {
+	match string
+	user  string
+}{
+	{match: "ubuntu", user: "ubuntu"},
+	{match: "debian", user: "debian"},
+}
+
+// lookPath and runSSH are variables to allow replacing them in tests.
+var (
+	lookPath = osexec.LookPath
+	runSSH   = func(cmd *osexec.Cmd) error { return cmd.Run() }
+)
+
+// SSHCommand creates the "server ssh" command
+func SSHCommand() commands.Command {
+	return &sshCommand{
+		BaseCommand: commands.New(
+			"ssh",
+			"Connect to a server with SSH",
+			"upctl server ssh my_server",
+			"upctl server ssh my_server --address-type public-ipv6 --user admin",
+			"upctl server ssh my_server -- uptime",
+			`upctl server ssh "web-*" -- sudo systemctl restart nginx`,
+		),
+	}
+}
+
+type sshCommand struct {
+	*commands.BaseCommand
+	resolver.CachingServer
+	completion.StartedServer
+	addressType  string
+	user         string
+	client       string
+	identityFile string
+	sshOptions   []string
+}
+
+type sshTarget struct {
+	UUID     string
+	Hostname string
+	Address  string
+	User     string
+}
+
+// sshExitError is returned when the SSH client connected to a single server exits with a non-zero exit code, so that the exit code is passed on to the caller.
+type sshExitError struct {
+	ExitCode int
+}
+
+var _ clierrors.ClientError = sshExitError{}
+
+func (err sshExitError) ErrorCode() int {
+	return err.ExitCode
+}
+
+func (err sshExitError) Error() string {
+	return fmt.Sprintf("ssh exited with exit code %d", err.ExitCode)
+}
+
+// InitCommand implements Command.InitCommand
+func (s *sshCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Connect to a server with SSH
+
+Resolves the address of the server and launches the local SSH client. If a command is given after ` + "`--`" + `, the command is run on the server instead of opening an interactive session.
+
+If the arguments match multiple servers, for example when using glob patterns, the command is run on all of the servers in parallel and each line of output is prefixed with the hostname of the server. Interactive sessions can only be opened to a single server.
+
+By default, the user is ` + "`ubuntu`" + ` for servers created from Ubuntu templates, ` + "`debian`" + ` for servers created from Debian templates, and ` + "`root`" + ` otherwise.`)
+
+	flags := &pflag.FlagSet{}
+	flags.StringVar(&s.addressType, "address-type", addressTypePublicIPv4, "Type of the address to connect to. Valid values are "+strings.Join(addressTypes, ", ")+".")
+	flags.StringVar(&s.user, "user", "", "User to log in as. By default, the user is chosen based on the template the server was created from.")
+	flags.StringVar(&s.client, "client", "ssh", "Path to the SSH client binary to use.")
+	flags.StringVar(&s.identityFile, "identity-file", "", "Private key file to authenticate with. Passed to the SSH client with `-i`.")
+	flags.StringArrayVar(&s.sshOptions, "ssh-option", nil, "Option to pass to the SSH client with `-o`, multiple can be declared.\nUsage: --ssh-option StrictHostKeyChecking=accept-new")
+	commands.Must(flags.SetAnnotation("address-type", commands.FlagAnnotationFixedCompletions, addressTypes))
+	commands.Must(flags.SetAnnotation("user", commands.FlagAnnotationNoFileCompletions, nil))
+	commands.Must(flags.SetAnnotation("ssh-option", commands.FlagAnnotationNoFileCompletions, nil))
+	s.AddFlags(flags)
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s *sshCommand) PositionalArgumentHelp() string {
+	return "<UUID/Title/Hostname...> [-- command...]"
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *sshCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	serverArgs, remoteCommand := s.Cobra().Flags().Args(), []string(nil)
+	if dash := s.Cobra().ArgsLenAtDash(); dash >= 0 {
+		serverArgs, remoteCommand = serverArgs[:dash], serverArgs[dash:]
+	}
+	if len(serverArgs) == 0 {
+		return nil, fmt.Errorf("at least one server is required")
+	}
+
+	if !slices.Contains(addressTypes, s.addressType) {
+		return nil, fmt.Errorf("invalid address type %q, must be one of: %s", s.addressType, strings.Join(addressTypes, ", "))
+	}
+
+	client, err := lookPath(s.client)
+	if err != nil {
+		return nil, fmt.Errorf("could not find %s client from PATH, install OpenSSH client or define the client with --client", s.client)
+	}
+
+	uuids, err := s.resolveServers(exec, serverArgs)
+	if err != nil {
+		return nil, err
+	}
+	if len(uuids) > 1 && len(remoteCommand) == 0 {
+		return nil, fmt.Errorf("arguments matched %d servers, a command is required when connecting to multiple servers", len(uuids))
+	}
+
+	targets := make(map[string]sshTarget)
+	failed := 0
+	for _, out := range commands.ExecuteInParallel(exec, uuids, maxServerActions, s.resolveTarget) {
+		switch typed := out.(type) {
+		case output.OnlyMarshaled:
+			if target, ok := typed.Value.(sshTarget); ok {
+				targets[target.UUID] = target
+			}
+		case output.Error:
+			if len(uuids) == 1 {
+				return nil, typed.Value
+			}
+			failed++
+		}
+	}
+
+	exec.StopProgressLog()
+
+	if len(uuids) == 1 {
+		target := targets[uuids[0]]
+		cmd := s.sshCmd(exec, client, target, remoteCommand, false)
+		cmd.Stdin = s.Cobra().InOrStdin()
+		cmd.Stdout = s.Cobra().OutOrStdout()
+		cmd.Stderr = s.Cobra().ErrOrStderr()
+
+		exec.Debug("launching ssh client", "client", client, "args", cmd.Args)
+		if err := runSSH(cmd); err != nil {
+			var exitErr *osexec.ExitError
+			if errors.As(err, &exitErr) {
+				return output.WithError{Output: output.None{}, Err: sshExitError{ExitCode: exitErr.ExitCode()}}, nil
+			}
+			return nil, fmt.Errorf("%s exited with error: %w", client, err)
+		}
+		return output.None{}, nil
+	}
+
+	hostnameWidth := 0
+	for _, target := range targets {
+		hostnameWidth = max(hostnameWidth, len(target.Hostname))
+	}
+
+	connected := []string{}
+	for _, uuid := range uuids {
+		if _, ok := targets[uuid]; ok {
+			connected = append(connected, uuid)
+		}
+	}
+
+	var mu sync.Mutex
+	outputs := commands.ExecuteInParallel(exec, connected, maxServerActions, func(exec commands.Executor, uuid string) (output.Output, error) {
+		target := targets[uuid]
+		prefix := fmt.Sprintf("%-*s | ", hostnameWidth, target.Hostname)
+		stdout := &prefixWriter{mu: &mu, w: s.Cobra().OutOrStdout(), prefix: prefix}
+		stderr := &prefixWriter{mu: &mu, w: s.Cobra().ErrOrStderr(), prefix: prefix}
+		defer func() {
+			_ = stdout.Flush()
+			_ = stderr.Flush()
+		}()
+
+		cmd := s.sshCmd(exec, client, target, remoteCommand, true)
+		cmd.Stdout = stdout
+		cmd.Stderr = stderr
+
+		exec.Debug("launching ssh client", "client", client, "args", cmd.Args)
+		if err := runSSH(cmd); err != nil {
+			fmt.Fprintf(stderr, "%s exited with error: %v\n", client, err)
+			return nil, err
+		}
+		return output.None{}, nil
+	})
+	for _, out := range outputs {
+		if _, ok := out.(output.Error); ok {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return output.WithError{Output: output.None{}, Err: &clierrors.CommandFailedError{FailedCount: failed}}, nil
+	}
+	return output.None{}, nil
+}
+
+// resolveServers resolves the server arguments to server UUIDs. Glob patterns can match multiple servers.
+func (s *sshCommand) resolveServers(exec commands.Executor, args []string) ([]string, error) {
+	resolve, err := s.CachingServer.Get(exec.Context(), exec.All())
+	if err != nil {
+		return nil, fmt.Errorf("cannot get resolver: %w", err)
+	}
+
+	uuids := []string{}
+	for _, arg := range args {
+		resolved := resolve(arg)
+		matches, err := resolved.GetAll()
+		if err != nil {
+			return nil, err
+		}
+		for _, uuid := range matches {
+			if !slices.Contains(uuids, uuid) {
+				uuids = append(uuids, uuid)
+			}
+		}
+	}
+	return uuids, nil
+}
+
+// resolveTarget finds the address and the user to use when connecting to the server.
+func (s *sshCommand) resolveTarget(exec commands.Executor, uuid string) (output.Output, error) {
+	msg := fmt.Sprintf("Resolving SSH address of server %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	details, err := exec.Server().GetServerDetails(exec.Context(), &request.GetServerDetailsRequest{UUID: uuid})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+	if details.State != upcloud.ServerStateStarted {
+		return commands.HandleError(exec, msg, fmt.Errorf("server %s is in %s state, it must be started to connect to it", uuid, details.State))
+	}
+
+	address := serverAddress(details, s.addressType)
+	if address == "" {
+		return commands.HandleError(exec, msg, fmt.Errorf("server %s does not have a %s address", uuid, s.addressType))
+	}
+
+	user := s.user
+	if user == "" {
+		user = defaultUserForServer(exec, details)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: sshTarget{
+		UUID:     uuid,
+		Hostname: details.Hostname,
+		Address:  address,
+		User:     user,
+	}}, nil
+}
+
+func (s *sshCommand) sshCmd(exec commands.Executor, client string, target sshTarget, remoteCommand []string, batch bool) *osexec.Cmd {
+	args := []string{"-l", target.User}
+	if s.identityFile != "" {
+		args = append(args, "-i", s.identityFile)
+	}
+	for _, option := range s.sshOptions {
+		args = append(args, "-o", option)
+	}
+	if batch {
+		// Output of multiple servers is prefixed line by line, do not allocate a terminal or prompt for passwords.
+		args = append(args, "-T", "-o", "BatchMode=yes")
+	}
+	args = append(args, target.Address)
+	args = append(args, remoteCommand...)
+
+	return osexec.CommandContext(exec.Context(), client, args...) //gosec:disable G204 -- client binary is either chosen by the user or looked up from PATH and the remote command is explicit CLI input
+}
+
+// serverAddress returns the first address of the server that matches the address type.
+func serverAddress(details *upcloud.ServerDetails, addressType string) string {
+	access, family := upcloud.IPAddressAccessPublic, upcloud.IPAddressFamilyIPv4
+	switch addressType {
+	case addressTypePublicIPv6:
+		family = upcloud.IPAddressFamilyIPv6
+	case addressTypeUtility:
+		access = upcloud.IPAddressAccessUtility
+	}
+
+	for _, ip := range details.IPAddresses {
+		if ip.Access == access && ip.Family == family {
+			return ip.Address
+		}
+	}
+	return ""
+}
+
+// defaultUserForServer returns the default user of the template the boot disk of the server was created from. If the template can not be determined, root is used.
+func defaultUserForServer(exec commands.Executor, details *upcloud.ServerDetails) string {
+	for _, device := range details.StorageDevices {
+		if device.Type != upcloud.StorageTypeDisk {
+			continue
+		}
+
+		disk, err := exec.Storage().GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: device.UUID})
+		if err != nil || disk.Origin == "" {
+			return defaultSSHUser
+		}
+
+		template, err := exec.Storage().GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: disk.Origin})
+		if err != nil {
+			return defaultSSHUser
+		}
+
+		title := strings.ToLower(template.Title)
+		for _, candidate := range templateSSHUsers {
+			if strings.Contains(title, candidate.match) {
+				return candidate.user
+			}
+		}
+		return defaultSSHUser
+	}
+	return defaultSSHUser
+}
+
+// prefixWriter writes each line written to it to the underlying writer prefixed with the given prefix. The lock is shared between the writers of all servers so that lines from different servers are not interleaved.
+type prefixWriter struct {
+	mu     *sync.Mutex
+	w      io.Writer
+	prefix string
+	buf    []byte
+}
+
+// Write implements io.Writer
+func (p *prefixWriter) Write(b []byte) (int, error) {
+	p.buf = append(p.buf, b...)
+	for {
+		i := bytes.IndexByte(p.buf, '\n')
+		if i < 0 {
+			break
+		}
+		if err := p.writeLine(p.buf[:i+1]); err != nil {
+			return 0, err
+		}
+		p.buf = p.buf[i+1:]
+	}
+	return len(b), nil
+}
+
+// Flush writes the remaining output, if the last line did not end with a newline.
+func (p *prefixWriter) Flush() error {
+	if len(p.buf) == 0 {
+		return nil
+	}
+	err := p.writeLine(append(p.buf, '\n'))
+	p.buf = nil
+	return err
+}
+
+func (p *prefixWriter) writeLine(line []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, err := p.w.Write(append([]byte(p.prefix), line...))
+	return err
+}
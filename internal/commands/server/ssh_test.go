@@ -0,0 +1,212 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	osexec "os/exec"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSHCommand(t *testing.T) {
+	var mu sync.Mutex
+	var ran [][]string
+	origLookPath, origRunSSH := lookPath, runSSH
+	defer func() { lookPath, runSSH = origLookPath, origRunSSH }()
+	lookPath = func(file string) (string, error) {
+		return "/usr/bin/" + file, nil
+	}
+	runSSH = func(cmd *osexec.Cmd) error {
+		mu.Lock()
+		ran = append(ran, cmd.Args)
+		mu.Unlock()
+		if !strings.Contains(strings.Join(cmd.Args, " "), "uptime") {
+			return nil
+		}
+		_, err := io.WriteString(cmd.Stdout, "up 3 days\npartial")
+		return err
+	}
+
+	templates := map[string]string{
+		"01000000-0000-4000-8000-000030240200": "Ubuntu Server 24.04 LTS (Noble Numbat)",
+		"01000000-0000-4000-8000-000020070100": "Debian GNU/Linux 12 (Bookworm)",
+	}
+	servers := []upcloud.ServerDetails{
+		{
+			Server: upcloud.Server{UUID: "00b5a3d6-7a2c-4c4a-9d9c-3c4fbbe8b0f1", Title: "web-1", Hostname: "web-1.example.com", State: upcloud.ServerStateStarted},
+			IPAddresses: upcloud.IPAddressSlice{
+				{Access: upcloud.IPAddressAccessPublic, Family: upcloud.IPAddressFamilyIPv4, Address: "192.0.2.11"},
+				{Access: upcloud.IPAddressAccessPublic, Family: upcloud.IPAddressFamilyIPv6, Address: "2001:db8::11"},
+			},
+			StorageDevices: []upcloud.ServerStorageDevice{
+				{UUID: "01e7a6e9-1c8b-4b4f-bb0d-21b9ea8d5f31", Type: upcloud.StorageTypeDisk},
+			},
+		},
+		{
+			Server: upcloud.Server{UUID: "00b5a3d6-7a2c-4c4a-9d9c-3c4fbbe8b0f2", Title: "web-2", Hostname: "web-2", State: upcloud.ServerStateStarted},
+			IPAddresses: upcloud.IPAddressSlice{
+				{Access: upcloud.IPAddressAccessPublic, Family: upcloud.IPAddressFamilyIPv4, Address: "192.0.2.12"},
+			},
+			StorageDevices: []upcloud.ServerStorageDevice{
+				{UUID: "01e7a6e9-1c8b-4b4f-bb0d-21b9ea8d5f32", Type: upcloud.StorageTypeDisk},
+			},
+		},
+	}
+
+	for _, test := range []struct {
+		name     string
+		args     []string
+		expected [][]string
+		output   []string
+		error    string
+	}{
+		{
+			name:     "interactive session with template user",
+			args:     []string{"web-1"},
+			expected: [][]string{{"/usr/bin/ssh", "-l", "ubuntu", "192.0.2.11"}},
+		},
+		{
+			name:     "command with user and address type",
+			args:     []string{"web-1", "--user", "admin", "--address-type", "public-ipv6", "--identity-file", "id_ed25519", "--ssh-option", "StrictHostKeyChecking=accept-new", "--", "uptime"},
+			expected: [][]string{{"/usr/bin/ssh", "-l", "admin", "-i", "id_ed25519", "-o", "StrictHostKeyChecking=accept-new", "2001:db8::11", "uptime"}},
+			output:   []string{"up 3 days\npartial"},
+		},
+		{
+			name: "glob runs command on all matching servers",
+			args: []string{"web-*", "--", "uptime"},
+			expected: [][]string{
+				{"/usr/bin/ssh", "-l", "ubuntu", "-T", "-o", "BatchMode=yes", "192.0.2.11", "uptime"},
+				{"/usr/bin/ssh", "-l", "debian", "-T", "-o", "BatchMode=yes", "192.0.2.12", "uptime"},
+			},
+			output: []string{
+				"web-1.example.com | up 3 days\n",
+				"web-1.example.com | partial\n",
+				"web-2             | up 3 days\n",
+				"web-2             | partial\n",
+			},
+		},
+		{
+			name:  "glob without command",
+			args:  []string{"web-*"},
+			error: "arguments matched 2 servers, a command is required when connecting to multiple servers",
+		},
+		{
+			name:  "missing address",
+			args:  []string{"web-2", "--address-type", "utility"},
+			error: "server 00b5a3d6-7a2c-4c4a-9d9c-3c4fbbe8b0f2 does not have a utility address",
+		},
+		{
+			name:  "invalid address type",
+			args:  []string{"web-1", "--address-type", "private"},
+			error: `invalid address type "private", must be one of: public-ipv4, public-ipv6, utility`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ran = nil
+			mService := smock.Service{}
+			mService.On("GetServers").Return(&upcloud.Servers{Servers: []upcloud.Server{servers[0].Server, servers[1].Server}}, nil)
+			origins := []string{"01000000-0000-4000-8000-000030240200", "01000000-0000-4000-8000-000020070100"}
+			for i, server := range servers {
+				mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: server.UUID}).Return(&servers[i], nil)
+				mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: server.StorageDevices[0].UUID}).Return(&upcloud.StorageDetails{
+					Storage: upcloud.Storage{UUID: server.StorageDevices[0].UUID, Origin: origins[i]},
+				}, nil)
+			}
+			for uuid, title := range templates {
+				mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: uuid}).Return(&upcloud.StorageDetails{
+					Storage: upcloud.Storage{UUID: uuid, Title: title, Type: upcloud.StorageTypeTemplate},
+				}, nil)
+			}
+
+			conf := config.New()
+			c := commands.BuildCommand(SSHCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			out, err := mockexecute.MockExecute(c, &mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				assert.Empty(t, ran)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, test.expected, ran)
+			for _, expected := range test.output {
+				assert.Contains(t, out, expected)
+			}
+		})
+	}
+}
+
+func TestSSHCommand_RunCommand(t *testing.T) {
+	origLookPath, origRunSSH := lookPath, runSSH
+	defer func() { lookPath, runSSH = origLookPath, origRunSSH }()
+	lookPath = func(file string) (string, error) {
+		return "/usr/bin/" + file, nil
+	}
+	runSSH = func(cmd *osexec.Cmd) error {
+		if slices.Contains(cmd.Args, "192.0.2.12") {
+			return fmt.Errorf("exit status 255")
+		}
+		_, err := io.WriteString(cmd.Stdout, "up 3 days\n")
+		return err
+	}
+
+	servers := []upcloud.ServerDetails{
+		{
+			Server:      upcloud.Server{UUID: "00b5a3d6-7a2c-4c4a-9d9c-3c4fbbe8b0f1", Title: "web-1", Hostname: "web-1", State: upcloud.ServerStateStarted},
+			IPAddresses: upcloud.IPAddressSlice{{Access: upcloud.IPAddressAccessPublic, Family: upcloud.IPAddressFamilyIPv4, Address: "192.0.2.11"}},
+		},
+		{
+			Server:      upcloud.Server{UUID: "00b5a3d6-7a2c-4c4a-9d9c-3c4fbbe8b0f2", Title: "web-2", Hostname: "web-2", State: upcloud.ServerStateStarted},
+			IPAddresses: upcloud.IPAddressSlice{{Access: upcloud.IPAddressAccessPublic, Family: upcloud.IPAddressFamilyIPv4, Address: "192.0.2.12"}},
+		},
+	}
+
+	mService := smock.Service{}
+	mService.On("GetAccount").Return(&upcloud.Account{}, nil)
+	mService.On("GetServers").Return(&upcloud.Servers{Servers: []upcloud.Server{servers[0].Server, servers[1].Server}}, nil)
+	for i, server := range servers {
+		mService.On("GetServerDetails", &request.GetServerDetailsRequest{UUID: server.UUID}).Return(&servers[i], nil)
+	}
+
+	conf := config.New()
+	c := commands.BuildCommand(SSHCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"web-*", "--user", "root", "--", "uptime"})
+
+	// The command stops the progress log before running the remote commands, so the failed remote command must not be pushed to the stopped log, and stopping the log again after the command must not panic.
+	var out string
+	var err error
+	assert.NotPanics(t, func() {
+		out, err = mockexecute.MockExecuteRunCommand(c, &mService, conf)
+	})
+	assert.EqualError(t, err, "Command execution failed for 1 resource(s)")
+	assert.Contains(t, out, "web-1 | up 3 days\n")
+	assert.Contains(t, out, "web-2 | ssh exited with error: exit status 255\n")
+}
+
+func TestPrefixWriter(t *testing.T) {
+	var mu sync.Mutex
+	var b strings.Builder
+	w := &prefixWriter{mu: &mu, w: &b, prefix: "web-1 | "}
+
+	_, err := fmt.Fprint(w, "first line\nsecond ")
+	assert.NoError(t, err)
+	assert.Equal(t, "web-1 | first line\n", b.String())
+
+	_, err = fmt.Fprint(w, "line\nlast")
+	assert.NoError(t, err)
+	assert.NoError(t, w.Flush())
+	assert.Equal(t, "web-1 | first line\nweb-1 | second line\nweb-1 | last\n", b.String())
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
 	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
 
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
@@ -92,3 +93,18 @@ func TestStartCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestStartCommand_SpecOutput(t *testing.T) {
+	mService := new(smock.Service)
+
+	conf := config.New()
+	conf.Viper().Set(config.KeyOutput, config.ValueOutputSpec)
+
+	c := commands.BuildCommand(StartCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"1fdfda29-ead1-4855-b71f-1e33eb2ca9de"})
+	_, err := mockexecute.MockExecute(c, mService, conf)
+
+	// The output format is checked before the command is executed, so the server is not started.
+	assert.EqualError(t, err, "output format spec is not supported by this command")
+	mService.AssertNotCalled(t, "StartServer", mock.Anything)
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// cloudConfigMergeType makes cloud-init to merge the cloud-config parts of a multi-part user data by appending lists and merging dictionaries, instead of replacing the values defined in the previous parts.
+const cloudConfigMergeType = "list(append)+dict(recurse_array)+str()"
+
+// userDataContentTypes maps the first line of a user data part to its cloud-init content type. Prefixes are checked in order, so longer prefixes need to be listed before their shorter alternatives.
+var userDataContentTypes = []struct {
+	prefix      string
+	contentType string
+}{
+	{"#cloud-config-archive", "text/cloud-config-archive"},
+	{"#cloud-config", "text/cloud-config"},
+	{"#cloud-boothook", "text/cloud-boothook"},
+	{"#include", "text/x-include-url"},
+	{"#part-handler", "text/part-handler"},
+	{"## template: jinja", "text/jinja2"},
+	{"#!", "text/x-shellscript"},
+}
+
+type userDataPart struct {
+	name    string
+	content string
+}
+
+// buildUserData reads, renders, and validates the user data values given with `--user-data` and combines multiple values into a multi-part MIME message understood by cloud-init.
+//
+// Values prefixed with `@` are read from a file. Other values are used as is, which allows using URLs or inline scripts. If vars are given, the values, except URLs, are rendered as Go templates.
+func buildUserData(values, vars []string) (string, error) {
+	if len(values) == 0 {
+		if len(vars) > 0 {
+			return "", fmt.Errorf("--var can only be used with --user-data")
+		}
+		return "", nil
+	}
+
+	data, err := parseUserDataVars(vars)
+	if err != nil {
+		return "", err
+	}
+
+	parts := make([]userDataPart, 0, len(values))
+	for i, value := range values {
+		part, err := readUserDataPart(value, i)
+		if err != nil {
+			return "", err
+		}
+
+		if data != nil && !isUserDataURL(part.content) {
+			if part.content, err = renderUserData(part, data); err != nil {
+				return "", err
+			}
+		}
+
+		if err := validateUserData(part); err != nil {
+			return "", err
+		}
+		parts = append(parts, part)
+	}
+
+	if len(parts) == 1 {
+		return parts[0].content, nil
+	}
+	return combineUserData(parts)
+}
+
+func parseUserDataVars(vars []string) (map[string]string, error) {
+	if len(vars) == 0 {
+		return nil, nil
+	}
+
+	data := make(map[string]string)
+	for _, v := range vars {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf(`invalid variable "%s", expected format is key=value`, v)
+		}
+		data[key] = value
+	}
+	return data, nil
+}
+
+func readUserDataPart(value string, index int) (userDataPart, error) {
+	if path, ok := strings.CutPrefix(value, "@"); ok {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return userDataPart{}, fmt.Errorf("cannot read user data: %w", err)
+		}
+		return userDataPart{name: filepath.Base(path), content: string(content)}, nil
+	}
+	return userDataPart{name: fmt.Sprintf("part-%d", index+1), content: value}, nil
+}
+
+func renderUserData(part userDataPart, data map[string]string) (string, error) {
+	tmpl, err := template.New(part.name).Option("missingkey=error").Parse(part.content)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse user data %s as template: %w", part.name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("cannot render user data %s: %w", part.name, err)
+	}
+	return buf.String(), nil
+}
+
+// validateUserData checks that cloud-config user data is a valid YAML mapping. Other types of user data are not validated.
+func validateUserData(part userDataPart) error {
+	if userDataContentType(part.content) != "text/cloud-config" {
+		return nil
+	}
+
+	var cloudConfig map[string]any
+	if err := yaml.Unmarshal([]byte(part.content), &cloudConfig); err != nil {
+		return fmt.Errorf("user data %s is not valid cloud-config: %w", part.name, err)
+	}
+	return nil
+}
+
+func isUserDataURL(content string) bool {
+	return strings.HasPrefix(content, "http://") || strings.HasPrefix(content, "https://")
+}
+
+func userDataContentType(content string) string {
+	for _, t := range userDataContentTypes {
+		if strings.HasPrefix(content, t.prefix) {
+			return t.contentType
+		}
+	}
+	return ""
+}
+
+// combineUserData combines multiple user data parts into a multi-part MIME message. URLs are included with `#include` directive.
+func combineUserData(parts []userDataPart) (string, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	for _, part := range parts {
+		content := part.content
+		if isUserDataURL(content) {
+			content = "#include\n" + content
+		}
+
+		contentType := userDataContentType(content)
+		if contentType == "" {
+			return "", fmt.Errorf("cannot detect type of user data %s, the content should start with a cloud-init header, for example #cloud-config or #!", part.name)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", fmt.Sprintf(`%s; charset="utf-8"`, contentType))
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "8bit")
+		header.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, part.name))
+		if contentType == "text/cloud-config" {
+			header.Set("Merge-Type", cloudConfigMergeType)
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := pw.Write([]byte(content)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Content-Type: multipart/mixed; boundary=\"%s\"\r\nMIME-Version: 1.0\r\n\r\n%s", w.Boundary(), body.String()), nil
+}
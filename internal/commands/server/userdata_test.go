@@ -0,0 +1,146 @@
+package server
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildUserData(t *testing.T) {
+	dir := t.TempDir()
+	cloudConfig := filepath.Join(dir, "cloud-config.yaml")
+	require.NoError(t, os.WriteFile(cloudConfig, []byte("#cloud-config\npackages:\n  - {{.package}}\n"), 0o600))
+	invalid := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalid, []byte("#cloud-config\npackages: [nginx\n"), 0o600))
+
+	for _, test := range []struct {
+		name     string
+		values   []string
+		vars     []string
+		expected string
+		error    string
+	}{
+		{
+			name: "no user data",
+		},
+		{
+			name:     "inline script",
+			values:   []string{"#!/bin/sh\necho {{.name}}"},
+			expected: "#!/bin/sh\necho {{.name}}",
+		},
+		{
+			name:     "url",
+			values:   []string{"https://example.com/setup.sh"},
+			vars:     []string{"name=world"},
+			expected: "https://example.com/setup.sh",
+		},
+		{
+			name:     "rendered inline script",
+			values:   []string{"#!/bin/sh\necho {{.name}}"},
+			vars:     []string{"name=hello=world"},
+			expected: "#!/bin/sh\necho hello=world",
+		},
+		{
+			name:     "rendered file",
+			values:   []string{"@" + cloudConfig},
+			vars:     []string{"package=nginx"},
+			expected: "#cloud-config\npackages:\n  - nginx\n",
+		},
+		{
+			name:   "missing variable",
+			values: []string{"@" + cloudConfig},
+			vars:   []string{"other=nginx"},
+			error:  `cannot render user data cloud-config.yaml: template: cloud-config.yaml:3:6: executing "cloud-config.yaml" at <.package>: map has no entry for key "package"`,
+		},
+		{
+			name:   "invalid variable",
+			values: []string{"@" + cloudConfig},
+			vars:   []string{"package"},
+			error:  `invalid variable "package", expected format is key=value`,
+		},
+		{
+			name:  "variable without user data",
+			vars:  []string{"package=nginx"},
+			error: "--var can only be used with --user-data",
+		},
+		{
+			name:   "invalid cloud-config",
+			values: []string{"@" + invalid},
+			error:  "user data invalid.yaml is not valid cloud-config: yaml: line 1: did not find expected ',' or ']'",
+		},
+		{
+			name:   "unknown part type in multi-part user data",
+			values: []string{"@" + cloudConfig, "echo hello"},
+			vars:   []string{"package=nginx"},
+			error:  "cannot detect type of user data part-2, the content should start with a cloud-init header, for example #cloud-config or #!",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			userData, err := buildUserData(test.values, test.vars)
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, userData)
+			}
+		})
+	}
+}
+
+func TestBuildUserData_MultiPart(t *testing.T) {
+	dir := t.TempDir()
+	cloudConfig := filepath.Join(dir, "cloud-config.yaml")
+	require.NoError(t, os.WriteFile(cloudConfig, []byte("#cloud-config\npackages:\n  - {{.package}}\n"), 0o600))
+
+	userData, err := buildUserData([]string{
+		"@" + cloudConfig,
+		"#!/bin/sh\nsystemctl enable --now {{.package}}",
+		"https://example.com/cloud-config.yaml",
+	}, []string{"package=nginx"})
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(strings.NewReader(userData))
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	expected := []struct {
+		contentType string
+		filename    string
+		mergeType   string
+		content     string
+	}{
+		{"text/cloud-config", "cloud-config.yaml", cloudConfigMergeType, "#cloud-config\npackages:\n  - nginx\n"},
+		{"text/x-shellscript", "part-2", "", "#!/bin/sh\nsystemctl enable --now nginx"},
+		{"text/x-include-url", "part-3", "", "#include\nhttps://example.com/cloud-config.yaml"},
+	}
+
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	for _, e := range expected {
+		part, err := r.NextPart()
+		require.NoError(t, err)
+
+		contentType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, e.contentType, contentType)
+		assert.Equal(t, e.filename, part.FileName())
+		assert.Equal(t, e.mergeType, part.Header.Get("Merge-Type"))
+
+		content, err := io.ReadAll(part)
+		require.NoError(t, err)
+		assert.Equal(t, e.content, string(content))
+	}
+
+	_, err = r.NextPart()
+	assert.ErrorIs(t, err, io.EOF)
+}
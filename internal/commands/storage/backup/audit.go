@@ -0,0 +1,253 @@
+package storagebackup
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// AuditCommand creates the "storage backup audit" command
+func AuditCommand() commands.Command {
+	return &auditCommand{
+		BaseCommand: commands.New(
+			"audit",
+			"Audit backups of all storages",
+			"upctl storage backup audit",
+			"upctl storage backup audit --max-age 1d",
+			"upctl storage backup audit --max-age 36h --output json",
+		),
+	}
+}
+
+// maxStorageDetailsRequests is the number of storage details fetched in parallel.
+const maxStorageDetailsRequests = 10
+
+type auditCommand struct {
+	*commands.BaseCommand
+	maxAge string
+}
+
+type auditResult struct {
+	UUID          string              `json:"uuid"`
+	Title         string              `json:"title"`
+	Zone          string              `json:"zone"`
+	BackupRule    *upcloud.BackupRule `json:"backup_rule,omitempty"`
+	LastBackup    *time.Time          `json:"last_backup,omitempty"`
+	LastBackupAge string              `json:"last_backup_age,omitempty"`
+	Violation     string              `json:"violation,omitempty"`
+}
+
+// backupPolicyViolationError is returned after rendering the audit results, if some of the storages violate the given policy.
+type backupPolicyViolationError struct {
+	ViolationCount int
+}
+
+var _ clierrors.ClientError = backupPolicyViolationError{}
+
+func (err backupPolicyViolationError) ErrorCode() int {
+	return min(err.ViolationCount, 99)
+}
+
+func (err backupPolicyViolationError) Error() string {
+	return fmt.Sprintf("%d storage(s) violate the backup policy", err.ViolationCount)
+}
+
+// InitCommand implements Command.InitCommand
+func (s *auditCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Audit backups of all storages
+
+Lists all normal storages with their backup rule, retention, and the time and age of their latest backup.
+
+If ` + "`--max-age`" + ` is given, storages that do not have a backup that is newer than the given maximum age are reported as violations of the policy. If there are violations, the command exits with the number of violating storages (up to 99) as the exit code.`)
+
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringVar(&s.maxAge, "max-age", "", "Maximum age of the latest backup of each storage, e.g. `7d` or `36h`. By default, the age is not checked.")
+
+	s.AddFlags(flagSet)
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("max-age", cobra.NoFileCompletions))
+}
+
+// parseMaxAge parses a duration that can, in addition to the units supported by time.ParseDuration, be given in days, e.g. 7d.
+func parseMaxAge(in string) (time.Duration, error) {
+	if in == "" {
+		return 0, nil
+	}
+
+	if days, ok := strings.CutSuffix(in, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return time.Duration(n) * 24 * time.Hour, nil
+		}
+	}
+
+	d, err := time.ParseDuration(in)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid max-age %q, use a positive number of days (e.g. 7d) or a duration (e.g. 36h)", in)
+	}
+	return d, nil
+}
+
+// formatAge formats a backup age with day precision for old backups and minute precision for recent ones.
+func formatAge(age time.Duration) string {
+	if age >= 24*time.Hour {
+		return fmt.Sprintf("%dd%dh", age/(24*time.Hour), age%(24*time.Hour)/time.Hour)
+	}
+	return age.Truncate(time.Minute).String()
+}
+
+func formatBackupRule(rule *upcloud.BackupRule) string {
+	if rule == nil || rule.Interval == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s at %s", rule.Interval, rule.Time)
+}
+
+func formatViolation(val any) (text.Colors, string, error) {
+	violation, ok := val.(string)
+	if !ok {
+		return nil, fmt.Sprint(val), nil
+	}
+	if violation == "" {
+		return text.Colors{text.FgGreen}, "no", nil
+	}
+	return text.Colors{text.FgHiRed, text.Bold}, violation, nil
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *auditCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	maxAge, err := parseMaxAge(s.maxAge)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := exec.Storage()
+	storages, err := svc.GetStorages(exec.Context(), &request.GetStoragesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	latestBackups := make(map[string]time.Time)
+	for _, storage := range storages.Storages {
+		if storage.Type == upcloud.StorageTypeBackup && storage.Created.After(latestBackups[storage.Origin]) {
+			latestBackups[storage.Origin] = storage.Created
+		}
+	}
+
+	normal := []upcloud.Storage{}
+	args := []string{}
+	for _, storage := range storages.Storages {
+		if storage.Type == upcloud.StorageTypeNormal {
+			args = append(args, strconv.Itoa(len(normal)))
+			normal = append(normal, storage)
+		}
+	}
+
+	// Backup rules are only included in storage details. Each execution writes only to its own index, so the rules can be written without locking.
+	backupRules := make([]*upcloud.BackupRule, len(normal))
+	outputs := commands.ExecuteInParallel(exec, args, maxStorageDetailsRequests, func(exec commands.Executor, arg string) (output.Output, error) {
+		i, _ := strconv.Atoi(arg)
+		details, err := exec.Storage().GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: normal[i].UUID})
+		if err != nil {
+			return nil, err
+		}
+		backupRules[i] = details.BackupRule
+		return output.None{}, nil
+	})
+	for _, out := range outputs {
+		if typed, ok := out.(output.Error); ok {
+			return nil, typed.Value
+		}
+	}
+
+	now := time.Now()
+	results := []auditResult{}
+	rows := []output.TableRow{}
+	violations := 0
+	for i, storage := range normal {
+		result := auditResult{
+			UUID:  storage.UUID,
+			Title: storage.Title,
+			Zone:  storage.Zone,
+		}
+		if rule := backupRules[i]; rule != nil && rule.Interval != "" {
+			result.BackupRule = rule
+		}
+
+		var age time.Duration
+		if lastBackup, ok := latestBackups[storage.UUID]; ok {
+			age = now.Sub(lastBackup)
+			result.LastBackup = &lastBackup
+			result.LastBackupAge = formatAge(age)
+		}
+
+		if maxAge > 0 {
+			switch {
+			case result.LastBackup == nil:
+				result.Violation = "no backups"
+			case age > maxAge:
+				result.Violation = fmt.Sprintf("latest backup older than %s", s.maxAge)
+			}
+		}
+		if result.Violation != "" {
+			violations++
+		}
+
+		retention := ""
+		if result.BackupRule != nil {
+			retention = fmt.Sprintf("%d days", result.BackupRule.Retention)
+		}
+		lastBackup := ""
+		if result.LastBackup != nil {
+			lastBackup = ui.FormatTime(*result.LastBackup)
+		}
+
+		results = append(results, result)
+		rows = append(rows, output.TableRow{
+			result.UUID,
+			result.Title,
+			result.Zone,
+			formatBackupRule(result.BackupRule),
+			retention,
+			lastBackup,
+			result.LastBackupAge,
+			result.Violation,
+		})
+	}
+
+	columns := []output.TableColumn{
+		{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+		{Key: "title", Header: "Title"},
+		{Key: "zone", Header: "Zone"},
+		{Key: "backup_rule", Header: "Backup rule"},
+		{Key: "retention", Header: "Retention"},
+		{Key: "last_backup", Header: "Last backup"},
+		{Key: "last_backup_age", Header: "Age"},
+	}
+	if maxAge > 0 {
+		columns = append(columns, output.TableColumn{Key: "violation", Header: "Violation", Format: formatViolation})
+	}
+
+	var out output.Output = output.MarshaledWithHumanOutput{
+		Value: results,
+		Output: output.Table{
+			Columns:      columns,
+			Rows:         rows,
+			EmptyMessage: "No storages found.",
+		},
+	}
+	if violations > 0 {
+		out = output.WithError{Output: out, Err: backupPolicyViolationError{ViolationCount: violations}}
+	}
+	return out, nil
+}
@@ -0,0 +1,99 @@
+package storagebackup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditCommand(t *testing.T) {
+	storage1 := upcloud.Storage{UUID: UUID1, Title: Title1, Type: upcloud.StorageTypeNormal, Zone: "fi-hel1"}
+	storage2 := upcloud.Storage{UUID: UUID2, Title: Title2, Type: upcloud.StorageTypeNormal, Zone: "fi-hel1"}
+	oldBackup := upcloud.Storage{UUID: "01f3286c-a5ea-4670-8121-d0b9767d7b6f", Type: upcloud.StorageTypeBackup, Origin: UUID1, Created: time.Now().Add(-72 * time.Hour)}
+	newBackup := upcloud.Storage{UUID: "0193bd01-b7cb-4ccd-89ef-0d4d8b2b1e9a", Type: upcloud.StorageTypeBackup, Origin: UUID1, Created: time.Now().Add(-2 * time.Hour)}
+
+	for _, test := range []struct {
+		name       string
+		args       []string
+		violations int
+		expected   []string
+	}{
+		{
+			name:     "no policy",
+			expected: []string{`"last_backup_age": "2h0m0s"`, `"interval": "daily"`},
+		},
+		{
+			name:       "storage without backups violates policy",
+			args:       []string{"--max-age", "1d"},
+			violations: 1,
+			expected:   []string{`"violation": "no backups"`},
+		},
+		{
+			name:       "latest backup is too old",
+			args:       []string{"--max-age", "1h"},
+			violations: 2,
+			expected:   []string{`"violation": "latest backup older than 1h"`, `"violation": "no backups"`},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := new(smock.Service)
+			mService.On("GetStorages", &request.GetStoragesRequest{}).Return(&upcloud.Storages{Storages: []upcloud.Storage{storage1, storage2, oldBackup, newBackup}}, nil)
+			mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: UUID1}).Return(&upcloud.StorageDetails{
+				Storage:    storage1,
+				BackupRule: &upcloud.BackupRule{Interval: upcloud.BackupRuleIntervalDaily, Time: "0400", Retention: 7},
+			}, nil)
+			mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: UUID2}).Return(&upcloud.StorageDetails{Storage: storage2}, nil)
+
+			conf := config.New()
+			conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+			c := commands.BuildCommand(AuditCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			out, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.violations > 0 {
+				assert.EqualError(t, err, backupPolicyViolationError{ViolationCount: test.violations}.Error())
+				var clierr clierrors.ClientError
+				assert.ErrorAs(t, err, &clierr)
+				assert.Equal(t, test.violations, clierr.ErrorCode())
+			} else {
+				assert.NoError(t, err)
+			}
+			for _, expected := range test.expected {
+				assert.Contains(t, out, expected)
+			}
+		})
+	}
+}
+
+func TestParseMaxAge(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		expected time.Duration
+		error    bool
+	}{
+		{input: "", expected: 0},
+		{input: "7d", expected: 7 * 24 * time.Hour},
+		{input: "36h", expected: 36 * time.Hour},
+		{input: "1w", error: true},
+		{input: "-1h", error: true},
+	} {
+		t.Run(test.input, func(t *testing.T) {
+			actual, err := parseMaxAge(test.input)
+			if test.error {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			}
+		})
+	}
+}
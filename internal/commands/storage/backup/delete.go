@@ -0,0 +1,61 @@
+package storagebackup
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+type deleteBackupCommand struct {
+	*commands.BaseCommand
+	resolver.CachingStorage
+	completion.Storage
+}
+
+// DeleteBackupCommand creates the "storage backup delete" command
+func DeleteBackupCommand() commands.Command {
+	return &deleteBackupCommand{
+		BaseCommand: commands.New(
+			"delete",
+			"Delete a backup",
+			"upctl storage backup delete 01177c9e-7f76-4ce4-b128-bcaa3448f7ec",
+			"upctl storage backup delete 01177c9e-7f76-4ce4-b128-bcaa3448f7ec 0127dfd6-3884-4079-a948-3a8881df1a7a",
+			`upctl storage backup delete second_backup`,
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *deleteBackupCommand) InitCommand() {
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *deleteBackupCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.Storage()
+	msg := fmt.Sprintf("Deleting backup %v", uuid)
+	exec.PushProgressStarted(msg)
+
+	// Backups are storages, make sure that a normal storage is not deleted by accident.
+	details, err := svc.GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: uuid})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+	if details.Type != upcloud.StorageTypeBackup {
+		return commands.HandleError(exec, msg, fmt.Errorf("storage %s is not a backup, use `upctl storage delete` to delete it", uuid))
+	}
+
+	err = svc.DeleteStorage(exec.Context(), &request.DeleteStorageRequest{UUID: uuid})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	exec.PushProgressSuccess(msg)
+
+	return output.None{}, nil
+}
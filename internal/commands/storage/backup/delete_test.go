@@ -0,0 +1,55 @@
+package storagebackup
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteBackupCommand(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		storageType string
+		error       string
+		deleteCalls int
+	}{
+		{
+			name:        "backup",
+			storageType: upcloud.StorageTypeBackup,
+			deleteCalls: 1,
+		},
+		{
+			name:        "normal storage",
+			storageType: upcloud.StorageTypeNormal,
+			error:       fmt.Sprintf("storage %s is not a backup, use `upctl storage delete` to delete it", UUID1),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			storage := upcloud.Storage{UUID: UUID1, Title: Title1, Type: test.storageType}
+
+			mService := new(smock.Service)
+			mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: UUID1}).Return(&upcloud.StorageDetails{Storage: storage}, nil)
+			mService.On("DeleteStorage", &request.DeleteStorageRequest{UUID: UUID1}).Return(nil)
+
+			conf := config.New()
+			c := commands.BuildCommand(DeleteBackupCommand(), nil, conf)
+			c.Cobra().SetArgs([]string{UUID1})
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+			} else {
+				assert.NoError(t, err)
+			}
+			mService.AssertNumberOfCalls(t, "DeleteStorage", test.deleteCalls)
+		})
+	}
+}
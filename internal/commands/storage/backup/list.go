@@ -0,0 +1,100 @@
+package storagebackup
+
+import (
+	"slices"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ListBackupsCommand creates the "storage backup list" command
+func ListBackupsCommand() commands.Command {
+	return &listBackupsCommand{
+		BaseCommand: commands.New(
+			"list",
+			"List backups",
+			"upctl storage backup list",
+			`upctl storage backup list 01cbea5e-eb5b-4072-b2ac-9b635120e5d8 "My Storage"`,
+		),
+	}
+}
+
+type listBackupsCommand struct {
+	*commands.BaseCommand
+	completion.Storage
+}
+
+// InitCommand implements Command.InitCommand
+func (s *listBackupsCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`List backups
+
+If storages are given as arguments, only the backups of those storages are listed.`)
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s *listBackupsCommand) PositionalArgumentHelp() string {
+	return "[<UUID/Title>...]"
+}
+
+// ExecuteWithoutArguments implements commands.NoArgumentCommand
+func (s *listBackupsCommand) ExecuteWithoutArguments(exec commands.Executor) (output.Output, error) {
+	origins := []string{}
+	for _, arg := range s.Cobra().Flags().Args() {
+		storage, err := namedargs.GetStorage(exec, arg)
+		if err != nil {
+			return nil, err
+		}
+		origins = append(origins, storage.UUID)
+	}
+
+	storages, err := exec.Storage().GetStorages(exec.Context(), &request.GetStoragesRequest{Type: upcloud.StorageTypeBackup})
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make([]upcloud.Storage, 0)
+	rows := []output.TableRow{}
+	for _, backup := range storages.Storages {
+		if backup.Type != upcloud.StorageTypeBackup {
+			continue
+		}
+		if len(origins) > 0 && !slices.Contains(origins, backup.Origin) {
+			continue
+		}
+
+		backups = append(backups, backup)
+		rows = append(rows, output.TableRow{
+			backup.UUID,
+			backup.Title,
+			backup.Origin,
+			backup.Zone,
+			backup.Size,
+			backup.State,
+			backup.Created,
+		})
+	}
+
+	return output.MarshaledWithHumanOutput{
+		Value: upcloud.Storages{Storages: backups},
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+				{Key: "title", Header: "Title"},
+				{Key: "origin", Header: "Origin", Colour: ui.DefaultUUUIDColours},
+				{Key: "zone", Header: "Zone"},
+				{Key: "size", Header: "Size"},
+				{Key: "state", Header: "State", Format: format.StorageState},
+				{Key: "created", Header: "Created"},
+			},
+			Rows:         rows,
+			EmptyMessage: "No backups found.",
+		},
+	}, nil
+}
@@ -0,0 +1,58 @@
+package storagebackup
+
+import (
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListBackupsCommand(t *testing.T) {
+	storage := upcloud.Storage{UUID: UUID1, Title: Title1, Type: upcloud.StorageTypeNormal, Zone: "fi-hel1"}
+	backup1 := upcloud.Storage{UUID: UUID2, Title: Title2, Type: upcloud.StorageTypeBackup, Zone: "fi-hel1", Origin: UUID1}
+	backup2 := upcloud.Storage{UUID: "01f3286c-a5ea-4670-8121-d0b9767d7b6f", Title: "other-backup", Type: upcloud.StorageTypeBackup, Zone: "fi-hel1", Origin: "0193bd01-b7cb-4ccd-89ef-0d4d8b2b1e9a"}
+
+	for _, test := range []struct {
+		name        string
+		args        []string
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name:     "all backups",
+			expected: []string{UUID2, "01f3286c-a5ea-4670-8121-d0b9767d7b6f"},
+		},
+		{
+			name:        "backups of given storage",
+			args:        []string{Title1},
+			expected:    []string{UUID2},
+			notExpected: []string{"01f3286c-a5ea-4670-8121-d0b9767d7b6f"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			mService := new(smock.Service)
+			mService.On("GetStorages", &request.GetStoragesRequest{}).Return(&upcloud.Storages{Storages: []upcloud.Storage{storage, backup1, backup2}}, nil)
+			mService.On("GetStorages", &request.GetStoragesRequest{Type: upcloud.StorageTypeBackup}).Return(&upcloud.Storages{Storages: []upcloud.Storage{backup1, backup2}}, nil)
+
+			conf := config.New()
+			conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+			c := commands.BuildCommand(ListBackupsCommand(), nil, conf)
+			c.Cobra().SetArgs(test.args)
+			out, err := mockexecute.MockExecute(c, mService, conf)
+
+			assert.NoError(t, err)
+			for _, expected := range test.expected {
+				assert.Contains(t, out, expected)
+			}
+			for _, notExpected := range test.notExpected {
+				assert.NotContains(t, out, notExpected)
+			}
+		})
+	}
+}
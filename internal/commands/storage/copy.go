@@ -0,0 +1,215 @@
+package storage
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/clierrors"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/format"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/namedargs"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	maxCopyZones    = 10
+	copyWaitTimeout = 2 * time.Hour
+)
+
+type copyCommand struct {
+	*commands.BaseCommand
+	resolver.CachingStorage
+	completion.Storage
+	zones []string
+	title string
+	tier  string
+	wait  config.OptionalBoolean
+}
+
+type copyResult struct {
+	Zone    string                  `json:"zone"`
+	Storage *upcloud.StorageDetails `json:"storage,omitempty"`
+	Error   string                  `json:"error,omitempty"`
+}
+
+// CopyCommand creates the "storage copy" command
+func CopyCommand() commands.Command {
+	return &copyCommand{
+		BaseCommand: commands.New(
+			"copy",
+			"Copy a storage or a private template to other zones",
+			"upctl storage copy 015899e0-0a68-4949-85bb-261a99de5fdd --to-zone de-fra1",
+			`upctl storage copy "My Template" --to-zone de-fra1 --to-zone nl-ams1 --to-zone us-nyc1 --wait`,
+			`upctl storage copy "My Storage" --to-zone pl-waw1 --title "My Storage in Warsaw" --tier maxiops`,
+		),
+	}
+}
+
+// InitCommand implements Command.InitCommand
+func (s *copyCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Copy a storage or a private template to other zones
+
+The copies to different zones are created in parallel. Normal storages are cloned to the target zones. Templates are cloned to the target zones and the clones are templatised and deleted after the new templates have been created. Because of this, copying a template always waits for the templates to be created.`)
+
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringArrayVar(&s.zones, "to-zone", nil, "Zone to copy the storage to, multiple can be declared.\nUsage: --to-zone de-fra1 --to-zone nl-ams1")
+	flagSet.StringVar(&s.title, "title", "", "Title of the copies. Defaults to the title of the source storage.")
+	flagSet.StringVar(&s.tier, "tier", "", "The storage tier to use. Defaults to the tier of the source storage.")
+	config.AddToggleFlag(flagSet, &s.wait, "wait", false, "Wait for the copies to be in online state before returning.")
+
+	s.AddFlags(flagSet)
+	commands.Must(s.Cobra().MarkFlagRequired("to-zone"))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("tier", cobra.FixedCompletions(tiers, cobra.ShellCompDirectiveNoFileComp)))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("title", cobra.NoFileCompletions))
+}
+
+func (s *copyCommand) InitCommandWithConfig(cfg *config.Config) {
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("to-zone", namedargs.CompletionFunc(completion.Zone{}, cfg)))
+}
+
+// MaximumExecutions implements command.Command
+func (s *copyCommand) MaximumExecutions() int {
+	return maxStorageActions
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *copyCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	source, err := exec.Storage().GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	isTemplate := source.Type == upcloud.StorageTypeTemplate
+	if source.Access != upcloud.StorageAccessPrivate || (source.Type != upcloud.StorageTypeNormal && !isTemplate) {
+		return nil, fmt.Errorf("storage %s is a %s %s storage, only normal storages and private templates can be copied", uuid, source.Access, source.Type)
+	}
+
+	zones := []string{}
+	for _, zone := range s.zones {
+		if !slices.Contains(zones, zone) {
+			zones = append(zones, zone)
+		}
+	}
+
+	outputs := commands.ExecuteInParallel(exec, zones, maxCopyZones, func(exec commands.Executor, zone string) (output.Output, error) {
+		return s.copyToZone(exec, source, zone)
+	})
+
+	results := make(map[string]copyResult)
+	failed := 0
+	for _, out := range outputs {
+		switch typed := out.(type) {
+		case output.OnlyMarshaled:
+			if result, ok := typed.Value.(copyResult); ok {
+				results[result.Zone] = result
+			}
+		case output.Error:
+			failed++
+			results[typed.Original] = copyResult{Zone: typed.Original, Error: typed.Value.Error()}
+		}
+	}
+
+	values := []copyResult{}
+	rows := []output.TableRow{}
+	for _, zone := range zones {
+		result := results[zone]
+		values = append(values, result)
+		if result.Storage != nil {
+			rows = append(rows, output.TableRow{zone, result.Storage.UUID, result.Storage.Type, result.Storage.State, ""})
+		} else {
+			rows = append(rows, output.TableRow{zone, "", "", "", result.Error})
+		}
+	}
+
+	var out output.Output = output.MarshaledWithHumanOutput{
+		Value: values,
+		Output: output.Table{
+			Columns: []output.TableColumn{
+				{Key: "zone", Header: "Zone"},
+				{Key: "uuid", Header: "UUID", Colour: ui.DefaultUUUIDColours},
+				{Key: "type", Header: "Type"},
+				{Key: "state", Header: "State", Format: format.StorageState},
+				{Key: "error", Header: "Error"},
+			},
+			Rows: rows,
+		},
+	}
+	if failed > 0 {
+		out = output.WithError{Output: out, Err: &clierrors.CommandFailedError{FailedCount: failed}}
+	}
+	return out, nil
+}
+
+// copyToZone copies the source storage to given zone. Templates are copied by cloning the template, templatising the clone, and deleting the clone.
+func (s *copyCommand) copyToZone(exec commands.Executor, source *upcloud.StorageDetails, zone string) (output.Output, error) {
+	svc := exec.Storage()
+	msg := fmt.Sprintf("Copying storage %v to %v", source.UUID, zone)
+	exec.PushProgressStarted(msg)
+
+	title := s.title
+	if title == "" {
+		title = source.Title
+	}
+	tier := s.tier
+	if tier == "" {
+		tier = source.Tier
+	}
+
+	clone, err := svc.CloneStorage(exec.Context(), &request.CloneStorageRequest{
+		UUID:  source.UUID,
+		Zone:  zone,
+		Tier:  tier,
+		Title: title,
+	})
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+
+	res := clone
+	if source.Type == upcloud.StorageTypeTemplate {
+		exec.PushProgressUpdateMessage(msg, fmt.Sprintf("%s: cloning template", msg))
+		if err := waitForStorageOnline(exec, clone.UUID, msg, copyWaitTimeout); err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("template clone %s was left in zone %s: %w", clone.UUID, zone, err))
+		}
+
+		exec.PushProgressUpdateMessage(msg, fmt.Sprintf("%s: templatising clone %s", msg, clone.UUID))
+		res, err = svc.TemplatizeStorage(exec.Context(), &request.TemplatizeStorageRequest{UUID: clone.UUID, Title: title})
+		if err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("template clone %s was left in zone %s: %w", clone.UUID, zone, err))
+		}
+
+		// The clone can only be deleted after the template has been created from it.
+		if err := waitForStorageOnline(exec, res.UUID, msg, copyWaitTimeout); err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("template clone %s was left in zone %s: %w", clone.UUID, zone, err))
+		}
+		if err := svc.DeleteStorage(exec.Context(), &request.DeleteStorageRequest{UUID: clone.UUID}); err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("template %s was created, but deleting template clone %s failed: %w", res.UUID, clone.UUID, err))
+		}
+	} else if s.wait.Value() {
+		if err := waitForStorageOnline(exec, res.UUID, msg, copyWaitTimeout); err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+
+	if s.wait.Value() || source.Type == upcloud.StorageTypeTemplate {
+		res, err = svc.GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: res.UUID})
+		if err != nil {
+			return commands.HandleError(exec, msg, err)
+		}
+	}
+
+	exec.PushProgressUpdateMessage(msg, msg)
+	exec.PushProgressSuccess(msg)
+
+	return output.OnlyMarshaled{Value: copyResult{Zone: zone, Storage: res}}, nil
+}
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCopyCommand(t *testing.T) {
+	for _, test := range []struct {
+		name            string
+		storageType     string
+		access          string
+		args            []string
+		failZone        string
+		expected        []string
+		expectedError   string
+		templatizeCalls int
+		deleteCalls     int
+		waitCalls       int
+	}{
+		{
+			name:        "storage to two zones",
+			storageType: upcloud.StorageTypeNormal,
+			access:      upcloud.StorageAccessPrivate,
+			args:        []string{"--to-zone", "de-fra1", "--to-zone", "nl-ams1", "--to-zone", "de-fra1"},
+			expected:    []string{`"zone": "de-fra1"`, `"zone": "nl-ams1"`, `"uuid": "copy-de-fra1"`, `"uuid": "copy-nl-ams1"`},
+		},
+		{
+			name:        "storage with wait",
+			storageType: upcloud.StorageTypeNormal,
+			access:      upcloud.StorageAccessPrivate,
+			args:        []string{"--to-zone", "de-fra1", "--wait"},
+			expected:    []string{`"uuid": "copy-de-fra1"`},
+			waitCalls:   1,
+		},
+		{
+			name:            "template",
+			storageType:     upcloud.StorageTypeTemplate,
+			access:          upcloud.StorageAccessPrivate,
+			args:            []string{"--to-zone", "de-fra1", "--to-zone", "nl-ams1"},
+			expected:        []string{`"uuid": "template-copy-de-fra1"`, `"uuid": "template-copy-nl-ams1"`},
+			templatizeCalls: 2,
+			deleteCalls:     2,
+			waitCalls:       4,
+		},
+		{
+			name:          "one zone fails",
+			storageType:   upcloud.StorageTypeNormal,
+			access:        upcloud.StorageAccessPrivate,
+			args:          []string{"--to-zone", "de-fra1", "--to-zone", "nl-ams1"},
+			failZone:      "nl-ams1",
+			expected:      []string{`"uuid": "copy-de-fra1"`, `"error": "zone not available"`},
+			expectedError: "Command execution failed for 1 resource(s)",
+		},
+		{
+			name:          "public template",
+			storageType:   upcloud.StorageTypeTemplate,
+			access:        upcloud.StorageAccessPublic,
+			args:          []string{"--to-zone", "de-fra1"},
+			expectedError: fmt.Sprintf("storage %s is a public template storage, only normal storages and private templates can be copied", UUID1),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			CachedStorages = nil
+			source := upcloud.Storage{
+				UUID:   UUID1,
+				Title:  Title1,
+				Type:   test.storageType,
+				Access: test.access,
+				Zone:   "fi-hel1",
+				Tier:   upcloud.StorageTierMaxIOPS,
+			}
+
+			mService := new(smock.Service)
+			mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: UUID1}).Return(&upcloud.StorageDetails{Storage: source}, nil)
+			mService.On("CloneStorage", mock.MatchedBy(func(r *request.CloneStorageRequest) bool {
+				return r.Zone == test.failZone
+			})).Return(nil, fmt.Errorf("zone not available"))
+			for _, zone := range []string{"de-fra1", "nl-ams1"} {
+				clone := upcloud.Storage{UUID: "copy-" + zone, Type: upcloud.StorageTypeNormal, Zone: zone, State: upcloud.StorageStateMaintenance}
+				template := upcloud.Storage{UUID: "template-copy-" + zone, Type: upcloud.StorageTypeTemplate, Zone: zone, State: upcloud.StorageStateOnline}
+
+				mService.On("CloneStorage", &request.CloneStorageRequest{UUID: UUID1, Zone: zone, Tier: upcloud.StorageTierMaxIOPS, Title: Title1}).Return(&upcloud.StorageDetails{Storage: clone}, nil)
+				mService.On("TemplatizeStorage", &request.TemplatizeStorageRequest{UUID: clone.UUID, Title: Title1}).Return(&upcloud.StorageDetails{Storage: template}, nil)
+				mService.On("DeleteStorage", &request.DeleteStorageRequest{UUID: clone.UUID}).Return(nil)
+
+				clone.State = upcloud.StorageStateOnline
+				for _, storage := range []upcloud.Storage{clone, template} {
+					mService.On("WaitForStorageState", &request.WaitForStorageStateRequest{UUID: storage.UUID, DesiredState: upcloud.StorageStateOnline}).Return(&upcloud.StorageDetails{Storage: storage}, nil)
+					mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: storage.UUID}).Return(&upcloud.StorageDetails{Storage: storage}, nil)
+				}
+			}
+
+			conf := config.New()
+			conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+			c := commands.BuildCommand(CopyCommand(), nil, conf)
+			c.Cobra().SetArgs(append([]string{UUID1}, test.args...))
+			out, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			for _, expected := range test.expected {
+				assert.Contains(t, out, expected)
+			}
+			mService.AssertNumberOfCalls(t, "TemplatizeStorage", test.templatizeCalls)
+			mService.AssertNumberOfCalls(t, "DeleteStorage", test.deleteCalls)
+			mService.AssertNumberOfCalls(t, "WaitForStorageState", test.waitCalls)
+		})
+	}
+}
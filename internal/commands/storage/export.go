@@ -0,0 +1,322 @@
+package storage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	osexec "os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/spf13/cobra"
+)
+
+const (
+	exportCompressionNone = "none"
+	exportCompressionGzip = "gzip"
+	exportCompressionXZ   = "xz"
+)
+
+// ExportCommand creates the "storage export" command
+func ExportCommand() commands.Command {
+	return &exportCommand{
+		BaseCommand: commands.New(
+			"export",
+			"Export a storage to a local disk image file",
+			"upctl storage export 015899e0-0a68-4949-85bb-261a99de5fdd --target disk.raw",
+			`upctl storage export "My Storage" --target disk.raw.gz`,
+			`upctl storage export "My Storage" --target disk.raw.xz --helper-plan 2xCPU-4GB`,
+		),
+	}
+}
+
+type exportCommand struct {
+	*commands.BaseCommand
+	resolver.CachingStorage
+	completion.Storage
+	target     string
+	helperPlan string
+	helperOS   string
+}
+
+type exportResult struct {
+	StorageUUID string `json:"storage_uuid"`
+	Target      string `json:"target"`
+	Compression string `json:"compression"`
+	Size        int64  `json:"size"`
+	SHA256      string `json:"sha256"`
+}
+
+// InitCommand implements Command.InitCommand
+func (s *exportCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Export a storage to a local disk image file
+
+The storage is cloned and the clone is attached to a temporary helper server in the same zone as the storage. The disk image is downloaded from the helper server over SSH using an ephemeral key. The UUIDs of the clone and the helper server are shown before the download starts. The clone and the helper server are deleted after the export, also if the export fails or is interrupted with Ctrl+C. If deleting them fails, they need to be deleted manually.
+
+The format of the target file is defined by its extension: ` + "`.raw` or `.img`" + ` for an uncompressed raw image, ` + "`.gz`" + ` for a gzip compressed and ` + "`.xz`" + ` for a xz compressed raw image. Exporting to a xz compressed file requires the ` + "`xz`" + ` command to be installed. The exported file can be imported back with ` + "`upctl storage import`" + `.
+
+The SHA-256 checksum of the downloaded raw image is compared to the checksum calculated in the helper server before the target file is created.`)
+
+	fs := s.Cobra().Flags()
+	fs.StringVar(&s.target, "target", "", "Path of the disk image file to create. Supported extensions: .raw, .img, .gz, and .xz.")
+	fs.StringVar(&s.helperPlan, "helper-plan", "1xCPU-1GB", "Plan of the temporary helper server.")
+	fs.StringVar(&s.helperOS, "helper-os", "Ubuntu Server 24.04 LTS (Noble Numbat)", "Template of the temporary helper server. Must be a cloud-init template that provides sudo, dd, and sha256sum.")
+
+	commands.Must(s.Cobra().MarkFlagRequired("target"))
+	commands.Must(s.Cobra().MarkFlagFilename("target", "raw", "img", "gz", "xz"))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("helper-plan", cobra.NoFileCompletions))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("helper-os", cobra.NoFileCompletions))
+}
+
+// ExecuteSingleArgument implements commands.SingleArgumentCommand
+func (s *exportCommand) ExecuteSingleArgument(exec commands.Executor, uuid string) (output.Output, error) {
+	compression, err := exportCompression(s.target)
+	if err != nil {
+		return nil, err
+	}
+	if compression == exportCompressionXZ {
+		if _, err := osexec.LookPath("xz"); err != nil {
+			return nil, fmt.Errorf("xz command is required for exporting to .xz files: %w", err)
+		}
+	}
+	if _, err := os.Stat(s.target); err == nil {
+		return nil, fmt.Errorf("target file %s already exists", s.target)
+	}
+
+	source, err := exec.Storage().GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	signer, authorizedKey, err := newHelperKey()
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate SSH key for helper server: %w", err)
+	}
+
+	// Handle interrupts in the command instead of the executor, which would exit immediately, so that the temporary resources are deleted also when the export is interrupted.
+	exec.StopInterruptHandler()
+	ctx, stop := signal.NotifyContext(exec.Context(), os.Interrupt)
+	defer stop()
+
+	export := &storageExport{ctx: ctx, exec: exec, source: source}
+	defer export.cleanup()
+
+	if err := export.cloneSource(); err != nil {
+		return nil, err
+	}
+
+	address, err := export.createHelper(s.helperPlan, s.helperOS, authorizedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := fmt.Sprintf("Exporting storage %s to %s", uuid, s.target)
+	exec.PushProgressStarted(msg)
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Connecting to helper server %s", address))
+
+	conn, err := dialHelper(ctx, address, signer)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+	defer conn.Close()
+
+	exec.PushProgressUpdateMessage(msg, msg)
+	res, err := downloadExport(ctx, exec, msg, conn, int64(source.Size)*1024*1024*1024, s.target, compression)
+	if err != nil {
+		return commands.HandleError(exec, msg, err)
+	}
+	res.StorageUUID = uuid
+
+	exec.PushProgressSuccess(msg)
+
+	return output.MarshaledWithHumanDetails{
+		Value: res,
+		Details: []output.DetailRow{
+			{Title: "Storage UUID", Value: res.StorageUUID, Colour: ui.DefaultUUUIDColours},
+			{Title: "Target", Value: res.Target},
+			{Title: "Compression", Value: res.Compression},
+			{Title: "SHA-256", Value: res.SHA256},
+		},
+	}, nil
+}
+
+func exportCompression(target string) (string, error) {
+	switch ext := filepath.Ext(target); ext {
+	case ".raw", ".img":
+		return exportCompressionNone, nil
+	case ".gz":
+		return exportCompressionGzip, nil
+	case ".xz":
+		return exportCompressionXZ, nil
+	default:
+		return "", fmt.Errorf("unsupported target file extension %q, use .raw, .img, .gz, or .xz", ext)
+	}
+}
+
+type writerCounter struct {
+	written atomic.Int64
+}
+
+// Write implements io.Writer
+func (w *writerCounter) Write(p []byte) (int, error) {
+	w.written.Add(int64(len(p)))
+	return len(p), nil
+}
+
+// downloadExport streams the attached clone from the helper server to the target file and verifies its checksum. The file is written with .part suffix and renamed to target only after the checksum has been verified.
+func downloadExport(ctx context.Context, exec commands.Executor, msg string, conn helperConnection, size int64, target, compression string) (*exportResult, error) {
+	partial := target + ".part"
+	file, err := os.OpenFile(partial, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600) //gosec:disable G304 -- target path is explicit CLI input
+	if err != nil {
+		return nil, fmt.Errorf("cannot create target file: %w", err)
+	}
+
+	success := false
+	defer func() {
+		if !success {
+			_ = os.Remove(partial)
+		}
+	}()
+
+	compressor, err := newCompressor(ctx, compression, file)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	hash := sha256.New()
+	counter := &writerCounter{}
+
+	done := make(chan struct{})
+	go reportExportProgress(exec, msg, size, counter, done)
+
+	err = conn.Run(ctx, fmt.Sprintf("sudo -n dd if=%s bs=4M status=none", exportHelperDevice), io.MultiWriter(compressor, hash, counter))
+	close(done)
+	if closeErr := compressor.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot download storage: %w", err)
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Verifying SHA-256 checksum of %s", target))
+
+	var remote bytes.Buffer
+	if err := conn.Run(ctx, fmt.Sprintf("sudo -n sha256sum %s", exportHelperDevice), &remote); err != nil {
+		return nil, fmt.Errorf("cannot calculate checksum in helper server: %w", err)
+	}
+	fields := strings.Fields(remote.String())
+	if len(fields) == 0 || fields[0] != checksum {
+		return nil, fmt.Errorf("checksum mismatch: downloaded image has SHA-256 checksum %s, storage has %q", checksum, strings.TrimSpace(remote.String()))
+	}
+
+	if err := os.Rename(partial, target); err != nil {
+		return nil, fmt.Errorf("cannot rename target file: %w", err)
+	}
+	success = true
+	exec.PushProgressUpdateMessage(msg, msg)
+
+	return &exportResult{
+		Target:      target,
+		Compression: compression,
+		Size:        counter.written.Load(),
+		SHA256:      checksum,
+	}, nil
+}
+
+func reportExportProgress(exec commands.Executor, msg string, size int64, counter *writerCounter, done <-chan struct{}) {
+	startTime := time.Now()
+	updateTicker := time.NewTicker(300 * time.Millisecond)
+	defer updateTicker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-updateTicker.C:
+			written := counter.written.Load()
+			bps := float64(written) / time.Since(startTime).Seconds()
+			exec.PushProgressUpdate(messages.Update{
+				Key: msg,
+				ProgressMessage: fmt.Sprintf(
+					"- downloaded %.2f%% (%sBps)",
+					float64(written)/float64(size)*100,
+					ui.AbbrevNumBinaryPrefix(uint(bps)),
+				),
+			})
+		}
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+// Close implements io.Closer
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// commandWriter pipes written data to the standard input of a command.
+type commandWriter struct {
+	cmd    *osexec.Cmd
+	stdin  io.WriteCloser
+	stderr bytes.Buffer
+}
+
+// Write implements io.Writer
+func (w *commandWriter) Write(p []byte) (int, error) {
+	return w.stdin.Write(p)
+}
+
+// Close implements io.Closer
+func (w *commandWriter) Close() error {
+	_ = w.stdin.Close()
+	if err := w.cmd.Wait(); err != nil {
+		return fmt.Errorf("%s: %w", strings.TrimSpace(w.stderr.String()), err)
+	}
+	return nil
+}
+
+func newCompressor(ctx context.Context, compression string, w io.Writer) (io.WriteCloser, error) {
+	switch compression {
+	case exportCompressionGzip:
+		return gzip.NewWriter(w), nil
+	case exportCompressionXZ:
+		cw := &commandWriter{cmd: osexec.CommandContext(ctx, "xz", "--compress", "--stdout", "--threads=0")}
+		cw.cmd.Stdout = w
+		cw.cmd.Stderr = &cw.stderr
+
+		stdin, err := cw.cmd.StdinPipe()
+		if err != nil {
+			return nil, err
+		}
+		cw.stdin = stdin
+
+		if err := cw.cmd.Start(); err != nil {
+			return nil, fmt.Errorf("cannot start xz: %w", err)
+		}
+		return cw, nil
+	default:
+		return nopWriteCloser{w}, nil
+	}
+}
@@ -0,0 +1,194 @@
+package storage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+type fakeHelperConnection struct {
+	image     []byte
+	checksum  string
+	interrupt bool
+}
+
+func (c *fakeHelperConnection) Run(ctx context.Context, cmd string, stdout io.Writer) error {
+	switch {
+	case strings.Contains(cmd, "dd if=/dev/vdb") && c.interrupt:
+		// Simulate Ctrl+C during the download.
+		process, err := os.FindProcess(os.Getpid())
+		if err != nil {
+			return err
+		}
+		if err := process.Signal(os.Interrupt); err != nil {
+			return err
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	case strings.Contains(cmd, "dd if=/dev/vdb"):
+		_, err := stdout.Write(c.image)
+		return err
+	case strings.Contains(cmd, "sha256sum /dev/vdb"):
+		_, err := fmt.Fprintf(stdout, "%s  /dev/vdb\n", c.checksum)
+		return err
+	}
+	return fmt.Errorf("unexpected command %q", cmd)
+}
+
+func (c *fakeHelperConnection) Close() error {
+	return nil
+}
+
+func TestExportCommand(t *testing.T) {
+	image := bytes.Repeat([]byte("upctl"), 1024)
+	sum := sha256.Sum256(image)
+	checksum := hex.EncodeToString(sum[:])
+
+	source := upcloud.Storage{
+		UUID:  UUID1,
+		Title: Title1,
+		Type:  upcloud.StorageTypeNormal,
+		Zone:  "fi-hel1",
+		Size:  10,
+		Tier:  upcloud.StorageTierMaxIOPS,
+	}
+	template := upcloud.Storage{
+		UUID:  "01000000-0000-4000-8000-000030240200",
+		Title: "Ubuntu Server 24.04 LTS (Noble Numbat)",
+		Type:  upcloud.StorageTypeTemplate,
+		Size:  4,
+	}
+	clone := upcloud.Storage{UUID: "01e7a6e9-1c8b-4b4f-bb0d-21b9ea8d5f3b", Zone: "fi-hel1"}
+	helper := upcloud.ServerDetails{
+		Server: upcloud.Server{UUID: "00b5a3d6-7a2c-4c4a-9d9c-3c4fbbe8b0f1"},
+		IPAddresses: upcloud.IPAddressSlice{
+			{Access: upcloud.IPAddressAccessPublic, Family: upcloud.IPAddressFamilyIPv4, Address: "192.0.2.10"},
+		},
+	}
+
+	for _, test := range []struct {
+		name      string
+		target    string
+		checksum  string
+		interrupt bool
+		error     string
+	}{
+		{
+			name:     "raw",
+			target:   "disk.raw",
+			checksum: checksum,
+		},
+		{
+			name:     "gzip",
+			target:   "disk.raw.gz",
+			checksum: checksum,
+		},
+		{
+			name:     "checksum mismatch",
+			target:   "disk.raw",
+			checksum: strings.Repeat("0", 64),
+			error:    "checksum mismatch",
+		},
+		{
+			name:      "interrupted",
+			target:    "disk.raw",
+			checksum:  checksum,
+			interrupt: true,
+			error:     "context canceled",
+		},
+		{
+			name:   "unsupported extension",
+			target: "disk.qcow2",
+			error:  `unsupported target file extension ".qcow2", use .raw, .img, .gz, or .xz`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if test.interrupt && runtime.GOOS == "windows" {
+				t.Skip("sending interrupt signal is not supported on Windows")
+			}
+
+			CachedStorages = nil
+			target := filepath.Join(t.TempDir(), test.target)
+
+			originalDialHelper := dialHelper
+			dialHelper = func(_ context.Context, address string, _ ssh.Signer) (helperConnection, error) {
+				assert.Equal(t, "192.0.2.10", address)
+				return &fakeHelperConnection{image: image, checksum: test.checksum, interrupt: test.interrupt}, nil
+			}
+			t.Cleanup(func() { dialHelper = originalDialHelper })
+
+			mService := smock.Service{}
+			mService.On("GetStorages", &request.GetStoragesRequest{}).Return(&upcloud.Storages{Storages: []upcloud.Storage{source, template}}, nil)
+			mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: source.UUID}).Return(&upcloud.StorageDetails{Storage: source}, nil)
+			mService.On("CloneStorage", &request.CloneStorageRequest{
+				UUID:  source.UUID,
+				Zone:  source.Zone,
+				Tier:  source.Tier,
+				Title: "upctl-export-" + source.Title,
+			}).Return(&upcloud.StorageDetails{Storage: clone}, nil)
+			mService.On("WaitForStorageState", mock.Anything).Return(&upcloud.StorageDetails{Storage: clone}, nil)
+			mService.On("GetPlans", mock.Anything).Return(&upcloud.Plans{Plans: []upcloud.Plan{{Name: "1xCPU-1GB", StorageSize: 25}}}, nil)
+			mService.On("CreateServer", mock.MatchedBy(func(r *request.CreateServerRequest) bool {
+				return len(r.StorageDevices) == 2 &&
+					r.StorageDevices[0].Storage == template.UUID && r.StorageDevices[0].Size == 25 &&
+					r.StorageDevices[1].Storage == clone.UUID &&
+					r.LoginUser != nil && len(r.LoginUser.SSHKeys) == 1
+			})).Return(&helper, nil)
+			mService.On("WaitForServerState", &request.WaitForServerStateRequest{UUID: helper.UUID, DesiredState: upcloud.ServerStateStarted}).Return(&helper, nil)
+			mService.On("StopServer", &request.StopServerRequest{UUID: helper.UUID, StopType: request.ServerStopTypeHard}).Return(&helper, nil)
+			mService.On("WaitForServerState", &request.WaitForServerStateRequest{UUID: helper.UUID, DesiredState: upcloud.ServerStateStopped}).Return(&helper, nil)
+			mService.On("DeleteServerAndStorages", &request.DeleteServerAndStoragesRequest{UUID: helper.UUID}).Return(nil)
+
+			conf := config.New()
+			c := commands.BuildCommand(ExportCommand(), nil, conf)
+			c.Cobra().SetArgs([]string{source.UUID, "--target", target})
+			_, err := mockexecute.MockExecute(c, &mService, conf)
+
+			_, partialErr := os.Stat(target + ".part")
+			assert.ErrorIs(t, partialErr, os.ErrNotExist)
+			if test.checksum != "" {
+				mService.AssertNumberOfCalls(t, "DeleteServerAndStorages", 1)
+			}
+
+			if test.error != "" {
+				assert.ErrorContains(t, err, test.error)
+				_, statErr := os.Stat(target)
+				assert.ErrorIs(t, statErr, os.ErrNotExist)
+				return
+			}
+
+			require.NoError(t, err)
+
+			content, err := os.ReadFile(target)
+			require.NoError(t, err)
+			if strings.HasSuffix(target, ".gz") {
+				r, err := gzip.NewReader(bytes.NewReader(content))
+				require.NoError(t, err)
+				content, err = io.ReadAll(r)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, image, content)
+		})
+	}
+}
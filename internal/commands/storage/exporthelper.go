@@ -0,0 +1,337 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	exportHelperUsername = "upctl"
+	// exportHelperDevice is the device the cloned storage is attached to in the helper server. The OS storage is the first and the clone the second virtio device.
+	exportHelperDevice = "/dev/vdb"
+	exportWaitTimeout  = 15 * time.Minute
+	exportDialTimeout  = 5 * time.Minute
+)
+
+// helperConnection runs commands in the export helper server.
+type helperConnection interface {
+	// Run runs the command and writes its standard output to stdout.
+	Run(ctx context.Context, cmd string, stdout io.Writer) error
+	Close() error
+}
+
+// dialHelper connects to the export helper server. It is a variable to allow replacing the connection in tests.
+var dialHelper = func(ctx context.Context, address string, signer ssh.Signer) (helperConnection, error) {
+	config := &ssh.ClientConfig{
+		User: exportHelperUsername,
+		Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		// The host key of the helper server is generated on first boot, so there is no known key to compare to.
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(), //gosec:disable G106 -- the helper server was just created by this command and is deleted after the export
+		Timeout:         10 * time.Second,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, exportDialTimeout)
+	defer cancel()
+
+	// The SSH server might not be running or the login user might not exist yet, so retry until the connection succeeds.
+	for {
+		client, err := ssh.Dial("tcp", net.JoinHostPort(address, "22"), config)
+		if err == nil {
+			return &sshConnection{client: client}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("cannot connect to helper server: %w", err)
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+type sshConnection struct {
+	client *ssh.Client
+}
+
+// Run implements helperConnection.Run
+func (c *sshConnection) Run(ctx context.Context, cmd string, stdout io.Writer) error {
+	session, err := c.client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	var stderr bytes.Buffer
+	session.Stdout = stdout
+	session.Stderr = &stderr
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			session.Close()
+		case <-done:
+		}
+	}()
+
+	if err := session.Run(cmd); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s: %w", msg, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// Close implements helperConnection.Close
+func (c *sshConnection) Close() error {
+	return c.client.Close()
+}
+
+// newHelperKey generates an ephemeral SSH key for connecting to the helper server.
+func newHelperKey() (ssh.Signer, string, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, "", err
+	}
+
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey())))
+	return signer, authorizedKey + " upctl-storage-export", nil
+}
+
+// storageExport keeps track of the temporary resources created during an export, so that they can be cleaned up afterwards.
+type storageExport struct {
+	// ctx is cancelled when the export is interrupted. It is used instead of the context of the executor, which is not cancelled as the command handles interrupts itself.
+	ctx        context.Context
+	exec       commands.Executor
+	source     *upcloud.StorageDetails
+	cloneUUID  string
+	serverUUID string
+}
+
+// cloneSource clones the exported storage to be able to attach it to the helper server without affecting the source.
+func (e *storageExport) cloneSource() error {
+	msg := fmt.Sprintf("Cloning storage %s for export", e.source.UUID)
+	e.exec.PushProgressStarted(msg)
+
+	tier := e.source.Tier
+	if tier == "" {
+		tier = upcloud.StorageTierMaxIOPS
+	}
+
+	clone, err := e.exec.Storage().CloneStorage(e.ctx, &request.CloneStorageRequest{
+		UUID:  e.source.UUID,
+		Zone:  e.source.Zone,
+		Tier:  tier,
+		Title: fmt.Sprintf("upctl-export-%s", ui.TruncateText(e.source.Title, 64-13)),
+	})
+	if err != nil {
+		_, _ = commands.HandleError(e.exec, msg, err)
+		return err
+	}
+	e.cloneUUID = clone.UUID
+
+	e.exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for temporary storage %s to be in %s state", clone.UUID, upcloud.StorageStateOnline))
+	ctx, cancel := context.WithTimeout(e.ctx, exportWaitTimeout)
+	defer cancel()
+
+	if _, err := e.exec.All().WaitForStorageState(ctx, &request.WaitForStorageStateRequest{
+		UUID:         clone.UUID,
+		DesiredState: upcloud.StorageStateOnline,
+	}); err != nil {
+		_, _ = commands.HandleError(e.exec, msg, err)
+		return err
+	}
+
+	// Keep the UUID of the clone in the progress log, so that it can be deleted manually if the cleanup fails.
+	e.exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Cloned storage %s to temporary storage %s for export", e.source.UUID, clone.UUID))
+	e.exec.PushProgressSuccess(msg)
+	return nil
+}
+
+// createHelper creates a server that has the cloned storage attached as a secondary disk and returns its public IPv4 address.
+func (e *storageExport) createHelper(plan, osTemplate, authorizedKey string) (string, error) {
+	msg := fmt.Sprintf("Creating helper server for exporting storage %s", e.source.UUID)
+	e.exec.PushProgressStarted(msg)
+
+	template, err := SearchSingleStorage(osTemplate, e.exec)
+	if err != nil {
+		_, _ = commands.HandleError(e.exec, msg, err)
+		return "", err
+	}
+
+	size := template.Size
+	plans, err := e.exec.All().GetPlans(e.ctx)
+	if err != nil {
+		_, _ = commands.HandleError(e.exec, msg, err)
+		return "", err
+	}
+	for _, p := range plans.Plans {
+		if p.Name == plan && p.StorageSize > size {
+			size = p.StorageSize
+		}
+	}
+
+	server, err := e.exec.All().CreateServer(e.ctx, &request.CreateServerRequest{
+		Zone:             e.source.Zone,
+		Title:            fmt.Sprintf("upctl storage export helper for %s", e.source.UUID),
+		Hostname:         "upctl-export-helper",
+		Plan:             plan,
+		Metadata:         upcloud.True,
+		PasswordDelivery: request.PasswordDeliveryNone,
+		LoginUser: &request.LoginUser{
+			Username:       exportHelperUsername,
+			CreatePassword: "no",
+			SSHKeys:        []string{authorizedKey},
+		},
+		StorageDevices: []request.CreateServerStorageDevice{
+			{
+				Action:  "clone",
+				Address: "virtio",
+				Storage: template.UUID,
+				Title:   "upctl-export-helper-OS",
+				Size:    size,
+				Type:    upcloud.StorageTypeDisk,
+			},
+			{
+				Action:  "attach",
+				Address: "virtio",
+				Storage: e.cloneUUID,
+				Type:    upcloud.StorageTypeDisk,
+			},
+		},
+		Networking: &request.CreateServerNetworking{
+			Interfaces: []request.CreateServerInterface{{
+				Type:        upcloud.NetworkTypePublic,
+				IPAddresses: []request.CreateServerIPAddress{{Family: upcloud.IPAddressFamilyIPv4}},
+			}},
+		},
+	})
+	if err != nil {
+		_, _ = commands.HandleError(e.exec, msg, err)
+		return "", err
+	}
+	e.serverUUID = server.UUID
+
+	e.exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Waiting for server %s to be in %s state", server.UUID, upcloud.ServerStateStarted))
+	ctx, cancel := context.WithTimeout(e.ctx, exportWaitTimeout)
+	defer cancel()
+
+	details, err := e.exec.All().WaitForServerState(ctx, &request.WaitForServerStateRequest{
+		UUID:         server.UUID,
+		DesiredState: upcloud.ServerStateStarted,
+	})
+	if err != nil {
+		_, _ = commands.HandleError(e.exec, msg, err)
+		return "", err
+	}
+
+	address := publicIPv4Address(details)
+	if address == "" {
+		address = publicIPv4Address(server)
+	}
+	if address == "" {
+		err := fmt.Errorf("helper server %s does not have a public IPv4 address", server.UUID)
+		_, _ = commands.HandleError(e.exec, msg, err)
+		return "", err
+	}
+
+	// Keep the UUID of the helper server in the progress log, so that it can be deleted manually if the cleanup fails.
+	e.exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Created temporary helper server %s for exporting storage %s", server.UUID, e.source.UUID))
+	e.exec.PushProgressSuccess(msg)
+	return address, nil
+}
+
+func publicIPv4Address(server *upcloud.ServerDetails) string {
+	for _, ip := range server.IPAddresses {
+		if ip.Access == upcloud.IPAddressAccessPublic && ip.Family == upcloud.IPAddressFamilyIPv4 {
+			return ip.Address
+		}
+	}
+	for _, iface := range server.Networking.Interfaces {
+		if iface.Type != upcloud.NetworkTypePublic {
+			continue
+		}
+		for _, ip := range iface.IPAddresses {
+			if ip.Family == upcloud.IPAddressFamilyIPv4 {
+				return ip.Address
+			}
+		}
+	}
+	return ""
+}
+
+// cleanup deletes the helper server and the cloned storage. Cleanup is done even if the export has been interrupted.
+func (e *storageExport) cleanup() {
+	if e.serverUUID == "" && e.cloneUUID == "" {
+		return
+	}
+
+	msg := fmt.Sprintf("Deleting temporary resources of storage %s export", e.source.UUID)
+	e.exec.PushProgressStarted(msg)
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(e.ctx), exportWaitTimeout)
+	defer cancel()
+
+	var err error
+	leftovers := fmt.Sprintf("storage %s", e.cloneUUID)
+	if e.serverUUID != "" {
+		err = e.deleteHelper(ctx)
+		leftovers = fmt.Sprintf("server %s and its storages", e.serverUUID)
+	} else {
+		err = e.exec.Storage().DeleteStorage(ctx, &request.DeleteStorageRequest{UUID: e.cloneUUID})
+	}
+
+	if err != nil {
+		e.exec.PushProgressUpdate(messages.Update{
+			Key:     msg,
+			Message: msg,
+			Status:  messages.MessageStatusWarning,
+			Details: fmt.Sprintf("Error: %s. Delete %s manually.", err.Error(), leftovers),
+		})
+		return
+	}
+
+	e.exec.PushProgressSuccess(msg)
+}
+
+// deleteHelper stops the helper server and deletes it with its storages, including the clone.
+func (e *storageExport) deleteHelper(ctx context.Context) error {
+	if _, err := e.exec.All().StopServer(ctx, &request.StopServerRequest{
+		UUID:     e.serverUUID,
+		StopType: request.ServerStopTypeHard,
+	}); err != nil {
+		return err
+	}
+
+	if _, err := e.exec.All().WaitForServerState(ctx, &request.WaitForServerStateRequest{
+		UUID:         e.serverUUID,
+		DesiredState: upcloud.ServerStateStopped,
+	}); err != nil {
+		return err
+	}
+
+	return e.exec.All().DeleteServerAndStorages(ctx, &request.DeleteServerAndStoragesRequest{
+		UUID: e.serverUUID,
+	})
+}
@@ -1,12 +1,16 @@
 package storage
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
 	"math"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -22,6 +26,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
@@ -58,8 +63,10 @@ type importCommand struct {
 
 	sourceLocation            string
 	existingStorageUUIDOrName string
+	sha256                    string
 	noWait                    config.OptionalBoolean
 	wait                      config.OptionalBoolean
+	noResume                  config.OptionalBoolean
 
 	createParams createParams
 
@@ -68,16 +75,27 @@ type importCommand struct {
 
 // InitCommand implements Command.InitCommand
 func (s *importCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Import a storage from external or local source
+
+Local qcow2, VMDK, and VHDX images are converted to raw format while uploading. The conversion requires ` + "`qemu-img`, `qemu-nbd`, and `nbdcopy`" + ` commands to be installed.
+
+The checksum of the uploaded data is compared to the SHA-256 checksum reported by the API after the import. For gzip and xz compressed files, the API verifies the compressed data when decompressing it instead. Use ` + "`--sha256`" + ` to also verify the source file against a known checksum. The checksum of compressed HTTP sources can not be verified.
+
+The state of an unfinished import is stored in the cache directory. If the import is interrupted, running the same command again reuses the storage created for the interrupted import, waits for an HTTP import that is still in progress, and skips an upload that the API already completed. An interrupted upload is started again from the beginning of the file.`)
+
 	flagSet := &pflag.FlagSet{}
 	flagSet.StringVar(&s.sourceLocation, "source-location", "", "Location of the source of the import. Can be a file or a URL.")
 	flagSet.StringVar(&s.existingStorageUUIDOrName, "storage", "", "Import to an existing storage. Storage must be large enough and must be undetached or the server where the storage is attached must be in shutdown state.")
+	flagSet.StringVar(&s.sha256, "sha256", "", "Expected SHA-256 checksum of the source. The import fails if the checksum does not match.")
 	config.AddToggleFlag(flagSet, &s.noWait, "no-wait", false, "When importing from remote url, do not wait until the import finishes or storage is in online state. If set, command will exit after import process has been initialized.")
 	config.AddToggleFlag(flagSet, &s.wait, "wait", false, "Wait for storage to be in online state before returning.")
+	config.AddToggleFlag(flagSet, &s.noResume, "no-resume", false, "Do not reuse the storage or import of an interrupted import of the same source, start a new import instead.")
 	applyCreateFlags(flagSet, &s.createParams, defaultCreateParams)
 
 	s.AddFlags(flagSet)
 	commands.Must(s.Cobra().MarkFlagRequired("source-location"))
-	commands.Must(s.Cobra().MarkFlagFilename("source-location", "raw", "img", "iso", "gz", "xz"))
+	commands.Must(s.Cobra().MarkFlagFilename("source-location", "raw", "img", "iso", "gz", "xz", "qcow2", "vmdk", "vhdx"))
+	commands.Must(s.Cobra().RegisterFlagCompletionFunc("sha256", cobra.NoFileCompletions))
 }
 
 func (s *importCommand) InitCommandWithConfig(cfg *config.Config) {
@@ -130,18 +148,65 @@ func (s *importCommand) ExecuteWithoutArguments(exec commands.Executor) (output.
 		return nil, fmt.Errorf("title and zone are not valid when using existing storage")
 	}
 
+	expectedSHA256, err := parseSHA256(s.sha256)
+	if err != nil {
+		return nil, err
+	}
+
 	// figure out sourcetype and validate the inputs to the best of our ability
 	parsedSource, sourceType, fileSize, err := parseSource(s.sourceLocation)
 	if err != nil {
 		return nil, err
 	}
 
+	// detect images that need to be converted to raw format and collect the details used to identify the source when resuming an import
+	state := &importState{
+		SourceLocation: s.sourceLocation,
+		Zone:           s.createParams.Zone,
+		Title:          s.createParams.Title,
+	}
+	imageFormat := ""
+	switch sourceType {
+	case upcloud.StorageImportSourceDirectUpload:
+		stat, err := os.Stat(parsedSource.Path) //gosec:disable G703 -- local source path is explicit CLI input
+		if err != nil {
+			return nil, err
+		}
+		if state.SourceLocation, err = filepath.Abs(parsedSource.Path); err != nil {
+			return nil, err
+		}
+		state.Size = stat.Size()
+		state.ModTime = stat.ModTime()
+
+		if imageFormat, err = detectImageFormat(parsedSource.Path); err != nil {
+			return nil, err
+		}
+		if imageFormat != "" {
+			// the storage needs to be large enough for the converted image
+			if fileSize, err = imageVirtualSize(exec.Context(), parsedSource.Path, imageFormat); err != nil {
+				return nil, err
+			}
+		}
+	case upcloud.StorageImportSourceHTTPImport:
+		if format := convertedImageFormatFromURL(parsedSource.Path); format != "" {
+			return nil, fmt.Errorf("%s images can only be imported from local files, download the image first", format)
+		}
+		if expectedSHA256 != "" && isCompressedSource(parsedSource.Path) {
+			return nil, fmt.Errorf("checksum of compressed HTTP sources can not be verified, download the file first or import without --sha256")
+		}
+	}
+
+	var resumed *importState
+	if !s.noResume.Value() {
+		resumed = loadImportState(state.SourceLocation, state.Size, state.ModTime)
+	}
+
 	// calculate filesize in gigabytes to validate storage sizes
 	// add one because we're rounding down with integer division, otherwise we could end up consistently
 	// creating too small storages to hold the file we want to upload
 	fileSizeInGB := int(fileSize/1024/1024/1024) + 1
 
-	// next, figure out if we want to import to an existing storage (and validate it) or create one
+	// next, figure out if we want to import to an existing storage (and validate it), resume an interrupted import, or create one
 	var storageToImportTo upcloud.Storage
 	if s.existingStorageUUIDOrName != "" {
 		storage, err := namedargs.GetStorage(exec, s.existingStorageUUIDOrName)
@@ -152,6 +217,8 @@ func (s *importCommand) ExecuteWithoutArguments(exec commands.Executor) (output.
 			return nil, fmt.Errorf("the existing storage is too small for the file")
 		}
 		storageToImportTo = storage
+	} else if storage, ok := getResumedStorage(exec, resumed, state, fileSizeInGB); ok {
+		storageToImportTo = storage
 	} else {
 		// We need to create a new storage.
 		// Infer created storage size from the file if default size is used
@@ -168,17 +235,59 @@ func (s *importCommand) ExecuteWithoutArguments(exec commands.Executor) (output.
 		storageToImportTo = createdStorage
 	}
 
-	// input has been validated and we have a storage to import to, ready to start the actual import
 	msg := fmt.Sprintf("Importing to %v", storageToImportTo.UUID)
+	if resumed != nil && resumed.StorageUUID == storageToImportTo.UUID {
+		msg = fmt.Sprintf("Resuming import to %v", storageToImportTo.UUID)
+		state.SourceSHA256 = resumed.SourceSHA256
+		state.UploadSHA256 = resumed.UploadSHA256
+	} else {
+		resumed = nil
+	}
+	state.StorageUUID = storageToImportTo.UUID
+
+	// input has been validated and we have a storage to import to, ready to start the actual import
 	exec.PushProgressStarted(msg)
 
+	// the checksum of converted images can not be compared to the checksum reported by the API, so verify the source file before uploading
+	if imageFormat != "" && expectedSHA256 != "" && state.SourceSHA256 != expectedSHA256 {
+		exec.PushProgressUpdateMessage(msg, fmt.Sprintf("Calculating SHA-256 checksum of %s", parsedSource.Path))
+		checksum, err := fileSHA256(parsedSource.Path)
+		if err != nil {
+			return commands.HandleError(exec, msg, fmt.Errorf("cannot calculate checksum of local file: %w", err))
+		}
+		if checksum != expectedSHA256 {
+			return commands.HandleError(exec, msg, fmt.Errorf("checksum mismatch: source file has SHA-256 checksum %s, expected %s", checksum, expectedSHA256))
+		}
+		state.SourceSHA256 = checksum
+		exec.PushProgressUpdateMessage(msg, msg)
+	}
+
+	// failing to store the state only prevents resuming the import, so the error is ignored
+	_ = state.save()
+
 	startTime := time.Now()
 	var (
 		statusChan   = make(chan storageImportStatus)
 		transferType string
+		uploadHash   hash.Hash
 	)
-	switch sourceType {
-	case upcloud.StorageImportSourceHTTPImport:
+	switch resumedImport := getResumedImport(exec, resumed, sourceType); {
+	case resumedImport != nil && resumedImport.State == upcloud.StorageImportStateCompleted:
+		// the previous import was completed, continue from verifying the checksum
+		go func() {
+			statusChan <- storageImportStatus{result: resumedImport, complete: true}
+			close(statusChan)
+		}()
+	case resumedImport != nil:
+		// the previous HTTP import is still in progress, continue polling its status
+		transferType = "download"
+		if s.noWait.Value() {
+			exec.PushProgressSuccess(msg)
+
+			return getImportSuccessOutput(resumedImport, storageToImportTo, s.existingStorageUUIDOrName == "")
+		}
+		go pollStorageImportStatus(exec, storageToImportTo.UUID, statusChan)
+	case sourceType == upcloud.StorageImportSourceHTTPImport:
 		// Import from the internet
 		transferType = "download"
 		result, err := exec.Storage().CreateStorageImport(exec.Context(), &request.CreateStorageImportRequest{
@@ -199,23 +308,59 @@ func (s *importCommand) ExecuteWithoutArguments(exec commands.Executor) (output.
 
 			return getImportSuccessOutput(result, storageToImportTo, s.existingStorageUUIDOrName == "")
 		}
-	case upcloud.StorageImportSourceDirectUpload:
+	case sourceType == upcloud.StorageImportSourceDirectUpload:
 		// import from local file
 		transferType = "upload"
-		sourceFile, err := os.Open(parsedSource.Path) //gosec:disable G703 -- local source path is explicit CLI input
+		var (
+			sourceFile  io.ReadCloser
+			contentType = localFileContentType(parsedSource.Path)
+		)
+		if imageFormat != "" {
+			exec.PushProgressUpdateMessage(msg, fmt.Sprintf("%s: converting %s image to raw format", msg, imageFormat))
+			sourceFile, err = convertToRaw(exec.Context(), parsedSource.Path, imageFormat)
+			contentType = "application/octet-stream"
+		} else {
+			sourceFile, err = os.Open(parsedSource.Path) //gosec:disable G703 -- local source path is explicit CLI input
+		}
 		if err != nil {
 			return commands.HandleError(exec, msg, fmt.Errorf("cannot open local file: %w", err))
 		}
-		go importLocalFile(exec, storageToImportTo.UUID, sourceFile, statusChan)
+		uploadHash = sha256.New()
+		go importLocalFile(exec, storageToImportTo.UUID, sourceFile, uploadHash, contentType, statusChan)
 	}
 
 	// import has been triggered, read updates from the process
-	for statusUpdate := range statusChan {
+	for {
+		statusUpdate, ok := <-statusChan
+		if !ok {
+			break
+		}
 		switch {
 		case statusUpdate.err != nil:
 			// we received an error, clean up log and return the error
 			return commands.HandleError(exec, msg, statusUpdate.err)
+		case statusUpdate.complete && uploadHash != nil:
+			// the upload has finished, store the checksum of the uploaded data to be able to skip the upload when resuming
+			state.UploadSHA256 = hex.EncodeToString(uploadHash.Sum(nil))
+			_ = state.save()
+			uploadHash = nil
+
+			// the uploaded data might still be processed, e.g. decompressed, after the upload has finished
+			if statusUpdate.result != nil && statusUpdate.result.State != upcloud.StorageImportStateCompleted {
+				statusChan = make(chan storageImportStatus)
+				go pollStorageImportStatus(exec, storageToImportTo.UUID, statusChan)
+				continue
+			}
+			fallthrough
 		case statusUpdate.complete:
+			if err := verifyImportChecksum(statusUpdate.result, state.UploadSHA256, expectedSHA256, imageFormat != "", isCompressedSource(parsedSource.Path)); err != nil {
+				// upload the data again when retrying
+				state.UploadSHA256 = ""
+				_ = state.save()
+				return commands.HandleError(exec, msg, err)
+			}
+			_ = state.remove()
+
 			// we're complete, clean up log and return the result
 			if s.wait.Value() {
 				waitForStorageState(storageToImportTo.UUID, upcloud.StorageStateOnline, exec, msg)
@@ -265,6 +410,72 @@ func (s *importCommand) ExecuteWithoutArguments(exec commands.Executor) (output.
 	return commands.HandleError(exec, msg, fmt.Errorf("upload aborted unexpectedly"))
 }
 
+// getResumedStorage returns the storage of an interrupted import, if the import was started with the same zone and title and the storage still exists.
+func getResumedStorage(exec commands.Executor, resumed, state *importState, minSize int) (upcloud.Storage, bool) {
+	if resumed == nil || resumed.Zone != state.Zone || resumed.Title != state.Title {
+		return upcloud.Storage{}, false
+	}
+
+	details, err := exec.Storage().GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: resumed.StorageUUID})
+	if err != nil || details.Size < minSize {
+		return upcloud.Storage{}, false
+	}
+	return details.Storage, true
+}
+
+// getResumedImport returns the import details of an interrupted import, if the import has been completed with the same data or if the HTTP import is still in progress. Otherwise, the import needs to be restarted and nil is returned.
+func getResumedImport(exec commands.Executor, resumed *importState, sourceType string) *upcloud.StorageImportDetails {
+	if resumed == nil {
+		return nil
+	}
+
+	details, err := exec.Storage().GetStorageImportDetails(exec.Context(), &request.GetStorageImportDetailsRequest{UUID: resumed.StorageUUID})
+	if err != nil || details.ErrorCode != "" {
+		return nil
+	}
+
+	switch {
+	case sourceType == upcloud.StorageImportSourceHTTPImport && details.Source == upcloud.StorageImportSourceHTTPImport && details.SourceLocation == resumed.SourceLocation:
+		if details.State == upcloud.StorageImportStateCancelled {
+			return nil
+		}
+		return details
+	case details.State == upcloud.StorageImportStateCompleted && resumed.UploadSHA256 != "" && strings.EqualFold(details.SHA256Sum, resumed.UploadSHA256):
+		return details
+	}
+	return nil
+}
+
+// verifyImportChecksum compares the checksum reported by the API to the checksum of the uploaded data and to the expected checksum given by the user.
+// The checksum reported for compressed sources is not compared to the checksum of the uploaded data, as the API might calculate it from the decompressed data. The integrity of compressed data is verified by the API when decompressing it.
+func verifyImportChecksum(result *upcloud.StorageImportDetails, uploaded, expected string, converted, compressed bool) error {
+	reported := ""
+	if result != nil {
+		reported = strings.ToLower(result.SHA256Sum)
+	}
+
+	if uploaded != "" && reported != "" && uploaded != reported && !compressed {
+		return fmt.Errorf("checksum mismatch: uploaded data has SHA-256 checksum %s, but the API reported %s", uploaded, reported)
+	}
+
+	// converted images are verified before uploading
+	if expected == "" || converted {
+		return nil
+	}
+
+	actual := uploaded
+	if actual == "" {
+		actual = reported
+	}
+	if actual == "" {
+		return fmt.Errorf("cannot verify checksum, the API did not report a checksum for the import")
+	}
+	if actual != expected {
+		return fmt.Errorf("checksum mismatch: imported data has SHA-256 checksum %s, expected %s", actual, expected)
+	}
+	return nil
+}
+
 // TODO: figure out 'local http uploads', eg. piping from a local / non public internet url if required(?)
 func parseSource(location string) (parsedLocation *url.URL, sourceType string, fileSize int64, err error) {
 	fileSize, err = getLocalFileSize(location)
@@ -351,20 +562,27 @@ func pollStorageImportStatus(exec commands.Executor, uuid string, statusChan cha
 	}
 }
 
-func importLocalFile(exec commands.Executor, uuid string, file *os.File, statusChan chan<- storageImportStatus) {
-	// make sure we close the channel when exiting import
-	defer close(statusChan)
-	chDone := make(chan storageImportStatus)
-	reader := &readerCounter{source: file}
-
-	// figure out content type
-	contentType := "application/octet-stream"
-	switch filepath.Ext(file.Name()) {
+// localFileContentType returns the content type of the local file to import based on its extension.
+func localFileContentType(filename string) string {
+	switch filepath.Ext(filename) {
 	case ".gz":
-		contentType = "application/gzip"
+		return "application/gzip"
 	case ".xz":
-		contentType = "application/x-xz"
+		return "application/x-xz"
 	}
+	return "application/octet-stream"
+}
+
+// isCompressedSource returns true if the source is compressed and decompressed by the API.
+func isCompressedSource(filename string) bool {
+	return localFileContentType(filename) != "application/octet-stream"
+}
+
+func importLocalFile(exec commands.Executor, uuid string, source io.ReadCloser, checksum io.Writer, contentType string, statusChan chan<- storageImportStatus) {
+	// make sure we close the channel when exiting import
+	defer close(statusChan)
+	chDone := make(chan storageImportStatus)
+	reader := &readerCounter{source: io.TeeReader(source, checksum)}
 
 	go func() {
 		imported, err := exec.All().CreateStorageImport(exec.Context(), &request.CreateStorageImportRequest{
@@ -373,6 +591,10 @@ func importLocalFile(exec commands.Executor, uuid string, file *os.File, statusC
 			Source:         upcloud.StorageImportSourceDirectUpload,
 			SourceLocation: reader,
 		})
+		// closing the source reports errors from converting the image
+		if closeErr := source.Close(); err == nil {
+			err = closeErr
+		}
 		chDone <- storageImportStatus{result: imported, err: err, complete: true}
 	}()
 	updateTicker := time.NewTicker(300 * time.Millisecond)
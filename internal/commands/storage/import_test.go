@@ -1,13 +1,19 @@
 package storage
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"io/fs"
 	"net/url"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
@@ -16,6 +22,7 @@ import (
 
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/adrg/xdg"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -126,6 +133,7 @@ func TestImportCommand(t *testing.T) {
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
+			setupImportStateDir(t)
 			CachedStorages = nil
 			conf := config.New()
 			mService := new(smock.Service)
@@ -164,6 +172,248 @@ func TestImportCommand(t *testing.T) {
 	}
 }
 
+// setupImportStateDir stores the import state in a temporary directory instead of the user's cache directory.
+func setupImportStateDir(t *testing.T) {
+	t.Helper()
+	// Cleanups are run in reverse order, so the paths are reloaded after the environment has been restored.
+	t.Cleanup(xdg.Reload)
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	xdg.Reload()
+}
+
+// consumeImportSource reads the uploaded data like the API client would do.
+func consumeImportSource(args mock.Arguments) {
+	req := args.Get(0).(*request.CreateStorageImportRequest)
+	if r, ok := req.SourceLocation.(io.Reader); ok {
+		_, _ = io.Copy(io.Discard, r)
+	}
+}
+
+func TestImportCommand_Checksum(t *testing.T) {
+	content := []byte("disk image content")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+	otherChecksum := strings.Repeat("ab", 32)
+
+	Storage1 := upcloud.Storage{
+		UUID:  UUID1,
+		Title: Title1,
+		State: upcloud.StorageStateMaintenance,
+		Type:  upcloud.StorageTypeNormal,
+		Zone:  "fi-hel1",
+		Size:  10,
+		Tier:  upcloud.StorageTierMaxIOPS,
+	}
+
+	for _, test := range []struct {
+		name           string
+		filename       string
+		args           []string
+		reportedSHA256 string
+		error          string
+	}{
+		{
+			name:           "checksums match",
+			args:           []string{"--sha256", strings.ToUpper(checksum)},
+			reportedSHA256: checksum,
+		},
+		{
+			name:           "compressed source is verified against uploaded data",
+			filename:       "disk.raw.gz",
+			args:           []string{"--sha256", checksum},
+			reportedSHA256: otherChecksum,
+		},
+		{
+			name:           "compressed source does not match expected checksum",
+			filename:       "disk.raw.gz",
+			args:           []string{"--sha256", otherChecksum},
+			reportedSHA256: otherChecksum,
+			error:          fmt.Sprintf("checksum mismatch: imported data has SHA-256 checksum %s, expected %s", checksum, otherChecksum),
+		},
+		{
+			name:           "reported checksum does not match uploaded data",
+			reportedSHA256: otherChecksum,
+			error:          fmt.Sprintf("checksum mismatch: uploaded data has SHA-256 checksum %s, but the API reported %s", checksum, otherChecksum),
+		},
+		{
+			name:           "source does not match expected checksum",
+			args:           []string{"--sha256", otherChecksum},
+			reportedSHA256: checksum,
+			error:          fmt.Sprintf("checksum mismatch: imported data has SHA-256 checksum %s, expected %s", checksum, otherChecksum),
+		},
+		{
+			name:  "invalid expected checksum",
+			args:  []string{"--sha256", "abc"},
+			error: `invalid SHA-256 checksum "abc", expected 64 hexadecimal characters`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			setupImportStateDir(t)
+			CachedStorages = nil
+
+			name := test.filename
+			if name == "" {
+				name = "disk.raw"
+			}
+			filename := filepath.Join(t.TempDir(), name)
+			assert.NoError(t, os.WriteFile(filename, content, 0o600))
+
+			mService := new(smock.Service)
+			mService.On("CreateStorage", mock.Anything).Return(&upcloud.StorageDetails{Storage: Storage1}, nil)
+			mService.On("CreateStorageImport", mock.Anything).Run(consumeImportSource).Return(&upcloud.StorageImportDetails{
+				State:     upcloud.StorageImportStateCompleted,
+				SHA256Sum: test.reportedSHA256,
+			}, nil)
+
+			conf := config.New()
+			c := commands.BuildCommand(ImportCommand(), nil, conf)
+			c.Cobra().SetArgs(append([]string{"--source-location", filename, "--zone", "fi-hel1", "--title", "test"}, test.args...))
+			_, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.error != "" {
+				assert.EqualError(t, err, test.error)
+				return
+			}
+			assert.NoError(t, err)
+
+			abs, err := filepath.Abs(filename)
+			assert.NoError(t, err)
+			stat, err := os.Stat(filename)
+			assert.NoError(t, err)
+			assert.Nil(t, loadImportState(abs, stat.Size(), stat.ModTime()))
+		})
+	}
+}
+
+func TestImportCommand_Resume(t *testing.T) {
+	Storage2 := upcloud.Storage{
+		UUID:  UUID2,
+		Title: "test",
+		State: upcloud.StorageStateMaintenance,
+		Type:  upcloud.StorageTypeNormal,
+		Zone:  "fi-hel1",
+		Size:  10,
+		Tier:  upcloud.StorageTierMaxIOPS,
+	}
+
+	t.Run("http import in progress", func(t *testing.T) {
+		setupImportStateDir(t)
+		CachedStorages = nil
+
+		location := "https://example.com/disk.raw.gz"
+		state := &importState{SourceLocation: location, StorageUUID: Storage2.UUID, Zone: "fi-hel1", Title: "test"}
+		assert.NoError(t, state.save())
+
+		mService := new(smock.Service)
+		mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: Storage2.UUID}).Return(&upcloud.StorageDetails{Storage: Storage2}, nil)
+		mService.On("GetStorageImportDetails", &request.GetStorageImportDetailsRequest{UUID: Storage2.UUID}).Return(&upcloud.StorageImportDetails{
+			State:          upcloud.StorageImportStateImporting,
+			Source:         upcloud.StorageImportSourceHTTPImport,
+			SourceLocation: location,
+		}, nil).Once()
+		mService.On("GetStorageImportDetails", &request.GetStorageImportDetailsRequest{UUID: Storage2.UUID}).Return(&upcloud.StorageImportDetails{
+			State:          upcloud.StorageImportStateCompleted,
+			Source:         upcloud.StorageImportSourceHTTPImport,
+			SourceLocation: location,
+		}, nil)
+
+		conf := config.New()
+		c := commands.BuildCommand(ImportCommand(), nil, conf)
+		c.Cobra().SetArgs([]string{"--source-location", location, "--zone", "fi-hel1", "--title", "test"})
+		_, err := mockexecute.MockExecute(c, mService, conf)
+		assert.NoError(t, err)
+
+		mService.AssertNumberOfCalls(t, "CreateStorage", 0)
+		mService.AssertNumberOfCalls(t, "CreateStorageImport", 0)
+		mService.AssertNumberOfCalls(t, "GetStorageImportDetails", 2)
+		assert.Nil(t, loadImportState(location, 0, time.Time{}))
+	})
+
+	t.Run("completed upload", func(t *testing.T) {
+		setupImportStateDir(t)
+		CachedStorages = nil
+
+		content := []byte("disk image content")
+		sum := sha256.Sum256(content)
+		checksum := hex.EncodeToString(sum[:])
+
+		filename := filepath.Join(t.TempDir(), "disk.raw")
+		assert.NoError(t, os.WriteFile(filename, content, 0o600))
+		abs, err := filepath.Abs(filename)
+		assert.NoError(t, err)
+		stat, err := os.Stat(filename)
+		assert.NoError(t, err)
+
+		state := &importState{
+			SourceLocation: abs,
+			Size:           stat.Size(),
+			ModTime:        stat.ModTime(),
+			StorageUUID:    Storage2.UUID,
+			Zone:           "fi-hel1",
+			Title:          "test",
+			UploadSHA256:   checksum,
+		}
+		assert.NoError(t, state.save())
+
+		mService := new(smock.Service)
+		mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: Storage2.UUID}).Return(&upcloud.StorageDetails{Storage: Storage2}, nil)
+		mService.On("GetStorageImportDetails", &request.GetStorageImportDetailsRequest{UUID: Storage2.UUID}).Return(&upcloud.StorageImportDetails{
+			State:     upcloud.StorageImportStateCompleted,
+			Source:    upcloud.StorageImportSourceDirectUpload,
+			SHA256Sum: checksum,
+		}, nil)
+
+		conf := config.New()
+		c := commands.BuildCommand(ImportCommand(), nil, conf)
+		c.Cobra().SetArgs([]string{"--source-location", filename, "--zone", "fi-hel1", "--title", "test", "--sha256", checksum})
+		_, err = mockexecute.MockExecute(c, mService, conf)
+		assert.NoError(t, err)
+
+		mService.AssertNumberOfCalls(t, "CreateStorage", 0)
+		mService.AssertNumberOfCalls(t, "CreateStorageImport", 0)
+		assert.Nil(t, loadImportState(abs, stat.Size(), stat.ModTime()))
+	})
+}
+
+func TestDetectImageFormat(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		content  []byte
+		expected string
+	}{
+		{name: "qcow2", content: []byte("QFI\xfb\x00\x00\x00\x03"), expected: "qcow2"},
+		{name: "vmdk", content: []byte("KDMV\x01\x00\x00\x00"), expected: "vmdk"},
+		{name: "vhdx", content: []byte("vhdxfile"), expected: "vhdx"},
+		{name: "raw", content: bytes.Repeat([]byte{0}, 512), expected: ""},
+		{name: "short", content: []byte("QFI"), expected: ""},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "image")
+			assert.NoError(t, os.WriteFile(filename, test.content, 0o600))
+
+			format, err := detectImageFormat(filename)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, format)
+		})
+	}
+}
+
+func TestImportCommand_ConvertedHTTPSource(t *testing.T) {
+	conf := config.New()
+	c := commands.BuildCommand(ImportCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"--source-location", "https://example.com/disk.qcow2", "--zone", "fi-hel1", "--title", "test"})
+	_, err := mockexecute.MockExecute(c, new(smock.Service), conf)
+	assert.EqualError(t, err, "qcow2 images can only be imported from local files, download the image first")
+}
+
+func TestImportCommand_CompressedHTTPSourceChecksum(t *testing.T) {
+	conf := config.New()
+	c := commands.BuildCommand(ImportCommand(), nil, conf)
+	c.Cobra().SetArgs([]string{"--source-location", "https://example.com/disk.raw.xz", "--zone", "fi-hel1", "--title", "test", "--sha256", strings.Repeat("ab", 32)})
+	_, err := mockexecute.MockExecute(c, new(smock.Service), conf)
+	assert.EqualError(t, err, "checksum of compressed HTTP sources can not be verified, download the file first or import without --sha256")
+}
+
 func TestParseSource(t *testing.T) {
 	for _, test := range []struct {
 		name                    string
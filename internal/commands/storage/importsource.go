@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	osexec "os/exec"
+	"path"
+	"strings"
+)
+
+// convertedImageFormats contains the disk image formats that are converted to raw format while uploading. The formats are detected from the magic bytes in the beginning of the file.
+var convertedImageFormats = []struct {
+	name  string
+	magic []byte
+}{
+	{name: "qcow2", magic: []byte("QFI\xfb")},
+	{name: "vmdk", magic: []byte("KDMV")},
+	{name: "vhdx", magic: []byte("vhdxfile")},
+}
+
+// detectImageFormat returns the name of the disk image format of the file, if the file needs to be converted before uploading. Returns an empty string for raw images, ISO images, and compressed files.
+func detectImageFormat(filename string) (string, error) {
+	f, err := os.Open(filename) //gosec:disable G304 -- local source path is explicit CLI input
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	header := make([]byte, 8)
+	n, err := io.ReadFull(f, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	for _, format := range convertedImageFormats {
+		if bytes.HasPrefix(header[:n], format.magic) {
+			return format.name, nil
+		}
+	}
+	return "", nil
+}
+
+// convertedImageFormatFromURL returns the name of the disk image format, if the URL points to a file that would need to be converted.
+func convertedImageFormatFromURL(location string) string {
+	ext := strings.TrimPrefix(path.Ext(location), ".")
+	for _, format := range convertedImageFormats {
+		if strings.EqualFold(ext, format.name) {
+			return format.name
+		}
+	}
+	return ""
+}
+
+// imageVirtualSize returns the size of the disk image after conversion to raw format.
+func imageVirtualSize(ctx context.Context, filename, format string) (int64, error) {
+	if _, err := osexec.LookPath("qemu-img"); err != nil {
+		return 0, fmt.Errorf("qemu-img command is required for importing %s images: %w", format, err)
+	}
+
+	out, err := osexec.CommandContext(ctx, "qemu-img", "info", "--output=json", "-f", format, filename).Output() //gosec:disable G204 -- format is one of the supported formats and filename is explicit CLI input
+	if err != nil {
+		return 0, fmt.Errorf("cannot read %s image info: %w", format, err)
+	}
+
+	var info struct {
+		VirtualSize int64 `json:"virtual-size"`
+	}
+	if err := json.Unmarshal(out, &info); err != nil {
+		return 0, fmt.Errorf("cannot parse %s image info: %w", format, err)
+	}
+	return info.VirtualSize, nil
+}
+
+// rawImageReader streams the disk image converted to raw format from the standard output of nbdcopy.
+type rawImageReader struct {
+	io.ReadCloser
+	cmd    *osexec.Cmd
+	stderr bytes.Buffer
+}
+
+// Close implements io.Closer
+func (r *rawImageReader) Close() error {
+	_ = r.ReadCloser.Close()
+	if err := r.cmd.Wait(); err != nil {
+		return fmt.Errorf("image conversion failed: %s: %w", strings.TrimSpace(r.stderr.String()), err)
+	}
+	return nil
+}
+
+// convertToRaw starts converting the disk image to raw format. qemu-img can not write raw images to a pipe, so the image is served with qemu-nbd and copied to standard output with nbdcopy.
+func convertToRaw(ctx context.Context, filename, format string) (io.ReadCloser, error) {
+	for _, command := range []string{"nbdcopy", "qemu-nbd"} {
+		if _, err := osexec.LookPath(command); err != nil {
+			return nil, fmt.Errorf("%s command is required for importing %s images: %w", command, format, err)
+		}
+	}
+
+	r := &rawImageReader{
+		cmd: osexec.CommandContext(ctx, "nbdcopy", "--", "[", "qemu-nbd", "--read-only", "--format", format, filename, "]", "-"), //gosec:disable G204 -- format is one of the supported formats and filename is explicit CLI input
+	}
+	r.cmd.Stderr = &r.stderr
+
+	stdout, err := r.cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	r.ReadCloser = stdout
+
+	if err := r.cmd.Start(); err != nil {
+		return nil, fmt.Errorf("cannot start image conversion: %w", err)
+	}
+	return r, nil
+}
+
+// fileSHA256 calculates the SHA-256 checksum of a local file.
+func fileSHA256(filename string) (string, error) {
+	f, err := os.Open(filename) //gosec:disable G304 -- local source path is explicit CLI input
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// parseSHA256 validates and normalizes a SHA-256 checksum given as a hex string.
+func parseSHA256(in string) (string, error) {
+	if in == "" {
+		return "", nil
+	}
+
+	checksum := strings.ToLower(strings.TrimSpace(in))
+	if decoded, err := hex.DecodeString(checksum); err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("invalid SHA-256 checksum %q, expected 64 hexadecimal characters", in)
+	}
+	return checksum, nil
+}
@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/adrg/xdg"
+)
+
+// importState contains the details of an unfinished import. It is stored in the cache directory, so that an interrupted import of the same source can be resumed without creating a new storage or, in case of HTTP imports, without restarting the import.
+type importState struct {
+	SourceLocation string    `json:"source_location"`
+	Size           int64     `json:"size,omitempty"`
+	ModTime        time.Time `json:"mod_time,omitempty"`
+	StorageUUID    string    `json:"storage_uuid"`
+	Zone           string    `json:"zone,omitempty"`
+	Title          string    `json:"title,omitempty"`
+	// SourceSHA256 is the verified checksum of a source file that is converted before uploading.
+	SourceSHA256 string `json:"source_sha256,omitempty"`
+	// UploadSHA256 is the checksum of the data that was uploaded to the storage.
+	UploadSHA256 string `json:"upload_sha256,omitempty"`
+}
+
+func importStatePath(sourceLocation string) (string, error) {
+	sum := sha256.Sum256([]byte(sourceLocation))
+	return xdg.CacheFile(filepath.Join("upctl", "storage-import", hex.EncodeToString(sum[:16])+".json"))
+}
+
+// loadImportState reads the state of an unfinished import of given source. Returns nil if there is no state stored or if the source file has changed after the state was stored.
+func loadImportState(sourceLocation string, size int64, modTime time.Time) *importState {
+	path, err := importStatePath(sourceLocation)
+	if err != nil {
+		return nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var state importState
+	if err := json.Unmarshal(content, &state); err != nil {
+		return nil
+	}
+
+	if state.SourceLocation != sourceLocation || state.Size != size || !state.ModTime.Equal(modTime) || state.StorageUUID == "" {
+		return nil
+	}
+	return &state
+}
+
+func (s *importState) save() error {
+	path, err := importStatePath(s.SourceLocation)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0o600)
+}
+
+func (s *importState) remove() error {
+	path, err := importStatePath(s.SourceLocation)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
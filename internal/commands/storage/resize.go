@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/UpCloudLtd/progress/messages"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/output"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/ui"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+)
+
+// ResizeCommand creates the "storage resize" command
+func ResizeCommand() commands.Command {
+	return &resizeCommand{
+		BaseCommand: commands.New(
+			"resize",
+			"Resize a storage and optionally its partition and filesystem",
+			"upctl storage resize 01271548-2e92-44bb-9774-d282508cc762 --size 50",
+			`upctl storage resize "My Storage" --size 50 --resize-filesystem`,
+		),
+	}
+}
+
+type resizeCommand struct {
+	*commands.BaseCommand
+	completion.Storage
+	resolver.CachingStorage
+	size             int
+	resizeFilesystem config.OptionalBoolean
+}
+
+type resizeOutput struct {
+	*upcloud.StorageDetails
+	LatestResizeBackup string `json:"latest_resize_backup,omitempty"`
+}
+
+// MaximumExecutions implements command.Command
+func (s *resizeCommand) MaximumExecutions() int {
+	return maxStorageActions
+}
+
+// InitCommand implements Command.InitCommand
+func (s *resizeCommand) InitCommand() {
+	s.Cobra().Long = commands.WrapLongDescription(`Resize a storage and optionally its partition and filesystem
+
+Storages can only be enlarged. The storage must not be attached to a running server.
+
+With ` + "`--resize-filesystem`" + `, the last partition and the filesystem on it are resized to use the added space after the storage has been enlarged. A backup of the storage is taken before the partition and filesystem are resized. If the resize succeeds, the backup is kept and its UUID is included in the output. If the partition and filesystem resize fails, the command fails, but the storage keeps its new size. upctl does not restore the storage from the backup: find the backup with ` + "`upctl storage backup list`" + ` and restore it with ` + "`upctl storage backup restore`" + `, if needed. Taking and keeping backups incur costs.`)
+
+	fs := s.Cobra().Flags()
+	fs.IntVar(&s.size, "size", 0, "New size of the storage (GiB).")
+	config.AddToggleFlag(fs, &s.resizeFilesystem, "resize-filesystem", false, "Resize the last partition and the filesystem on it to use the added space.")
+
+	commands.Must(s.Cobra().MarkFlagRequired("size"))
+	commands.Must(fs.SetAnnotation("size", commands.FlagAnnotationNoFileCompletions, nil))
+}
+
+// Execute implements commands.MultipleArgumentCommand
+func (s *resizeCommand) Execute(exec commands.Executor, uuid string) (output.Output, error) {
+	svc := exec.Storage()
+	details, err := svc.GetStorageDetails(exec.Context(), &request.GetStorageDetailsRequest{UUID: uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case s.size < details.Size:
+		return nil, fmt.Errorf("cannot shrink storage %s from %d GiB to %d GiB, storages can only be enlarged", uuid, details.Size, s.size)
+	case s.size == details.Size && !s.resizeFilesystem.Value():
+		return nil, fmt.Errorf("storage %s is already %d GiB", uuid, details.Size)
+	}
+
+	msg := fmt.Sprintf("Resizing storage %v to %d GiB", uuid, s.size)
+	exec.PushProgressStarted(msg)
+
+	// The storage might have been enlarged earlier without resizing the filesystem, in which case only the filesystem is resized.
+	var req *request.ModifyStorageRequest
+	if s.size > details.Size {
+		req = &request.ModifyStorageRequest{UUID: uuid, Size: s.size}
+	}
+
+	res, backup, err := resizeStorage(exec, uuid, req, s.resizeFilesystem.Value(), msg)
+	var fsErr *filesystemResizeError
+	switch {
+	case errors.As(err, &fsErr) && req != nil:
+		// The storage was enlarged, so output the enlarged storage, but fail the command as the filesystem was not resized.
+		exec.PushProgressUpdate(messages.Update{
+			Key:     msg,
+			Status:  messages.MessageStatusError,
+			Details: "Error: " + fsErr.Error(),
+		})
+	case err != nil:
+		return commands.HandleError(exec, msg, err)
+	default:
+		exec.PushProgressUpdateMessage(msg, msg)
+		exec.PushProgressSuccess(msg)
+	}
+
+	if res == nil {
+		res = details
+	}
+	out := resizeOutput{StorageDetails: res, LatestResizeBackup: backup}
+
+	rows := []output.DetailRow{
+		{Title: "UUID", Value: uuid, Colour: ui.DefaultUUUIDColours},
+		{Title: "Size", Value: res.Size},
+	}
+	if out.LatestResizeBackup != "" {
+		rows = append(rows, output.DetailRow{Title: "Resize backup", Value: out.LatestResizeBackup, Colour: ui.DefaultUUUIDColours})
+	}
+
+	if fsErr != nil {
+		return output.WithError{Output: output.MarshaledWithHumanDetails{Value: out, Details: rows}, Err: fsErr}, nil
+	}
+	return output.MarshaledWithHumanDetails{Value: out, Details: rows}, nil
+}
+
+// filesystemResizeError is returned by resizeStorage when the partition and filesystem resize fails.
+type filesystemResizeError struct {
+	Err error
+}
+
+func (e *filesystemResizeError) Error() string {
+	return fmt.Sprintf("partition and filesystem resize failed: %s", e.Err.Error())
+}
+
+func (e *filesystemResizeError) Unwrap() error {
+	return e.Err
+}
+
+// resizeStorage modifies the storage with req, if given, and then optionally resizes the last partition and the filesystem on it. Returns the modified storage and the UUID of the backup taken before the filesystem resize.
+// If the filesystem resize fails, a *filesystemResizeError is returned.
+func resizeStorage(exec commands.Executor, uuid string, req *request.ModifyStorageRequest, resizeFilesystem bool, msg string) (*upcloud.StorageDetails, string, error) {
+	svc := exec.Storage()
+
+	var res *upcloud.StorageDetails
+	if req != nil {
+		var err error
+		res, err = svc.ModifyStorage(exec.Context(), req)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if err := waitForStorageOnline(exec, uuid, msg, 15*time.Minute); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if !resizeFilesystem {
+		return res, "", nil
+	}
+
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("%s: backing up storage and resizing partition and filesystem", msg))
+	backup, err := svc.ResizeStorageFilesystem(exec.Context(), &request.ResizeStorageFilesystemRequest{UUID: uuid})
+	if err != nil {
+		return res, "", &filesystemResizeError{Err: err}
+	}
+
+	if err := waitForStorageOnline(exec, uuid, msg, 15*time.Minute); err != nil {
+		return res, backup.UUID, err
+	}
+	return res, backup.UUID, nil
+}
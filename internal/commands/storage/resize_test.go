@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/config"
+	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/mockexecute"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResizeCommand(t *testing.T) {
+	Storage1 := upcloud.Storage{
+		UUID:  UUID1,
+		Title: Title1,
+		State: upcloud.StorageStateOnline,
+		Type:  upcloud.StorageTypeNormal,
+		Zone:  "fi-hel1",
+		Size:  25,
+		Tier:  upcloud.StorageTierMaxIOPS,
+	}
+	resized := Storage1
+	resized.Size = 50
+
+	for _, test := range []struct {
+		name          string
+		args          []string
+		resizeErr     error
+		modifyCalls   int
+		resizeCalls   int
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:        "enlarge storage",
+			args:        []string{"--size", "50"},
+			modifyCalls: 1,
+			expected:    []string{`"size": 50`},
+		},
+		{
+			name:        "enlarge storage and resize filesystem",
+			args:        []string{"--size", "50", "--resize-filesystem"},
+			modifyCalls: 1,
+			resizeCalls: 1,
+			expected:    []string{`"size": 50`, `"latest_resize_backup": "resize-backup-uuid"`},
+		},
+		{
+			name:        "resize filesystem of already enlarged storage",
+			args:        []string{"--size", "25", "--resize-filesystem"},
+			resizeCalls: 1,
+			expected:    []string{`"latest_resize_backup": "resize-backup-uuid"`},
+		},
+		{
+			name:          "filesystem resize fails after enlarging storage",
+			args:          []string{"--size", "50", "--resize-filesystem"},
+			resizeErr:     fmt.Errorf("unsupported filesystem"),
+			modifyCalls:   1,
+			resizeCalls:   1,
+			expected:      []string{`"size": 50`},
+			expectedError: "partition and filesystem resize failed: unsupported filesystem",
+		},
+		{
+			name:          "filesystem resize of already enlarged storage fails",
+			args:          []string{"--size", "25", "--resize-filesystem"},
+			resizeErr:     fmt.Errorf("unsupported filesystem"),
+			resizeCalls:   1,
+			expectedError: "partition and filesystem resize failed: unsupported filesystem",
+		},
+		{
+			name:          "shrink storage",
+			args:          []string{"--size", "20"},
+			expectedError: fmt.Sprintf("cannot shrink storage %s from 25 GiB to 20 GiB, storages can only be enlarged", UUID1),
+		},
+		{
+			name:          "same size",
+			args:          []string{"--size", "25"},
+			expectedError: fmt.Sprintf("storage %s is already 25 GiB", UUID1),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			CachedStorages = nil
+			mService := new(smock.Service)
+			mService.On("GetStorages", mock.Anything).Return(&upcloud.Storages{Storages: []upcloud.Storage{Storage1}}, nil)
+			mService.On("GetStorageDetails", &request.GetStorageDetailsRequest{UUID: UUID1}).Return(&upcloud.StorageDetails{Storage: Storage1}, nil)
+			mService.On("ModifyStorage", &request.ModifyStorageRequest{UUID: UUID1, Size: 50}).Return(&upcloud.StorageDetails{Storage: resized}, nil)
+			mService.On("WaitForStorageState", &request.WaitForStorageStateRequest{UUID: UUID1, DesiredState: upcloud.StorageStateOnline}).Return(&upcloud.StorageDetails{Storage: resized}, nil)
+			if test.resizeErr != nil {
+				mService.On("ResizeStorageFilesystem", &request.ResizeStorageFilesystemRequest{UUID: UUID1}).Return(nil, test.resizeErr)
+			} else {
+				mService.On("ResizeStorageFilesystem", &request.ResizeStorageFilesystemRequest{UUID: UUID1}).Return(&upcloud.ResizeStorageFilesystemBackup{UUID: "resize-backup-uuid"}, nil)
+			}
+
+			conf := config.New()
+			conf.Viper().Set(config.KeyOutput, config.ValueOutputJSON)
+			c := commands.BuildCommand(ResizeCommand(), nil, conf)
+			c.Cobra().SetArgs(append([]string{UUID1}, test.args...))
+			out, err := mockexecute.MockExecute(c, mService, conf)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			for _, expected := range test.expected {
+				assert.Contains(t, out, expected)
+			}
+			mService.AssertNumberOfCalls(t, "ModifyStorage", test.modifyCalls)
+			mService.AssertNumberOfCalls(t, "ResizeStorageFilesystem", test.resizeCalls)
+			mService.AssertNotCalled(t, "RestoreBackup", mock.Anything)
+			mService.AssertNotCalled(t, "DeleteStorage", mock.Anything)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
@@ -33,6 +34,8 @@ var (
 	// CachedStorages stores the cached list of storages in order to not hit the service more than once
 	// TODO: refactor
 	CachedStorages []upcloud.Storage
+	// cachedStoragesMutex guards CachedStorages, as storages can be searched in parallel, e.g., when creating multiple servers with `server create --from-file`.
+	cachedStoragesMutex sync.Mutex
 )
 
 // BaseStorageCommand creates the base "storage" command
@@ -65,6 +68,9 @@ func searchStorage(storagesPtr *[]upcloud.Storage, exec commands.Executor, uuidO
 	if storagesPtr == nil || exec == nil {
 		return nil, fmt.Errorf("no storages or executor passed")
 	}
+	cachedStoragesMutex.Lock()
+	defer cachedStoragesMutex.Unlock()
+
 	storages := *storagesPtr
 	if len(CachedStorages) == 0 {
 		res, err := exec.All().GetStorages(exec.Context(), &request.GetStoragesRequest{})
@@ -117,3 +123,17 @@ func waitForStorageState(uuid, state string, exec commands.Executor, msg string)
 	exec.PushProgressUpdateMessage(msg, msg)
 	exec.PushProgressSuccess(msg)
 }
+
+// waitForStorageOnline waits for the storage to be in online state, e.g., after it has been resized or copied.
+func waitForStorageOnline(exec commands.Executor, uuid, msg string, timeout time.Duration) error {
+	exec.PushProgressUpdateMessage(msg, fmt.Sprintf("%s: waiting for storage to be in %s state", msg, upcloud.StorageStateOnline))
+
+	ctx, cancel := context.WithTimeout(exec.Context(), timeout)
+	defer cancel()
+
+	_, err := exec.All().WaitForStorageState(ctx, &request.WaitForStorageStateRequest{
+		UUID:         uuid,
+		DesiredState: upcloud.StorageStateOnline,
+	})
+	return err
+}
@@ -2,7 +2,9 @@ package commands
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/jedib0t/go-pretty/v6/text"
+	"go.yaml.in/yaml/v3"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -249,6 +252,27 @@ func SetSubcommandExecutionDeprecationMessage(cmd Command, deprecatedParentAlias
 	}
 }
 
+// UnmarshalYAMLStrict parses a YAML or JSON document from `data` to `v` with DecodeYAMLStrict.
+func UnmarshalYAMLStrict(data []byte, v any) error {
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	return DecodeYAMLStrict(raw, v)
+}
+
+// DecodeYAMLStrict decodes a generic value, as decoded by the YAML decoder, to `v`. The value is converted to JSON first, to be able to use the JSON tags of the API types. Unknown fields are not allowed.
+func DecodeYAMLStrict(raw any, v any) error {
+	jsonData, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(jsonData))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
 // Must panics if the error is not nil.
 func Must(err error) {
 	if err != nil {
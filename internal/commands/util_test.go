@@ -33,3 +33,20 @@ func TestParseSSHKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalYAMLStrict(t *testing.T) {
+	type value struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+
+	var v value
+	assert.NoError(t, commands.UnmarshalYAMLStrict([]byte("name: test\ncount: 2\n"), &v))
+	assert.Equal(t, value{Name: "test", Count: 2}, v)
+
+	assert.NoError(t, commands.UnmarshalYAMLStrict([]byte(`{"name": "json"}`), &v))
+	assert.Equal(t, "json", v.Name)
+
+	assert.ErrorContains(t, commands.UnmarshalYAMLStrict([]byte("name: test\nsize: 2\n"), &v), `unknown field "size"`)
+	assert.Error(t, commands.UnmarshalYAMLStrict([]byte("name: [test"), &v))
+}
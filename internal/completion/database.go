@@ -75,3 +75,99 @@ func (s DatabaseProperty) CompleteArgument(ctx context.Context, svc service.AllS
 	}
 	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
 }
+
+// DatabaseUser implements argument completion for users of a database, by username.
+type DatabaseUser struct {
+	Database string
+}
+
+// make sure DatabaseUser implements the interface
+var _ Provider = DatabaseUser{}
+
+// CompleteArgument implements completion.Provider
+func (s DatabaseUser) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	uuid, ok := findDatabaseUUID(ctx, svc, s.Database)
+	if !ok {
+		return None(toComplete)
+	}
+
+	users, err := svc.GetManagedDatabaseUsers(ctx, &request.GetManagedDatabaseUsersRequest{ServiceUUID: uuid})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, user := range users {
+		vals = append(vals, user.Username)
+	}
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// DatabaseLogicalDatabase implements argument completion for logical databases of a database, by name.
+type DatabaseLogicalDatabase struct {
+	Database string
+}
+
+// make sure DatabaseLogicalDatabase implements the interface
+var _ Provider = DatabaseLogicalDatabase{}
+
+// CompleteArgument implements completion.Provider
+func (s DatabaseLogicalDatabase) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	uuid, ok := findDatabaseUUID(ctx, svc, s.Database)
+	if !ok {
+		return None(toComplete)
+	}
+
+	logicalDatabases, err := svc.GetManagedDatabaseLogicalDatabases(ctx, &request.GetManagedDatabaseLogicalDatabasesRequest{ServiceUUID: uuid})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, ldb := range logicalDatabases {
+		vals = append(vals, ldb.Name)
+	}
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// DatabaseConnectionPool implements argument completion for connection pools of a database, by name.
+type DatabaseConnectionPool struct {
+	Database string
+}
+
+// make sure DatabaseConnectionPool implements the interface
+var _ Provider = DatabaseConnectionPool{}
+
+// CompleteArgument implements completion.Provider
+func (s DatabaseConnectionPool) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	uuid, ok := findDatabaseUUID(ctx, svc, s.Database)
+	if !ok {
+		return None(toComplete)
+	}
+
+	pools, err := svc.GetManagedDatabaseConnectionPools(ctx, &request.GetManagedDatabaseConnectionPoolsRequest{ServiceUUID: uuid})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, pool := range pools {
+		vals = append(vals, pool.PoolName)
+	}
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// findDatabaseUUID finds the UUID of the database matching given UUID or title exactly.
+func findDatabaseUUID(ctx context.Context, svc service.AllServices, arg string) (string, bool) {
+	if arg == "" {
+		return "", false
+	}
+
+	databases, err := svc.GetManagedDatabases(ctx, &request.GetManagedDatabasesRequest{})
+	if err != nil {
+		return "", false
+	}
+	for _, db := range databases {
+		if db.UUID == arg || db.Title == arg {
+			return db.UUID, true
+		}
+	}
+	return "", false
+}
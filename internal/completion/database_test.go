@@ -8,6 +8,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
 	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -49,3 +50,20 @@ func TestDatabase_CompleteArgumentServiceFail(t *testing.T) {
 	assert.Nil(t, completions)
 	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
 }
+
+func TestDatabaseUser_CompleteArgument(t *testing.T) {
+	mService := new(smock.Service)
+	mService.On("GetManagedDatabases", mock.Anything).Return(mockDatabases, nil)
+	mService.On("GetManagedDatabaseUsers", &request.GetManagedDatabaseUsersRequest{ServiceUUID: "jklmno"}).Return([]upcloud.ManagedDatabaseUser{
+		{Username: "upadmin"},
+		{Username: "app"},
+		{Username: "app-readonly"},
+	}, nil)
+
+	completions, directive := completion.DatabaseUser{Database: "qwe-1"}.CompleteArgument(context.TODO(), mService, "app")
+	assert.Equal(t, []string{"app", "app-readonly"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	completions, _ = completion.DatabaseUser{Database: "unknown"}.CompleteArgument(context.TODO(), mService, "app")
+	assert.Nil(t, completions)
+}
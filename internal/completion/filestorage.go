@@ -27,3 +27,48 @@ func (s FileStorage) CompleteArgument(ctx context.Context, svc service.AllServic
 
 	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
 }
+
+// FileStorageShare implements argument completion for shares of a file storage service, by name.
+type FileStorageShare struct {
+	FileStorage string
+}
+
+// make sure FileStorageShare implements the interface
+var _ Provider = FileStorageShare{}
+
+// CompleteArgument implements completion.Provider
+func (s FileStorageShare) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	uuid, ok := findFileStorageUUID(ctx, svc, s.FileStorage)
+	if !ok {
+		return None(toComplete)
+	}
+
+	shares, err := svc.GetFileStorageShares(ctx, &request.GetFileStorageSharesRequest{ServiceUUID: uuid})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, share := range shares {
+		vals = append(vals, share.Name)
+	}
+
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// findFileStorageUUID finds the UUID of the file storage service matching given UUID or name exactly.
+func findFileStorageUUID(ctx context.Context, svc service.AllServices, arg string) (string, bool) {
+	if arg == "" {
+		return "", false
+	}
+
+	filestorages, err := svc.GetFileStorages(ctx, &request.GetFileStoragesRequest{})
+	if err != nil {
+		return "", false
+	}
+	for _, filesto := range filestorages {
+		if filesto.UUID == arg || filesto.Name == arg {
+			return filesto.UUID, true
+		}
+	}
+	return "", false
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/spf13/cobra"
 )
 
@@ -26,3 +27,79 @@ func (s Gateway) CompleteArgument(ctx context.Context, svc service.AllServices,
 
 	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
 }
+
+// GatewayConnection implements argument completion for connections of a gateway, by name.
+type GatewayConnection struct {
+	Gateway string
+}
+
+// make sure GatewayConnection implements the interface
+var _ Provider = GatewayConnection{}
+
+// CompleteArgument implements completion.Provider
+func (s GatewayConnection) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	uuid, ok := findGatewayUUID(ctx, svc, s.Gateway)
+	if !ok {
+		return None(toComplete)
+	}
+
+	connections, err := svc.GetGatewayConnections(ctx, &request.GetGatewayConnectionsRequest{ServiceUUID: uuid})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, conn := range connections {
+		vals = append(vals, conn.Name)
+	}
+
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// GatewayConnectionTunnel implements argument completion for tunnels of a gateway connection, by name.
+type GatewayConnectionTunnel struct {
+	Gateway    string
+	Connection string
+}
+
+// make sure GatewayConnectionTunnel implements the interface
+var _ Provider = GatewayConnectionTunnel{}
+
+// CompleteArgument implements completion.Provider
+func (s GatewayConnectionTunnel) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	uuid, ok := findGatewayUUID(ctx, svc, s.Gateway)
+	if !ok || s.Connection == "" {
+		return None(toComplete)
+	}
+
+	tunnels, err := svc.GetGatewayConnectionTunnels(ctx, &request.GetGatewayConnectionTunnelsRequest{
+		ServiceUUID:    uuid,
+		ConnectionName: s.Connection,
+	})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, tun := range tunnels {
+		vals = append(vals, tun.Name)
+	}
+
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// findGatewayUUID finds the UUID of the gateway matching given UUID or name exactly.
+func findGatewayUUID(ctx context.Context, svc service.AllServices, arg string) (string, bool) {
+	if arg == "" {
+		return "", false
+	}
+
+	gateways, err := svc.GetGateways(ctx)
+	if err != nil {
+		return "", false
+	}
+	for _, gtw := range gateways {
+		if gtw.UUID == arg || gtw.Name == arg {
+			return gtw.UUID, true
+		}
+	}
+	return "", false
+}
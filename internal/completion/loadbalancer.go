@@ -27,3 +27,117 @@ func (s LoadBalancer) CompleteArgument(ctx context.Context, svc service.AllServi
 
 	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
 }
+
+// LoadBalancerPlan implements argument completion for load balancer plans.
+type LoadBalancerPlan struct{}
+
+// make sure LoadBalancerPlan implements the interface
+var _ Provider = LoadBalancerPlan{}
+
+// CompleteArgument implements completion.Provider
+func (s LoadBalancerPlan) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	plans, err := svc.GetLoadBalancerPlans(ctx, &request.GetLoadBalancerPlansRequest{})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, plan := range plans {
+		vals = append(vals, plan.Name)
+	}
+
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// LoadBalancerFrontend implements argument completion for the frontends of a load balancer, by name.
+type LoadBalancerFrontend struct {
+	// LoadBalancer is the UUID or name of the load balancer to complete frontends for.
+	LoadBalancer string
+}
+
+// make sure LoadBalancerFrontend implements the interface
+var _ Provider = LoadBalancerFrontend{}
+
+// CompleteArgument implements completion.Provider
+func (s LoadBalancerFrontend) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	uuid, ok := findLoadBalancerUUID(ctx, svc, s.LoadBalancer)
+	if !ok {
+		return None(toComplete)
+	}
+
+	frontends, err := svc.GetLoadBalancerFrontends(ctx, &request.GetLoadBalancerFrontendsRequest{ServiceUUID: uuid})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, fe := range frontends {
+		vals = append(vals, fe.Name)
+	}
+
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// LoadBalancerBackend implements argument completion for the backends of a load balancer, by name.
+type LoadBalancerBackend struct {
+	// LoadBalancer is the UUID or name of the load balancer to complete backends for.
+	LoadBalancer string
+}
+
+// make sure LoadBalancerBackend implements the interface
+var _ Provider = LoadBalancerBackend{}
+
+// CompleteArgument implements completion.Provider
+func (s LoadBalancerBackend) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	uuid, ok := findLoadBalancerUUID(ctx, svc, s.LoadBalancer)
+	if !ok {
+		return None(toComplete)
+	}
+
+	backends, err := svc.GetLoadBalancerBackends(ctx, &request.GetLoadBalancerBackendsRequest{ServiceUUID: uuid})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, be := range backends {
+		vals = append(vals, be.Name)
+	}
+
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// LoadBalancerCertificateBundle implements argument completion for load balancer certificate bundles, by uuid or name.
+type LoadBalancerCertificateBundle struct{}
+
+// make sure LoadBalancerCertificateBundle implements the interface
+var _ Provider = LoadBalancerCertificateBundle{}
+
+// CompleteArgument implements completion.Provider
+func (s LoadBalancerCertificateBundle) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	bundles, err := svc.GetLoadBalancerCertificateBundles(ctx, &request.GetLoadBalancerCertificateBundlesRequest{})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, cb := range bundles {
+		vals = append(vals, cb.UUID, cb.Name)
+	}
+
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+// findLoadBalancerUUID finds the UUID of the load balancer matching given UUID or name exactly.
+func findLoadBalancerUUID(ctx context.Context, svc service.AllServices, arg string) (string, bool) {
+	if arg == "" {
+		return "", false
+	}
+
+	loadbalancers, err := svc.GetLoadBalancers(ctx, &request.GetLoadBalancersRequest{})
+	if err != nil {
+		return "", false
+	}
+	for _, lb := range loadbalancers {
+		if lb.UUID == arg || lb.Name == arg {
+			return lb.UUID, true
+		}
+	}
+	return "", false
+}
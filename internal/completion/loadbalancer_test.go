@@ -8,6 +8,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/completion"
 	smock "github.com/UpCloudLtd/upcloud-cli/v3/internal/mock"
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -49,3 +50,53 @@ func TestLoadBalancer_CompleteArgumentServiceFail(t *testing.T) {
 	assert.Nil(t, completions)
 	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
 }
+
+func TestLoadBalancerFrontend_CompleteArgument(t *testing.T) {
+	mService := new(smock.Service)
+	mService.On("GetLoadBalancers", mock.Anything).Return(mockLoadBalancers, nil)
+	mService.On("GetLoadBalancerFrontends", &request.GetLoadBalancerFrontendsRequest{ServiceUUID: "jklmno"}).Return([]upcloud.LoadBalancerFrontend{
+		{Name: "web"},
+		{Name: "web-tls"},
+		{Name: "api"},
+	}, nil)
+
+	completions, directive := completion.LoadBalancerFrontend{LoadBalancer: "qwe-1"}.CompleteArgument(context.TODO(), mService, "we")
+	assert.Equal(t, []string{"web", "web-tls"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
+func TestLoadBalancerBackend_CompleteArgument(t *testing.T) {
+	mService := new(smock.Service)
+	mService.On("GetLoadBalancers", mock.Anything).Return(mockLoadBalancers, nil)
+	mService.On("GetLoadBalancerBackends", &request.GetLoadBalancerBackendsRequest{ServiceUUID: "abcdef"}).Return([]upcloud.LoadBalancerBackend{
+		{Name: "web"},
+		{Name: "api"},
+	}, nil)
+
+	completions, directive := completion.LoadBalancerBackend{LoadBalancer: "abcdef"}.CompleteArgument(context.TODO(), mService, "a")
+	assert.Equal(t, []string{"api"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
+func TestLoadBalancerBackend_CompleteArgumentUnknownLoadBalancer(t *testing.T) {
+	mService := new(smock.Service)
+	mService.On("GetLoadBalancers", mock.Anything).Return(mockLoadBalancers, nil)
+
+	completions, directive := completion.LoadBalancerBackend{LoadBalancer: "asd"}.CompleteArgument(context.TODO(), mService, "a")
+	assert.Nil(t, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	mService.AssertNotCalled(t, "GetLoadBalancerBackends", mock.Anything)
+}
+
+func TestLoadBalancerCertificateBundle_CompleteArgument(t *testing.T) {
+	mService := new(smock.Service)
+	mService.On("GetLoadBalancerCertificateBundles", mock.Anything).Return([]upcloud.LoadBalancerCertificateBundle{
+		{Name: "example-com", UUID: "0aded5c1-c7a3-498a-b9c8-a871611c47a2"},
+		{Name: "example-ca", UUID: "0a6cb84b-b7a2-4a0b-b6b9-3f2d8c2d1e5f"},
+		{Name: "internal", UUID: "0c1f2b3a-4d5e-4f60-8a9b-0c1d2e3f4a5b"},
+	}, nil)
+
+	completions, directive := completion.LoadBalancerCertificateBundle{}.CompleteArgument(context.TODO(), mService, "example")
+	assert.Equal(t, []string{"example-com", "example-ca"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
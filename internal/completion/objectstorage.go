@@ -27,3 +27,46 @@ func (s ObjectStorage) CompleteArgument(ctx context.Context, svc service.AllServ
 
 	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
 }
+
+// ObjectStoragePolicy implements argument completion for policies of an object storage service, by name.
+type ObjectStoragePolicy struct {
+	ObjectStorage string
+}
+
+// make sure ObjectStoragePolicy implements the interface
+var _ Provider = ObjectStoragePolicy{}
+
+// CompleteArgument implements completion.Provider
+func (s ObjectStoragePolicy) CompleteArgument(ctx context.Context, svc service.AllServices, toComplete string) ([]string, cobra.ShellCompDirective) {
+	uuid, ok := findObjectStorageUUID(ctx, svc, s.ObjectStorage)
+	if !ok {
+		return None(toComplete)
+	}
+
+	policies, err := svc.GetManagedObjectStoragePolicies(ctx, &request.GetManagedObjectStoragePoliciesRequest{ServiceUUID: uuid})
+	if err != nil {
+		return None(toComplete)
+	}
+	var vals []string
+	for _, policy := range policies {
+		vals = append(vals, policy.Name)
+	}
+	return MatchStringPrefix(vals, toComplete, true), cobra.ShellCompDirectiveNoFileComp
+}
+
+func findObjectStorageUUID(ctx context.Context, svc service.AllServices, arg string) (string, bool) {
+	if arg == "" {
+		return "", false
+	}
+
+	objectstorages, err := svc.GetManagedObjectStorages(ctx, &request.GetManagedObjectStoragesRequest{})
+	if err != nil {
+		return "", false
+	}
+	for _, objsto := range objectstorages {
+		if objsto.UUID == arg || objsto.Name == arg {
+			return objsto.UUID, true
+		}
+	}
+	return "", false
+}
@@ -34,6 +34,8 @@ const (
 	ValueOutputYAML = "yaml"
 	// ValueOutputJSON defines the viper configuration value used to define JSON output
 	ValueOutputJSON = "json"
+	// ValueOutputSpec defines the viper configuration value used to define resource spec output
+	ValueOutputSpec = "spec"
 
 	// env vars custom prefix
 	envPrefix = "UPCLOUD"
@@ -57,7 +59,7 @@ type GlobalFlags struct {
 	ConfigFile    string        `valid:"-"`
 	ClientTimeout time.Duration `valid:"-"`
 	Debug         bool          `valid:"-"`
-	OutputFormat  string        `valid:"in(human|json|yaml)"`
+	OutputFormat  string        `valid:"in(human|json|yaml|spec)"`
 	NoColours     OptionalBoolean
 	ForceColours  OptionalBoolean
 }
@@ -263,6 +265,20 @@ func SaveTokenToKeyring(token string) error {
 	return keyring.Set(credentials.KeyringServiceName, credentials.KeyringTokenUser, token)
 }
 
+// SaveObjectStorageSecretToKeyring saves the secret access key of a managed object storage access key to the system keyring.
+func SaveObjectStorageSecretToKeyring(accessKeyID, secretAccessKey string) error {
+	return keyring.Set(credentials.KeyringServiceName, objectStorageKeyringUser(accessKeyID), secretAccessKey)
+}
+
+// GetObjectStorageSecretFromKeyring returns the secret access key of a managed object storage access key from the system keyring.
+func GetObjectStorageSecretFromKeyring(accessKeyID string) (string, error) {
+	return keyring.Get(credentials.KeyringServiceName, objectStorageKeyringUser(accessKeyID))
+}
+
+func objectStorageKeyringUser(accessKeyID string) string {
+	return "object-storage/" + accessKeyID
+}
+
 func getVersion() string {
 	// Version was overridden during the build
 	if Version != "dev" {
@@ -59,7 +59,8 @@ func BuildRootCmd(conf *config.Config) cobra.Command {
 			// Validate viper output binding too
 			if conf.Output() != config.ValueOutputHuman &&
 				conf.Output() != config.ValueOutputJSON &&
-				conf.Output() != config.ValueOutputYAML {
+				conf.Output() != config.ValueOutputYAML &&
+				conf.Output() != config.ValueOutputSpec {
 				return fmt.Errorf("output format '%v' not accepted", conf.Output())
 			}
 
@@ -71,10 +72,10 @@ func BuildRootCmd(conf *config.Config) cobra.Command {
 	flags.StringVarP(
 		&conf.GlobalFlags.ConfigFile, "config", "", "", "Configuration file path.",
 	)
-	outputFormats := []string{config.ValueOutputHuman, config.ValueOutputJSON, config.ValueOutputYAML}
+	outputFormats := []string{config.ValueOutputHuman, config.ValueOutputJSON, config.ValueOutputYAML, config.ValueOutputSpec}
 	flags.StringVarP(
 		&conf.GlobalFlags.OutputFormat, "output", "o", "human",
-		"Output format. Valid values are "+namedargs.ValidValuesHelp(outputFormats...)+".",
+		"Output format. Valid values are "+namedargs.ValidValuesHelp(outputFormats...)+". Spec output is only supported by `server show`.",
 	)
 	config.AddToggleFlag(flags, &conf.GlobalFlags.ForceColours, "force-colours", false, "Force coloured output despite detected terminal support.")
 	config.AddToggleFlag(flags, &conf.GlobalFlags.NoColours, "no-colours", false, "Disable coloured output despite detected terminal support. Colours can also be disabled by setting NO_COLOR environment variable.")
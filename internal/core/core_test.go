@@ -31,7 +31,7 @@ func TestInputValidation(t *testing.T) {
 				"--output", "toml",
 				"version",
 			},
-			error: "OutputFormat: toml does not validate as in(human|json|yaml)",
+			error: "OutputFormat: toml does not validate as in(human|json|yaml|spec)",
 		},
 		{
 			name: "validate config flag",
@@ -0,0 +1,71 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// fileStorageOperationalStateColour maps file storage operational states to colours
+func fileStorageOperationalStateColour(state upcloud.FileStorageOperationalState) text.Colors {
+	switch state {
+	case upcloud.FileStorageOperationalStateRunning:
+		return text.Colors{text.FgGreen}
+	case upcloud.FileStorageOperationalStateStopped:
+		return text.Colors{text.FgHiBlack}
+	default:
+		return text.Colors{text.FgYellow}
+	}
+}
+
+// FileStorageOperationalState implements Format function for file storage operational states
+func FileStorageOperationalState(val any) (text.Colors, string, error) {
+	return usingColorFunction(fileStorageOperationalStateColour, val)
+}
+
+// fileStorageConfiguredStatusColour maps file storage configured statuses to colours
+func fileStorageConfiguredStatusColour(state upcloud.FileStorageConfiguredStatus) text.Colors {
+	switch state {
+	case upcloud.FileStorageConfiguredStatusStarted:
+		return text.Colors{text.FgGreen}
+	default:
+		return text.Colors{text.FgHiBlack}
+	}
+}
+
+// FileStorageConfiguredStatus implements Format function for file storage configured statuses
+func FileStorageConfiguredStatus(val any) (text.Colors, string, error) {
+	return usingColorFunction(fileStorageConfiguredStatusColour, val)
+}
+
+// fileStorageShareACLPermissionColour maps file storage share ACL permissions to colours
+func fileStorageShareACLPermissionColour(permission upcloud.FileStorageShareACLPermission) text.Colors {
+	switch permission {
+	case upcloud.FileStorageShareACLPermissionReadWrite:
+		return text.Colors{text.FgYellow}
+	default:
+		return text.Colors{text.FgGreen}
+	}
+}
+
+// FileStorageShareACLPermission implements Format function for file storage share ACL permissions
+func FileStorageShareACLPermission(val any) (text.Colors, string, error) {
+	return usingColorFunction(fileStorageShareACLPermissionColour, val)
+}
+
+// FileStorageShareACL implements Format function for file storage share ACL entries
+func FileStorageShareACL(val any) (text.Colors, string, error) {
+	acl, ok := val.([]upcloud.FileStorageShareACL)
+	if !ok {
+		return nil, "", fmt.Errorf("cannot parse ACL from %T, expected []upcloud.FileStorageShareACL", val)
+	}
+
+	var rows []string
+	for _, entry := range acl {
+		rows = append(rows, fmt.Sprintf("%s (%s)", entry.Target, entry.Permission))
+	}
+
+	return nil, strings.Join(rows, ",\n"), nil
+}
@@ -0,0 +1,60 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// gatewayOperationalStateColour maps gateway states to colours
+func gatewayOperationalStateColour(state upcloud.GatewayOperationalState) text.Colors {
+	switch state {
+	case upcloud.GatewayOperationalStateRunning:
+		return text.Colors{text.FgGreen}
+	case "":
+		return text.Colors{text.FgHiBlack}
+	default:
+		return text.Colors{text.FgYellow}
+	}
+}
+
+// GatewayState implements Format function for gateway states
+func GatewayState(val any) (text.Colors, string, error) {
+	return usingColorFunction(gatewayOperationalStateColour, val)
+}
+
+// gatewayTunnelOperationalStateColour maps gateway tunnel states to colours
+func gatewayTunnelOperationalStateColour(state upcloud.GatewayTunnelOperationalState) text.Colors {
+	switch state {
+	case upcloud.GatewayTunnelOperationalStateEstablished:
+		return text.Colors{text.FgGreen}
+	case upcloud.GatewayTunnelOperationalStateConnecting:
+		return text.Colors{text.FgYellow}
+	case upcloud.GatewayTunnelOperationalStateUninitialized, upcloud.GatewayTunnelOperationalStateIdle:
+		return text.Colors{text.FgRed}
+	default:
+		return text.Colors{text.FgHiBlack}
+	}
+}
+
+// GatewayTunnelState implements Format function for gateway tunnel states
+func GatewayTunnelState(val any) (text.Colors, string, error) {
+	return usingColorFunction(gatewayTunnelOperationalStateColour, val)
+}
+
+// GatewayRoutes implements Format function for gateway connection routes
+func GatewayRoutes(val any) (text.Colors, string, error) {
+	routes, ok := val.([]upcloud.GatewayRoute)
+	if !ok {
+		return nil, "", fmt.Errorf("cannot parse routes from %T, expected []upcloud.GatewayRoute", val)
+	}
+
+	var rows []string
+	for _, route := range routes {
+		rows = append(rows, fmt.Sprintf("%s (%s)", route.StaticNetwork, route.Name))
+	}
+
+	return nil, strings.Join(rows, ",\n"), nil
+}
@@ -1,6 +1,9 @@
 package format
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
 	"github.com/jedib0t/go-pretty/v6/text"
 )
@@ -21,3 +24,32 @@ func loadBalancerOperationalStateColour(state upcloud.LoadBalancerOperationalSta
 func LoadBalancerState(val any) (text.Colors, string, error) {
 	return usingColorFunction(loadBalancerOperationalStateColour, val)
 }
+
+// certificateExpiryWarningPeriod defines how long before expiry certificates are highlighted as expiring soon
+const certificateExpiryWarningPeriod = 30 * 24 * time.Hour
+
+// certificateExpiryColour maps certificate expiry times to colours
+func certificateExpiryColour(notAfter time.Time) text.Colors {
+	switch remaining := time.Until(notAfter); {
+	case remaining <= 0:
+		return text.Colors{text.FgRed}
+	case remaining <= certificateExpiryWarningPeriod:
+		return text.Colors{text.FgYellow}
+	default:
+		return text.Colors{text.FgGreen}
+	}
+}
+
+// LoadBalancerCertificateExpiry implements Format function for certificate expiry times. Certificates that have expired are highlighted in red and certificates that expire within 30 days in yellow.
+func LoadBalancerCertificateExpiry(val any) (text.Colors, string, error) {
+	notAfter, ok := val.(time.Time)
+	if !ok {
+		return nil, "", fmt.Errorf("cannot parse %T, expected time.Time", val)
+	}
+
+	if notAfter.IsZero() {
+		return nil, text.FgHiBlack.Sprint("unknown"), nil
+	}
+
+	return certificateExpiryColour(notAfter), notAfter.Format(time.DateOnly), nil
+}
@@ -0,0 +1,62 @@
+package format
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadBalancerCertificateExpiry(t *testing.T) {
+	now := time.Now()
+
+	for _, test := range []struct {
+		name     string
+		val      any
+		colours  text.Colors
+		expected string
+		errorMsg string
+	}{
+		{
+			name:     "expired",
+			val:      now.Add(-24 * time.Hour),
+			colours:  text.Colors{text.FgRed},
+			expected: now.Add(-24 * time.Hour).Format(time.DateOnly),
+		},
+		{
+			name:     "expires soon",
+			val:      now.Add(7 * 24 * time.Hour),
+			colours:  text.Colors{text.FgYellow},
+			expected: now.Add(7 * 24 * time.Hour).Format(time.DateOnly),
+		},
+		{
+			name:     "valid",
+			val:      now.Add(90 * 24 * time.Hour),
+			colours:  text.Colors{text.FgGreen},
+			expected: now.Add(90 * 24 * time.Hour).Format(time.DateOnly),
+		},
+		{
+			name:     "not issued yet",
+			val:      time.Time{},
+			expected: text.FgHiBlack.Sprint("unknown"),
+		},
+		{
+			name:     "invalid type",
+			val:      "2030-01-01",
+			errorMsg: "cannot parse string, expected time.Time",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			colours, str, err := LoadBalancerCertificateExpiry(test.val)
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.colours, colours)
+			assert.Equal(t, test.expected, str)
+		})
+	}
+}
@@ -42,3 +42,20 @@ func objectStorageConfiguredStatusColour(state upcloud.ManagedObjectStorageConfi
 func ObjectStorageConfiguredStatus(val any) (text.Colors, string, error) {
 	return usingColorFunction(objectStorageConfiguredStatusColour, val)
 }
+
+// objectStoragePolicyStatementEffectColour maps object storage policy statement effects to colours
+func objectStoragePolicyStatementEffectColour(effect string) text.Colors {
+	switch effect {
+	case "Allow":
+		return text.Colors{text.FgGreen}
+	case "Deny":
+		return text.Colors{text.FgRed}
+	default:
+		return text.Colors{text.FgHiBlack}
+	}
+}
+
+// ObjectStoragePolicyStatementEffect implements Format function for object storage policy statement effects
+func ObjectStoragePolicyStatementEffect(val any) (text.Colors, string, error) {
+	return usingColorFunction(objectStoragePolicyStatementEffectColour, val)
+}
@@ -655,7 +655,11 @@ func (m *Service) CancelManagedDatabaseSession(_ context.Context, r *request.Can
 }
 
 func (m *Service) CloneManagedDatabase(_ context.Context, r *request.CloneManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabase), args.Error(1)
 }
 
 func (m *Service) CreateManagedDatabase(_ context.Context, r *request.CreateManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
@@ -756,7 +760,11 @@ func (m *Service) DeleteManagedDatabase(_ context.Context, r *request.DeleteMana
 }
 
 func (m *Service) ModifyManagedDatabase(_ context.Context, r *request.ModifyManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabase), args.Error(1)
 }
 
 func (m *Service) ModifyManagedDatabaseAccessControl(_ context.Context, r *request.ModifyManagedDatabaseAccessControlRequest) (*upcloud.ManagedDatabaseAccessControl, error) {
@@ -764,11 +772,19 @@ func (m *Service) ModifyManagedDatabaseAccessControl(_ context.Context, r *reque
 }
 
 func (m *Service) UpgradeManagedDatabaseVersion(_ context.Context, r *request.UpgradeManagedDatabaseVersionRequest) (*upcloud.ManagedDatabase, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabase), args.Error(1)
 }
 
 func (m *Service) GetManagedDatabaseVersions(_ context.Context, r *request.GetManagedDatabaseVersionsRequest) ([]string, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]string), args.Error(1)
 }
 
 func (m *Service) StartManagedDatabase(_ context.Context, r *request.StartManagedDatabaseRequest) (*upcloud.ManagedDatabase, error) {
@@ -791,6 +807,101 @@ func (m *Service) WaitForManagedDatabaseState(_ context.Context, r *request.Wait
 	return nil, nil
 }
 
+func (m *Service) CreateManagedDatabaseUser(_ context.Context, r *request.CreateManagedDatabaseUserRequest) (*upcloud.ManagedDatabaseUser, error) {
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseUser), args.Error(1)
+}
+
+func (m *Service) GetManagedDatabaseUser(_ context.Context, r *request.GetManagedDatabaseUserRequest) (*upcloud.ManagedDatabaseUser, error) {
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseUser), args.Error(1)
+}
+
+func (m *Service) GetManagedDatabaseUsers(_ context.Context, r *request.GetManagedDatabaseUsersRequest) ([]upcloud.ManagedDatabaseUser, error) {
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.ManagedDatabaseUser), args.Error(1)
+}
+
+func (m *Service) DeleteManagedDatabaseUser(_ context.Context, r *request.DeleteManagedDatabaseUserRequest) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
+func (m *Service) ModifyManagedDatabaseUser(_ context.Context, r *request.ModifyManagedDatabaseUserRequest) (*upcloud.ManagedDatabaseUser, error) {
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseUser), args.Error(1)
+}
+
+func (m *Service) ModifyManagedDatabaseUserAccessControl(_ context.Context, r *request.ModifyManagedDatabaseUserAccessControlRequest) (*upcloud.ManagedDatabaseUser, error) {
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseUser), args.Error(1)
+}
+
+func (m *Service) CreateManagedDatabaseLogicalDatabase(_ context.Context, r *request.CreateManagedDatabaseLogicalDatabaseRequest) (*upcloud.ManagedDatabaseLogicalDatabase, error) {
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseLogicalDatabase), args.Error(1)
+}
+
+func (m *Service) GetManagedDatabaseLogicalDatabases(_ context.Context, r *request.GetManagedDatabaseLogicalDatabasesRequest) ([]upcloud.ManagedDatabaseLogicalDatabase, error) {
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.ManagedDatabaseLogicalDatabase), args.Error(1)
+}
+
+func (m *Service) DeleteManagedDatabaseLogicalDatabase(_ context.Context, r *request.DeleteManagedDatabaseLogicalDatabaseRequest) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
+func (m *Service) CreateManagedDatabaseConnectionPool(_ context.Context, r *request.CreateManagedDatabaseConnectionPoolRequest) (*upcloud.ManagedDatabaseConnectionPool, error) {
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseConnectionPool), args.Error(1)
+}
+
+func (m *Service) GetManagedDatabaseConnectionPools(_ context.Context, r *request.GetManagedDatabaseConnectionPoolsRequest) ([]upcloud.ManagedDatabaseConnectionPool, error) {
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.ManagedDatabaseConnectionPool), args.Error(1)
+}
+
+func (m *Service) ModifyManagedDatabaseConnectionPool(_ context.Context, r *request.ModifyManagedDatabaseConnectionPoolRequest) (*upcloud.ManagedDatabaseConnectionPool, error) {
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedDatabaseConnectionPool), args.Error(1)
+}
+
+func (m *Service) DeleteManagedDatabaseConnectionPool(_ context.Context, r *request.DeleteManagedDatabaseConnectionPoolRequest) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
 func (m *Service) GetLoadBalancers(_ context.Context, r *request.GetLoadBalancersRequest) ([]upcloud.LoadBalancer, error) {
 	args := m.Called(r)
 	if args[0] == nil {
@@ -800,15 +911,27 @@ func (m *Service) GetLoadBalancers(_ context.Context, r *request.GetLoadBalancer
 }
 
 func (m *Service) GetLoadBalancer(_ context.Context, r *request.GetLoadBalancerRequest) (*upcloud.LoadBalancer, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancer), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancer(_ context.Context, r *request.CreateLoadBalancerRequest) (*upcloud.LoadBalancer, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancer), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancer(_ context.Context, r *request.ModifyLoadBalancerRequest) (*upcloud.LoadBalancer, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancer), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancer(_ context.Context, r *request.DeleteLoadBalancerRequest) error {
@@ -817,7 +940,11 @@ func (m *Service) DeleteLoadBalancer(_ context.Context, r *request.DeleteLoadBal
 }
 
 func (m *Service) GetLoadBalancerBackends(_ context.Context, r *request.GetLoadBalancerBackendsRequest) ([]upcloud.LoadBalancerBackend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerBackend), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerBackend(_ context.Context, r *request.GetLoadBalancerBackendRequest) (*upcloud.LoadBalancerBackend, error) {
@@ -825,15 +952,24 @@ func (m *Service) GetLoadBalancerBackend(_ context.Context, r *request.GetLoadBa
 }
 
 func (m *Service) CreateLoadBalancerBackend(_ context.Context, r *request.CreateLoadBalancerBackendRequest) (*upcloud.LoadBalancerBackend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackend), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerBackend(_ context.Context, r *request.ModifyLoadBalancerBackendRequest) (*upcloud.LoadBalancerBackend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackend), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerBackend(_ context.Context, r *request.DeleteLoadBalancerBackendRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerBackendMembers(_ context.Context, r *request.GetLoadBalancerBackendMembersRequest) ([]upcloud.LoadBalancerBackendMember, error) {
@@ -845,15 +981,24 @@ func (m *Service) GetLoadBalancerBackendMember(_ context.Context, r *request.Get
 }
 
 func (m *Service) CreateLoadBalancerBackendMember(_ context.Context, r *request.CreateLoadBalancerBackendMemberRequest) (*upcloud.LoadBalancerBackendMember, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackendMember), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerBackendMember(_ context.Context, r *request.ModifyLoadBalancerBackendMemberRequest) (*upcloud.LoadBalancerBackendMember, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerBackendMember), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerBackendMember(_ context.Context, r *request.DeleteLoadBalancerBackendMemberRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerBackendTLSConfigs(_ context.Context, r *request.GetLoadBalancerBackendTLSConfigsRequest) ([]upcloud.LoadBalancerBackendTLSConfig, error) {
@@ -877,31 +1022,56 @@ func (m *Service) DeleteLoadBalancerBackendTLSConfig(_ context.Context, r *reque
 }
 
 func (m *Service) GetLoadBalancerResolvers(_ context.Context, r *request.GetLoadBalancerResolversRequest) ([]upcloud.LoadBalancerResolver, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerResolver), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerResolver(_ context.Context, r *request.CreateLoadBalancerResolverRequest) (*upcloud.LoadBalancerResolver, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerResolver), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerResolver(_ context.Context, r *request.GetLoadBalancerResolverRequest) (*upcloud.LoadBalancerResolver, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerResolver), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerResolver(_ context.Context, r *request.ModifyLoadBalancerResolverRequest) (*upcloud.LoadBalancerResolver, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerResolver), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerResolver(_ context.Context, r *request.DeleteLoadBalancerResolverRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerPlans(_ context.Context, r *request.GetLoadBalancerPlansRequest) ([]upcloud.LoadBalancerPlan, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerPlan), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerFrontends(_ context.Context, r *request.GetLoadBalancerFrontendsRequest) ([]upcloud.LoadBalancerFrontend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerFrontend), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerFrontend(_ context.Context, r *request.GetLoadBalancerFrontendRequest) (*upcloud.LoadBalancerFrontend, error) {
@@ -909,39 +1079,69 @@ func (m *Service) GetLoadBalancerFrontend(_ context.Context, r *request.GetLoadB
 }
 
 func (m *Service) CreateLoadBalancerFrontend(_ context.Context, r *request.CreateLoadBalancerFrontendRequest) (*upcloud.LoadBalancerFrontend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontend), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerFrontend(_ context.Context, r *request.ModifyLoadBalancerFrontendRequest) (*upcloud.LoadBalancerFrontend, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontend), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerFrontend(_ context.Context, r *request.DeleteLoadBalancerFrontendRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerFrontendRules(_ context.Context, r *request.GetLoadBalancerFrontendRulesRequest) ([]upcloud.LoadBalancerFrontendRule, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerFrontendRule), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerFrontendRule(_ context.Context, r *request.GetLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendRule), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerFrontendRule(_ context.Context, r *request.CreateLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendRule), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerFrontendRule(_ context.Context, r *request.ModifyLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendRule), args.Error(1)
 }
 
 func (m *Service) ReplaceLoadBalancerFrontendRule(_ context.Context, r *request.ReplaceLoadBalancerFrontendRuleRequest) (*upcloud.LoadBalancerFrontendRule, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerFrontendRule), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerFrontendRule(_ context.Context, r *request.DeleteLoadBalancerFrontendRuleRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetLoadBalancerFrontendTLSConfigs(_ context.Context, r *request.GetLoadBalancerFrontendTLSConfigsRequest) ([]upcloud.LoadBalancerFrontendTLSConfig, error) {
@@ -965,23 +1165,40 @@ func (m *Service) DeleteLoadBalancerFrontendTLSConfig(_ context.Context, r *requ
 }
 
 func (m *Service) GetLoadBalancerCertificateBundles(_ context.Context, r *request.GetLoadBalancerCertificateBundlesRequest) ([]upcloud.LoadBalancerCertificateBundle, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.LoadBalancerCertificateBundle), args.Error(1)
 }
 
 func (m *Service) GetLoadBalancerCertificateBundle(_ context.Context, r *request.GetLoadBalancerCertificateBundleRequest) (*upcloud.LoadBalancerCertificateBundle, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerCertificateBundle), args.Error(1)
 }
 
 func (m *Service) CreateLoadBalancerCertificateBundle(_ context.Context, r *request.CreateLoadBalancerCertificateBundleRequest) (*upcloud.LoadBalancerCertificateBundle, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerCertificateBundle), args.Error(1)
 }
 
 func (m *Service) ModifyLoadBalancerCertificateBundle(_ context.Context, r *request.ModifyLoadBalancerCertificateBundleRequest) (*upcloud.LoadBalancerCertificateBundle, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.LoadBalancerCertificateBundle), args.Error(1)
 }
 
 func (m *Service) DeleteLoadBalancerCertificateBundle(_ context.Context, r *request.DeleteLoadBalancerCertificateBundleRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) ModifyLoadBalancerNetwork(ctx context.Context, r *request.ModifyLoadBalancerNetworkRequest) (*upcloud.LoadBalancerNetwork, error) {
@@ -1198,7 +1415,11 @@ func (m *Service) GetManagedObjectStorages(ctx context.Context, r *request.GetMa
 }
 
 func (m *Service) GetManagedObjectStorage(ctx context.Context, r *request.GetManagedObjectStorageRequest) (*upcloud.ManagedObjectStorage, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedObjectStorage), args.Error(1)
 }
 
 func (m *Service) ReplaceManagedObjectStorage(ctx context.Context, r *request.ReplaceManagedObjectStorageRequest) (*upcloud.ManagedObjectStorage, error) {
@@ -1302,19 +1523,32 @@ func (m *Service) WaitForManagedObjectStorageBucketDeletion(ctx context.Context,
 }
 
 func (m *Service) CreateManagedObjectStoragePolicy(ctx context.Context, r *request.CreateManagedObjectStoragePolicyRequest) (*upcloud.ManagedObjectStoragePolicy, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedObjectStoragePolicy), args.Error(1)
 }
 
 func (m *Service) GetManagedObjectStoragePolicies(ctx context.Context, r *request.GetManagedObjectStoragePoliciesRequest) ([]upcloud.ManagedObjectStoragePolicy, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.ManagedObjectStoragePolicy), args.Error(1)
 }
 
 func (m *Service) GetManagedObjectStoragePolicy(ctx context.Context, r *request.GetManagedObjectStoragePolicyRequest) (*upcloud.ManagedObjectStoragePolicy, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedObjectStoragePolicy), args.Error(1)
 }
 
 func (m *Service) DeleteManagedObjectStoragePolicy(ctx context.Context, r *request.DeleteManagedObjectStoragePolicyRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) AttachManagedObjectStorageUserPolicy(ctx context.Context, r *request.AttachManagedObjectStorageUserPolicyRequest) error {
@@ -1330,19 +1564,32 @@ func (m *Service) DetachManagedObjectStorageUserPolicy(ctx context.Context, r *r
 }
 
 func (m *Service) CreateManagedObjectStoragePolicyVersion(ctx context.Context, r *request.CreateManagedObjectStoragePolicyVersionRequest) (*upcloud.ManagedObjectStoragePolicyVersion, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedObjectStoragePolicyVersion), args.Error(1)
 }
 
 func (m *Service) GetManagedObjectStoragePolicyVersion(ctx context.Context, r *request.GetManagedObjectStoragePolicyVersionRequest) (*upcloud.ManagedObjectStoragePolicyVersion, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.ManagedObjectStoragePolicyVersion), args.Error(1)
 }
 
 func (m *Service) GetManagedObjectStoragePolicyVersions(ctx context.Context, r *request.GetManagedObjectStoragePolicyVersionsRequest) ([]upcloud.ManagedObjectStoragePolicyVersion, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.ManagedObjectStoragePolicyVersion), args.Error(1)
 }
 
 func (m *Service) DeleteManagedObjectStoragePolicyVersion(ctx context.Context, r *request.DeleteManagedObjectStoragePolicyVersionRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) CreateManagedObjectStorageCustomDomain(ctx context.Context, r *request.CreateManagedObjectStorageCustomDomainRequest) error {
@@ -1452,39 +1699,69 @@ func (m *Service) GetGatewayMetrics(ctx context.Context, r *request.GetGatewayMe
 }
 
 func (m *Service) GetGatewayConnections(ctx context.Context, r *request.GetGatewayConnectionsRequest) ([]upcloud.GatewayConnection, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.GatewayConnection), args.Error(1)
 }
 
 func (m *Service) GetGatewayConnection(ctx context.Context, r *request.GetGatewayConnectionRequest) (*upcloud.GatewayConnection, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayConnection), args.Error(1)
 }
 
 func (m *Service) CreateGatewayConnection(ctx context.Context, r *request.CreateGatewayConnectionRequest) (*upcloud.GatewayConnection, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayConnection), args.Error(1)
 }
 
 func (m *Service) ModifyGatewayConnection(ctx context.Context, r *request.ModifyGatewayConnectionRequest) (*upcloud.GatewayConnection, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayConnection), args.Error(1)
 }
 
 func (m *Service) DeleteGatewayConnection(ctx context.Context, r *request.DeleteGatewayConnectionRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetGatewayConnectionTunnels(ctx context.Context, r *request.GetGatewayConnectionTunnelsRequest) ([]upcloud.GatewayTunnel, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].([]upcloud.GatewayTunnel), args.Error(1)
 }
 
 func (m *Service) GetGatewayConnectionTunnel(ctx context.Context, r *request.GetGatewayConnectionTunnelRequest) (*upcloud.GatewayTunnel, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayTunnel), args.Error(1)
 }
 
 func (m *Service) CreateGatewayConnectionTunnel(ctx context.Context, r *request.CreateGatewayConnectionTunnelRequest) (*upcloud.GatewayTunnel, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.GatewayTunnel), args.Error(1)
 }
 
 func (m *Service) DeleteGatewayConnectionTunnel(ctx context.Context, r *request.DeleteGatewayConnectionTunnelRequest) error {
-	return nil
+	args := m.Called(r)
+	return args.Error(0)
 }
 
 func (m *Service) GetNetworkPeerings(ctx context.Context, f ...request.QueryFilter) (upcloud.NetworkPeerings, error) {
@@ -1492,15 +1769,27 @@ func (m *Service) GetNetworkPeerings(ctx context.Context, f ...request.QueryFilt
 }
 
 func (m *Service) GetNetworkPeering(ctx context.Context, r *request.GetNetworkPeeringRequest) (*upcloud.NetworkPeering, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.NetworkPeering), args.Error(1)
 }
 
 func (m *Service) CreateNetworkPeering(ctx context.Context, r *request.CreateNetworkPeeringRequest) (*upcloud.NetworkPeering, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.NetworkPeering), args.Error(1)
 }
 
 func (m *Service) ModifyNetworkPeering(ctx context.Context, r *request.ModifyNetworkPeeringRequest) (*upcloud.NetworkPeering, error) {
-	return nil, nil
+	args := m.Called(r)
+	if args[0] == nil {
+		return nil, args.Error(1)
+	}
+	return args[0].(*upcloud.NetworkPeering), args.Error(1)
 }
 
 func (m *Service) DeleteNetworkPeering(ctx context.Context, r *request.DeleteNetworkPeeringRequest) error {
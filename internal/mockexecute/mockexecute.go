@@ -29,6 +29,24 @@ func MockExecute(command commands.Command, service service.AllServices, conf *co
 	return buf.String(), err
 }
 
+// MockExecuteRunCommand executes the command like MockExecute, but through commands.RunCommand. This runs the command with the same argument resolution, workers, and progress log handling as the CLI.
+func MockExecuteRunCommand(command commands.Command, service service.AllServices, conf *config.Config) (string, error) {
+	buf := bytes.NewBuffer(nil)
+	command.Cobra().SetErr(buf)
+	command.Cobra().SetOut(buf)
+
+	if !conf.IsSet(config.KeyOutput) {
+		conf.Viper().Set(config.KeyOutput, config.ValueOutputHuman)
+	}
+
+	command.Cobra().RunE = func(_ *cobra.Command, args []string) error {
+		return commands.RunCommand(command, service, conf, args)
+	}
+	err := command.Cobra().Execute()
+
+	return buf.String(), err
+}
+
 func mockRunE(command commands.Command, service service.AllServices, conf *config.Config, args []string) error {
 	executor := commands.NewExecutor(conf, service, conf.NewLogger("test"))
 
@@ -19,3 +19,19 @@ func CompletionFunc(provider completion.Provider, cfg *config.Config) CompleteFu
 		return provider.CompleteArgument(cfg.Context(), svc, toComplete)
 	}
 }
+
+// CompletionFuncWithArgs creates a flag completion function from a completion provider that depends on the positional arguments of the command, e.g. flags that complete sub-resources of the resource given as the first positional argument.
+func CompletionFuncWithArgs(providerFn func(args []string) completion.Provider, cfg *config.Config) CompleteFunc {
+	return func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return completion.None(toComplete)
+		}
+
+		svc, err := cfg.CreateService()
+		if err != nil {
+			return completion.None(toComplete)
+		}
+
+		return providerFn(args).CompleteArgument(cfg.Context(), svc, toComplete)
+	}
+}
@@ -0,0 +1,18 @@
+package namedargs
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+)
+
+// ResolveFileStorageShare resolves name of a file storage share from values provided to named args (e.g., --name data)
+func ResolveFileStorageShare(exec commands.Executor, serviceUUID, arg string) (string, error) {
+	name, err := Resolve(&resolver.CachingFileStorageShare{ServiceUUID: serviceUUID}, exec, arg)
+	if err != nil {
+		err = fmt.Errorf("could not resolve share: %w", err)
+	}
+
+	return name, err
+}
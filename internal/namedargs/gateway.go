@@ -0,0 +1,28 @@
+package namedargs
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+)
+
+// ResolveGatewayConnection resolves name of a gateway connection from values provided to named args (e.g., --connection office)
+func ResolveGatewayConnection(exec commands.Executor, serviceUUID, arg string) (string, error) {
+	name, err := Resolve(&resolver.CachingGatewayConnection{ServiceUUID: serviceUUID}, exec, arg)
+	if err != nil {
+		err = fmt.Errorf("could not resolve connection: %w", err)
+	}
+
+	return name, err
+}
+
+// ResolveGatewayConnectionTunnel resolves name of a gateway connection tunnel from values provided to named args (e.g., --name tunnel-1)
+func ResolveGatewayConnectionTunnel(exec commands.Executor, serviceUUID, connectionName, arg string) (string, error) {
+	name, err := Resolve(&resolver.CachingGatewayConnectionTunnel{ServiceUUID: serviceUUID, ConnectionName: connectionName}, exec, arg)
+	if err != nil {
+		err = fmt.Errorf("could not resolve tunnel: %w", err)
+	}
+
+	return name, err
+}
@@ -0,0 +1,28 @@
+package namedargs
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+)
+
+// ResolveLoadBalancerFrontend resolves name of a load balancer frontend from values provided to named args (e.g., --frontend web)
+func ResolveLoadBalancerFrontend(exec commands.Executor, serviceUUID, arg string) (string, error) {
+	name, err := Resolve(&resolver.CachingLoadBalancerFrontend{ServiceUUID: serviceUUID}, exec, arg)
+	if err != nil {
+		err = fmt.Errorf("could not resolve frontend: %w", err)
+	}
+
+	return name, err
+}
+
+// ResolveLoadBalancerBackend resolves name of a load balancer backend from values provided to named args (e.g., --backend api)
+func ResolveLoadBalancerBackend(exec commands.Executor, serviceUUID, arg string) (string, error) {
+	name, err := Resolve(&resolver.CachingLoadBalancerBackend{ServiceUUID: serviceUUID}, exec, arg)
+	if err != nil {
+		err = fmt.Errorf("could not resolve backend: %w", err)
+	}
+
+	return name, err
+}
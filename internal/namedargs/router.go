@@ -0,0 +1,18 @@
+package namedargs
+
+import (
+	"fmt"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/commands"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
+)
+
+// ResolveRouter resolves router UUID from values provided to named args (e.g., --router my-router)
+func ResolveRouter(exec commands.Executor, arg string) (string, error) {
+	router, err := Resolve(&resolver.CachingRouter{}, exec, arg)
+	if err != nil {
+		err = fmt.Errorf("could not resolve router: %w", err)
+	}
+
+	return router, err
+}
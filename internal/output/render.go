@@ -14,6 +14,7 @@ const (
 	formatHuman string = "human"
 	formatJSON  string = "json"
 	formatYAML  string = "yaml"
+	formatSpec  string = "spec"
 )
 
 func formats() []string {
@@ -21,6 +22,7 @@ func formats() []string {
 		formatHuman,
 		formatJSON,
 		formatYAML,
+		formatSpec,
 	}
 }
 
@@ -38,6 +40,15 @@ func Render(writer io.Writer, outputFormat string, commandOutputs ...Output) (er
 		}
 	case formatYAML:
 		b, err = toYAML(commandOutputs...)
+	case formatSpec:
+		// Commands that support spec output return the spec as raw output.
+		for _, commandOutput := range commandOutputs {
+			switch commandOutput.(type) {
+			case Raw, Error, None:
+			default:
+				err = fmt.Errorf("output format %s is not supported by this command", outputFormat)
+			}
+		}
 	default:
 		err = fmt.Errorf("output format not valid: %s, valid formats: %v", outputFormat, formats())
 	}
@@ -52,18 +63,24 @@ func Render(writer io.Writer, outputFormat string, commandOutputs ...Output) (er
 
 	// Render streaming outputs and count failed ones
 	failedCount := 0
+	var outputErr error
 	for _, commandOutput := range commandOutputs {
 		if rawOutput, ok := commandOutput.(Raw); ok {
 			_, cErr := io.Copy(writer, rawOutput)
 			err = errors.Join(err, cErr, rawOutput.Close())
 		} else if _, ok := commandOutput.(Error); ok {
 			failedCount++
+		} else if withError, ok := commandOutput.(WithError); ok && outputErr == nil {
+			outputErr = withError.Err
 		}
 	}
 
 	if err != nil {
 		return err
 	}
+	if outputErr != nil {
+		return outputErr
+	}
 	if failedCount > 0 {
 		return &clierrors.CommandFailedError{
 			FailedCount: failedCount,
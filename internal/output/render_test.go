@@ -94,3 +94,28 @@ func validateOutput(t *testing.T, expectedOutput string, expectedErrorMessage st
 		}
 	}
 }
+
+func TestRenderWithError(t *testing.T) {
+	out := new(bytes.Buffer)
+	cfg := config.New()
+	cfg.Viper().Set(config.KeyOutput, "json")
+
+	err := output.Render(out, cfg.Output(), output.WithError{
+		Output: output.OnlyMarshaled{Value: "hello"},
+		Err:    errors.New("MOCKERROR"),
+	})
+	assert.EqualError(t, err, "MOCKERROR")
+	assert.Equal(t, "\"hello\"\n", out.String())
+}
+
+func TestRenderSpec(t *testing.T) {
+	out := new(bytes.Buffer)
+	err := output.Render(out, config.ValueOutputSpec, output.Raw{Source: io.NopCloser(strings.NewReader("---\nhostname: example\n"))})
+	assert.NoError(t, err)
+	assert.Equal(t, "---\nhostname: example\n", out.String())
+
+	out.Reset()
+	err = output.Render(out, config.ValueOutputSpec, output.OnlyMarshaled{Value: "hello"})
+	assert.EqualError(t, err, "output format spec is not supported by this command")
+	assert.Empty(t, out.String())
+}
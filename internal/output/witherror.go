@@ -0,0 +1,8 @@
+package output
+
+// WithError implements output.Output for a return value that is rendered normally, but should still make the command fail afterwards,
+// eg. reports that found problems in the inspected resources
+type WithError struct {
+	Output
+	Err error
+}
@@ -43,3 +43,41 @@ func (s *CachingFileStorage) Get(ctx context.Context, svc internal.AllServices)
 func (s CachingFileStorage) PositionalArgumentHelp() string {
 	return helpUUIDTitle
 }
+
+// CachingFileStorageShare implements resolver for shares of a file storage service, by name. The resolved value is the share name.
+type CachingFileStorageShare struct {
+	Cache[upcloud.FileStorageShare]
+
+	ServiceUUID string
+}
+
+// make sure we implement the ResolutionProvider interface
+var (
+	_ ResolutionProvider                                  = &CachingFileStorageShare{}
+	_ CachingResolutionProvider[upcloud.FileStorageShare] = &CachingFileStorageShare{}
+)
+
+// Get implements ResolutionProvider.Get
+func (s *CachingFileStorageShare) Get(ctx context.Context, svc internal.AllServices) (Resolver, error) {
+	shares, err := svc.GetFileStorageShares(ctx, &request.GetFileStorageSharesRequest{ServiceUUID: s.ServiceUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, share := range shares {
+		s.AddCached(share.Name, share)
+	}
+
+	return func(arg string) Resolved {
+		rv := Resolved{Arg: arg}
+		for _, share := range shares {
+			rv.AddMatch(share.Name, MatchTitle(arg, share.Name))
+		}
+		return rv
+	}, nil
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s CachingFileStorageShare) PositionalArgumentHelp() string {
+	return helpName
+}
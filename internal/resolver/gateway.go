@@ -4,6 +4,8 @@ import (
 	"context"
 
 	internal "github.com/UpCloudLtd/upcloud-cli/v3/internal/service"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 )
 
 // CachingGatewayimplements resolver for gateways, caching the results
@@ -32,3 +34,83 @@ func (s CachingGateway) Get(ctx context.Context, svc internal.AllServices) (Reso
 func (s CachingGateway) PositionalArgumentHelp() string {
 	return helpUUIDTitle
 }
+
+// CachingGatewayConnection implements resolver for connections of a gateway, by name. The resolved value is the connection name.
+type CachingGatewayConnection struct {
+	Cache[upcloud.GatewayConnection]
+
+	ServiceUUID string
+}
+
+// make sure we implement the ResolutionProvider interface
+var (
+	_ ResolutionProvider                                   = &CachingGatewayConnection{}
+	_ CachingResolutionProvider[upcloud.GatewayConnection] = &CachingGatewayConnection{}
+)
+
+// Get implements ResolutionProvider.Get
+func (s *CachingGatewayConnection) Get(ctx context.Context, svc internal.AllServices) (Resolver, error) {
+	connections, err := svc.GetGatewayConnections(ctx, &request.GetGatewayConnectionsRequest{ServiceUUID: s.ServiceUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, connection := range connections {
+		s.AddCached(connection.Name, connection)
+	}
+
+	return func(arg string) Resolved {
+		rv := Resolved{Arg: arg}
+		for _, conn := range connections {
+			rv.AddMatch(conn.Name, MatchTitle(arg, conn.Name))
+		}
+		return rv
+	}, nil
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s CachingGatewayConnection) PositionalArgumentHelp() string {
+	return helpName
+}
+
+// CachingGatewayConnectionTunnel implements resolver for tunnels of a gateway connection, by name. The resolved value is the tunnel name.
+type CachingGatewayConnectionTunnel struct {
+	Cache[upcloud.GatewayTunnel]
+
+	ServiceUUID    string
+	ConnectionName string
+}
+
+// make sure we implement the ResolutionProvider interface
+var (
+	_ ResolutionProvider                               = &CachingGatewayConnectionTunnel{}
+	_ CachingResolutionProvider[upcloud.GatewayTunnel] = &CachingGatewayConnectionTunnel{}
+)
+
+// Get implements ResolutionProvider.Get
+func (s *CachingGatewayConnectionTunnel) Get(ctx context.Context, svc internal.AllServices) (Resolver, error) {
+	tunnels, err := svc.GetGatewayConnectionTunnels(ctx, &request.GetGatewayConnectionTunnelsRequest{
+		ServiceUUID:    s.ServiceUUID,
+		ConnectionName: s.ConnectionName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tunnel := range tunnels {
+		s.AddCached(tunnel.Name, tunnel)
+	}
+
+	return func(arg string) Resolved {
+		rv := Resolved{Arg: arg}
+		for _, tun := range tunnels {
+			rv.AddMatch(tun.Name, MatchTitle(arg, tun.Name))
+		}
+		return rv
+	}, nil
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s CachingGatewayConnectionTunnel) PositionalArgumentHelp() string {
+	return helpName
+}
@@ -44,3 +44,121 @@ func (s *CachingLoadBalancer) Get(ctx context.Context, svc internal.AllServices)
 func (s CachingLoadBalancer) PositionalArgumentHelp() string {
 	return helpUUIDName
 }
+
+// CachingLoadBalancerFrontend implements resolver for frontends of a load balancer, by name. The resolved value is the frontend name.
+type CachingLoadBalancerFrontend struct {
+	Cache[upcloud.LoadBalancerFrontend]
+
+	ServiceUUID string
+}
+
+// make sure we implement the ResolutionProvider interface
+var (
+	_ ResolutionProvider                                      = &CachingLoadBalancerFrontend{}
+	_ CachingResolutionProvider[upcloud.LoadBalancerFrontend] = &CachingLoadBalancerFrontend{}
+)
+
+// Get implements ResolutionProvider.Get
+func (s *CachingLoadBalancerFrontend) Get(ctx context.Context, svc internal.AllServices) (Resolver, error) {
+	frontends, err := svc.GetLoadBalancerFrontends(ctx, &request.GetLoadBalancerFrontendsRequest{ServiceUUID: s.ServiceUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, frontend := range frontends {
+		s.AddCached(frontend.Name, frontend)
+	}
+
+	return func(arg string) Resolved {
+		rv := Resolved{Arg: arg}
+		for _, fe := range frontends {
+			rv.AddMatch(fe.Name, MatchTitle(arg, fe.Name))
+		}
+		return rv
+	}, nil
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s CachingLoadBalancerFrontend) PositionalArgumentHelp() string {
+	return helpName
+}
+
+// CachingLoadBalancerBackend implements resolver for backends of a load balancer, by name. The resolved value is the backend name.
+type CachingLoadBalancerBackend struct {
+	Cache[upcloud.LoadBalancerBackend]
+
+	ServiceUUID string
+}
+
+// make sure we implement the ResolutionProvider interface
+var (
+	_ ResolutionProvider                                     = &CachingLoadBalancerBackend{}
+	_ CachingResolutionProvider[upcloud.LoadBalancerBackend] = &CachingLoadBalancerBackend{}
+)
+
+// Get implements ResolutionProvider.Get
+func (s *CachingLoadBalancerBackend) Get(ctx context.Context, svc internal.AllServices) (Resolver, error) {
+	backends, err := svc.GetLoadBalancerBackends(ctx, &request.GetLoadBalancerBackendsRequest{ServiceUUID: s.ServiceUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, backend := range backends {
+		s.AddCached(backend.Name, backend)
+	}
+
+	return func(arg string) Resolved {
+		rv := Resolved{Arg: arg}
+		for _, be := range backends {
+			rv.AddMatch(be.Name, MatchTitle(arg, be.Name))
+		}
+		return rv
+	}, nil
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s CachingLoadBalancerBackend) PositionalArgumentHelp() string {
+	return helpName
+}
+
+// CachingLoadBalancerCertificateBundle implements resolver for load balancer certificate bundles, caching the results
+type CachingLoadBalancerCertificateBundle struct {
+	Cache[upcloud.LoadBalancerCertificateBundle]
+}
+
+// make sure we implement the ResolutionProvider interface
+var (
+	_ ResolutionProvider                                               = &CachingLoadBalancerCertificateBundle{}
+	_ CachingResolutionProvider[upcloud.LoadBalancerCertificateBundle] = &CachingLoadBalancerCertificateBundle{}
+)
+
+// Get implements ResolutionProvider.Get
+func (s *CachingLoadBalancerCertificateBundle) Get(ctx context.Context, svc internal.AllServices) (Resolver, error) {
+	bundles, err := svc.GetLoadBalancerCertificateBundles(ctx, &request.GetLoadBalancerCertificateBundlesRequest{
+		Page: &request.Page{
+			Number: 0,
+			Size:   100,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bundle := range bundles {
+		s.AddCached(bundle.UUID, bundle)
+	}
+
+	return func(arg string) Resolved {
+		rv := Resolved{Arg: arg}
+		for _, cb := range bundles {
+			rv.AddMatch(cb.UUID, MatchTitle(arg, cb.Name))
+			rv.AddMatch(cb.UUID, MatchUUID(arg, cb.UUID))
+		}
+		return rv
+	}, nil
+}
+
+// PositionalArgumentHelp implements resolver.ResolutionProvider
+func (s CachingLoadBalancerCertificateBundle) PositionalArgumentHelp() string {
+	return helpUUIDName
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/UpCloudLtd/upcloud-cli/v3/internal/resolver"
 
 	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud"
+	"github.com/UpCloudLtd/upcloud-go-api/v8/upcloud/request"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -92,3 +93,70 @@ func TestFailingLoadBalancerResolution(t *testing.T) {
 	assert.EqualError(t, err, "MOCKERROR")
 	assert.Nil(t, argResolver)
 }
+
+func TestLoadBalancerFrontendResolution(t *testing.T) {
+	mService := &smock.Service{}
+	mService.On("GetLoadBalancerFrontends", &request.GetLoadBalancerFrontendsRequest{ServiceUUID: "abcdef"}).Return([]upcloud.LoadBalancerFrontend{
+		{Name: "web"},
+		{Name: "web-tls"},
+	}, nil)
+	res := resolver.CachingLoadBalancerFrontend{ServiceUUID: "abcdef"}
+	argResolver, err := res.Get(context.TODO(), mService)
+	assert.NoError(t, err)
+
+	value, err := argResolver("WEB").GetOnly()
+	assert.NoError(t, err)
+	assert.Equal(t, "web", value)
+
+	fe, err := res.GetCached("web-tls")
+	assert.NoError(t, err)
+	assert.Equal(t, "web-tls", fe.Name)
+
+	_, err = argResolver("api").GetOnly()
+	assert.ErrorIs(t, err, resolver.NotFoundError("api"))
+
+	mService.AssertNumberOfCalls(t, "GetLoadBalancerFrontends", 1)
+}
+
+func TestLoadBalancerBackendResolution(t *testing.T) {
+	mService := &smock.Service{}
+	mService.On("GetLoadBalancerBackends", &request.GetLoadBalancerBackendsRequest{ServiceUUID: "abcdef"}).Return([]upcloud.LoadBalancerBackend{
+		{Name: "api"},
+		{Name: "web"},
+	}, nil)
+	res := resolver.CachingLoadBalancerBackend{ServiceUUID: "abcdef"}
+	argResolver, err := res.Get(context.TODO(), mService)
+	assert.NoError(t, err)
+
+	value, err := argResolver("api").GetOnly()
+	assert.NoError(t, err)
+	assert.Equal(t, "api", value)
+
+	_, err = argResolver("db").GetOnly()
+	assert.ErrorIs(t, err, resolver.NotFoundError("db"))
+}
+
+func TestLoadBalancerCertificateBundleResolution(t *testing.T) {
+	mService := &smock.Service{}
+	mService.On("GetLoadBalancerCertificateBundles", mock.Anything).Return([]upcloud.LoadBalancerCertificateBundle{
+		{Name: "example-com", UUID: "0aded5c1-c7a3-498a-b9c8-a871611c47a2"},
+		{Name: "example-ca", UUID: "0a6cb84b-b7a2-4a0b-b6b9-3f2d8c2d1e5f"},
+	}, nil)
+	res := resolver.CachingLoadBalancerCertificateBundle{}
+	argResolver, err := res.Get(context.TODO(), mService)
+	assert.NoError(t, err)
+
+	value, err := argResolver("example-ca").GetOnly()
+	assert.NoError(t, err)
+	assert.Equal(t, "0a6cb84b-b7a2-4a0b-b6b9-3f2d8c2d1e5f", value)
+
+	value, err = argResolver("0aded5c1-c7a3-498a-b9c8-a871611c47a2").GetOnly()
+	assert.NoError(t, err)
+	assert.Equal(t, "0aded5c1-c7a3-498a-b9c8-a871611c47a2", value)
+
+	bundle, err := res.GetCached(value)
+	assert.NoError(t, err)
+	assert.Equal(t, "example-com", bundle.Name)
+
+	mService.AssertNumberOfCalls(t, "GetLoadBalancerCertificateBundles", 1)
+}
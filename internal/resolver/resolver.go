@@ -9,6 +9,7 @@ import (
 const (
 	helpUUIDName  = "<UUID/Name...>"
 	helpUUIDTitle = "<UUID/Title...>"
+	helpName      = "<Name...>"
 )
 
 // Resolver represents the most basic argument resolver, a function that accepts and argument and returns the resolved value(s).
@@ -0,0 +1,175 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //gosec:disable G501 -- S3 requires Content-MD5 header for lifecycle configuration
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Bucket versioning statuses. Versioning of a bucket that has never had versioning enabled has empty status.
+const (
+	VersioningEnabled   = "Enabled"
+	VersioningSuspended = "Suspended"
+)
+
+// Lifecycle rule statuses.
+const (
+	LifecycleRuleEnabled  = "Enabled"
+	LifecycleRuleDisabled = "Disabled"
+)
+
+// LifecycleRule is an expiration rule of a bucket lifecycle configuration.
+type LifecycleRule struct {
+	ID     string `json:"id"`
+	Prefix string `json:"prefix"`
+	Status string `json:"status"`
+	// ExpirationDays is the number of days after creation when the current versions of the objects expire. Zero disables the expiration.
+	ExpirationDays int `json:"expiration_days,omitempty"`
+	// NoncurrentVersionExpirationDays is the number of days after becoming noncurrent when the noncurrent versions of the objects are deleted. Zero disables the expiration.
+	NoncurrentVersionExpirationDays int `json:"noncurrent_version_expiration_days,omitempty"`
+}
+
+type versioningConfiguration struct {
+	XMLName xml.Name `xml:"VersioningConfiguration"`
+	Status  string   `xml:"Status,omitempty"`
+}
+
+type lifecycleConfiguration struct {
+	XMLName xml.Name             `xml:"LifecycleConfiguration"`
+	Rules   []lifecycleRuleEntry `xml:"Rule"`
+}
+
+type lifecycleRuleEntry struct {
+	ID     string `xml:"ID,omitempty"`
+	Filter *struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"Filter"`
+	// Prefix is the deprecated location of the prefix, which some services still use in responses.
+	Prefix     *string `xml:"Prefix"`
+	Status     string  `xml:"Status"`
+	Expiration *struct {
+		Days int `xml:"Days"`
+	} `xml:"Expiration"`
+	NoncurrentVersionExpiration *struct {
+		NoncurrentDays int `xml:"NoncurrentDays"`
+	} `xml:"NoncurrentVersionExpiration"`
+}
+
+// GetBucketVersioning returns the versioning status of the bucket.
+func (c *Client) GetBucketVersioning(ctx context.Context, bucket string) (string, error) {
+	var res versioningConfiguration
+	if err := c.doXML(ctx, http.MethodGet, requestOptions{bucket: bucket, query: url.Values{"versioning": {""}}}, &res); err != nil {
+		return "", err
+	}
+	return res.Status, nil
+}
+
+// PutBucketVersioning sets the versioning status of the bucket to VersioningEnabled or VersioningSuspended.
+func (c *Client) PutBucketVersioning(ctx context.Context, bucket, status string) error {
+	body, err := xml.Marshal(versioningConfiguration{Status: status})
+	if err != nil {
+		return err
+	}
+	return c.doXML(ctx, http.MethodPut, requestOptions{bucket: bucket, query: url.Values{"versioning": {""}}, body: bytes.NewReader(body), contentLength: int64(len(body))}, nil)
+}
+
+// GetBucketLifecycle returns the lifecycle rules of the bucket. A bucket without lifecycle configuration has no rules.
+func (c *Client) GetBucketLifecycle(ctx context.Context, bucket string) ([]LifecycleRule, error) {
+	var res lifecycleConfiguration
+	if err := c.doXML(ctx, http.MethodGet, requestOptions{bucket: bucket, query: url.Values{"lifecycle": {""}}}, &res); err != nil {
+		if isErrorCode(err, "NoSuchLifecycleConfiguration") {
+			return []LifecycleRule{}, nil
+		}
+		return nil, err
+	}
+
+	rules := []LifecycleRule{}
+	for _, entry := range res.Rules {
+		rule := LifecycleRule{ID: entry.ID, Status: entry.Status}
+		if entry.Filter != nil {
+			rule.Prefix = entry.Filter.Prefix
+		} else if entry.Prefix != nil {
+			rule.Prefix = *entry.Prefix
+		}
+		if entry.Expiration != nil {
+			rule.ExpirationDays = entry.Expiration.Days
+		}
+		if entry.NoncurrentVersionExpiration != nil {
+			rule.NoncurrentVersionExpirationDays = entry.NoncurrentVersionExpiration.NoncurrentDays
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// PutBucketLifecycle replaces the lifecycle configuration of the bucket with given rules. Use DeleteBucketLifecycle to remove all rules.
+func (c *Client) PutBucketLifecycle(ctx context.Context, bucket string, rules []LifecycleRule) error {
+	config := lifecycleConfiguration{}
+	for _, rule := range rules {
+		entry := lifecycleRuleEntry{ID: rule.ID, Status: rule.Status}
+		entry.Filter = &struct {
+			Prefix string `xml:"Prefix"`
+		}{Prefix: rule.Prefix}
+		if rule.ExpirationDays > 0 {
+			entry.Expiration = &struct {
+				Days int `xml:"Days"`
+			}{Days: rule.ExpirationDays}
+		}
+		if rule.NoncurrentVersionExpirationDays > 0 {
+			entry.NoncurrentVersionExpiration = &struct {
+				NoncurrentDays int `xml:"NoncurrentDays"`
+			}{NoncurrentDays: rule.NoncurrentVersionExpirationDays}
+		}
+		config.Rules = append(config.Rules, entry)
+	}
+
+	body, err := xml.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	sum := md5.Sum(body) //gosec:disable G401 -- S3 requires Content-MD5 header for lifecycle configuration
+	header := http.Header{"Content-Md5": {base64.StdEncoding.EncodeToString(sum[:])}}
+	return c.doXML(ctx, http.MethodPut, requestOptions{bucket: bucket, query: url.Values{"lifecycle": {""}}, header: header, body: bytes.NewReader(body), contentLength: int64(len(body))}, nil)
+}
+
+// DeleteBucketLifecycle removes the lifecycle configuration of the bucket.
+func (c *Client) DeleteBucketLifecycle(ctx context.Context, bucket string) error {
+	return c.doXML(ctx, http.MethodDelete, requestOptions{bucket: bucket, query: url.Values{"lifecycle": {""}}}, nil)
+}
+
+// GetBucketPolicy returns the access policy document of the bucket. Empty policy is returned if the bucket does not have a policy.
+func (c *Client) GetBucketPolicy(ctx context.Context, bucket string) ([]byte, error) {
+	res, err := c.do(ctx, http.MethodGet, requestOptions{bucket: bucket, query: url.Values{"policy": {""}}})
+	if err != nil {
+		if isErrorCode(err, "NoSuchBucketPolicy") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	return io.ReadAll(res.Body)
+}
+
+// PutBucketPolicy replaces the access policy of the bucket with given JSON policy document.
+func (c *Client) PutBucketPolicy(ctx context.Context, bucket string, policy []byte) error {
+	header := http.Header{"Content-Type": {"application/json"}}
+	return c.doXML(ctx, http.MethodPut, requestOptions{bucket: bucket, query: url.Values{"policy": {""}}, header: header, body: bytes.NewReader(policy), contentLength: int64(len(policy))}, nil)
+}
+
+// DeleteBucketPolicy removes the access policy of the bucket.
+func (c *Client) DeleteBucketPolicy(ctx context.Context, bucket string) error {
+	return c.doXML(ctx, http.MethodDelete, requestOptions{bucket: bucket, query: url.Values{"policy": {""}}}, nil)
+}
+
+func isErrorCode(err error, code string) bool {
+	var s3Err *Error
+	return errors.As(err, &s3Err) && s3Err.Code == code
+}
@@ -0,0 +1,158 @@
+// Package s3 implements the subset of the S3 protocol needed to manage objects in UpCloud Managed Object Storage services.
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Credentials contains the access key used to sign the requests.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// Client is a S3 client that uses path-style requests, i.e. the bucket name is the first segment of the request path.
+type Client struct {
+	endpoint    *url.URL
+	region      string
+	credentials Credentials
+	httpClient  *http.Client
+	now         func() time.Time
+}
+
+// New returns a client for given endpoint, e.g. https://7mf5k.upcloudobjects.com, and region.
+func New(endpoint, region string, credentials Credentials) (*Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q: scheme and host are required", endpoint)
+	}
+
+	return &Client{
+		endpoint:    u,
+		region:      region,
+		credentials: credentials,
+		httpClient:  http.DefaultClient,
+		now:         time.Now,
+	}, nil
+}
+
+// Error is returned when the S3 API responds with an error status.
+type Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+// Error implements error
+func (e *Error) Error() string {
+	code := e.Code
+	if code == "" {
+		code = http.StatusText(e.StatusCode)
+	}
+	if e.Message == "" {
+		return fmt.Sprintf("s3 request failed with status %d: %s", e.StatusCode, code)
+	}
+	return fmt.Sprintf("s3 request failed with status %d: %s: %s", e.StatusCode, code, e.Message)
+}
+
+// IsNotFound returns true if the error is a S3 error for missing bucket or object.
+func IsNotFound(err error) bool {
+	var s3Err *Error
+	return errors.As(err, &s3Err) && s3Err.StatusCode == http.StatusNotFound
+}
+
+// objectURL returns the path-style URL of the bucket or object. Empty bucket refers to the service itself.
+func (c *Client) objectURL(bucket, key string, query url.Values) *url.URL {
+	u := *c.endpoint
+	u.Path = "/"
+	u.RawPath = ""
+	if bucket != "" {
+		u.Path = "/" + bucket
+		if key != "" {
+			u.Path += "/" + key
+		}
+		u.RawPath = escape(u.Path, false)
+	}
+	u.RawQuery = canonicalQuery(query)
+	return &u
+}
+
+type requestOptions struct {
+	bucket        string
+	key           string
+	query         url.Values
+	header        http.Header
+	body          io.Reader
+	contentLength int64
+}
+
+// do signs and sends the request. Error responses are returned as *Error, otherwise the caller is responsible for closing the response body.
+func (c *Client) do(ctx context.Context, method string, opts requestOptions) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.objectURL(opts.bucket, opts.key, opts.query).String(), opts.body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range opts.header {
+		req.Header[name] = values
+	}
+	if opts.body != nil {
+		req.ContentLength = opts.contentLength
+		if opts.contentLength == 0 {
+			req.Body = http.NoBody
+		}
+	}
+
+	sign(req, c.credentials, c.region, c.now())
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= 300 {
+		defer res.Body.Close()
+		s3Err := &Error{}
+		if body, err := io.ReadAll(res.Body); err == nil && len(body) > 0 {
+			_ = xml.Unmarshal(body, s3Err)
+		}
+		s3Err.StatusCode = res.StatusCode
+		return nil, s3Err
+	}
+
+	return res, nil
+}
+
+// doXML sends the request and decodes the XML response body into out.
+func (c *Client) doXML(ctx context.Context, method string, opts requestOptions, out any) error {
+	res, err := c.do(ctx, method, opts)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		_, err = io.Copy(io.Discard, res.Body)
+		return err
+	}
+
+	if err := xml.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("cannot parse s3 response: %w", err)
+	}
+	return nil
+}
+
+// trimETag removes the quotes around the ETag header value.
+func trimETag(etag string) string {
+	return strings.Trim(etag, `"`)
+}
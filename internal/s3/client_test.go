@@ -0,0 +1,238 @@
+package s3_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3"
+	"github.com/UpCloudLtd/upcloud-cli/v3/internal/s3/s3test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, server *s3test.Server) *s3.Client {
+	t.Helper()
+
+	client, err := s3.New(server.URL, "europe-1", s3.Credentials{AccessKeyID: "AKIATEST", SecretAccessKey: "secret"})
+	require.NoError(t, err)
+	return client
+}
+
+func TestNew_InvalidEndpoint(t *testing.T) {
+	_, err := s3.New("7mf5k.upcloudobjects.com", "europe-1", s3.Credentials{})
+	assert.EqualError(t, err, `invalid endpoint "7mf5k.upcloudobjects.com": scheme and host are required`)
+}
+
+func TestClient_Objects(t *testing.T) {
+	server := s3test.NewServer()
+	defer server.Close()
+	server.AddBucket("my-bucket")
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	buckets, err := client.ListBuckets(ctx)
+	require.NoError(t, err)
+	require.Len(t, buckets, 1)
+	assert.Equal(t, "my-bucket", buckets[0].Name)
+
+	content := []byte("hello world")
+	require.NoError(t, client.PutObject(ctx, "my-bucket", "dir/hello world.txt", bytes.NewReader(content), int64(len(content))))
+
+	object, err := client.HeadObject(ctx, "my-bucket", "dir/hello world.txt")
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), object.Size)
+	assert.Equal(t, "5eb63bbbe01eeed093cb22bb8f5acdc3", object.ETag)
+
+	body, _, err := client.GetObject(ctx, "my-bucket", "dir/hello world.txt")
+	require.NoError(t, err)
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.NoError(t, body.Close())
+	assert.Equal(t, content, data)
+
+	require.NoError(t, client.DeleteObject(ctx, "my-bucket", "dir/hello world.txt"))
+
+	_, err = client.HeadObject(ctx, "my-bucket", "dir/hello world.txt")
+	assert.True(t, s3.IsNotFound(err))
+
+	_, _, err = client.GetObject(ctx, "my-bucket", "dir/hello world.txt")
+	assert.EqualError(t, err, "s3 request failed with status 404: NoSuchKey: The specified key does not exist")
+}
+
+func TestClient_ListObjects(t *testing.T) {
+	server := s3test.NewServer()
+	defer server.Close()
+	server.AddBucket("my-bucket")
+	server.MaxKeys = 2
+	for _, key := range []string{"a.txt", "b/1.txt", "b/2.txt", "b/3.txt", "c.txt", "d/1.txt"} {
+		server.PutObject("my-bucket", key, []byte(key), time.Now())
+	}
+	client := newTestClient(t, server)
+
+	listing, err := client.ListObjects(context.Background(), "my-bucket", "", "/")
+	require.NoError(t, err)
+	var keys []string
+	for _, object := range listing.Objects {
+		keys = append(keys, object.Key)
+	}
+	assert.Equal(t, []string{"a.txt", "c.txt"}, keys)
+	assert.Equal(t, []string{"b/", "d/"}, listing.Prefixes)
+
+	listing, err = client.ListObjects(context.Background(), "my-bucket", "b/", "")
+	require.NoError(t, err)
+	assert.Len(t, listing.Objects, 3)
+	assert.Empty(t, listing.Prefixes)
+}
+
+func TestClient_Upload(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		size     int
+		requests []string
+	}{
+		{
+			name:     "single request",
+			size:     1024,
+			requests: []string{"PUT /my-bucket/large.bin"},
+		},
+		{
+			name: "multipart",
+			size: 2500,
+			requests: []string{
+				"POST /my-bucket/large.bin?uploads=",
+				"PUT /my-bucket/large.bin?partNumber=1&uploadId=1",
+				"PUT /my-bucket/large.bin?partNumber=2&uploadId=1",
+				"PUT /my-bucket/large.bin?partNumber=3&uploadId=1",
+				"POST /my-bucket/large.bin?uploadId=1",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server := s3test.NewServer()
+			defer server.Close()
+			server.AddBucket("my-bucket")
+			client := newTestClient(t, server)
+
+			content := bytes.Repeat([]byte("0123456789"), test.size/10)
+			err := client.Upload(context.Background(), "my-bucket", "large.bin", bytes.NewReader(content), int64(len(content)), s3.UploadOptions{PartSize: 1024, Concurrency: 1})
+			require.NoError(t, err)
+
+			object, ok := server.Object("my-bucket", "large.bin")
+			require.True(t, ok)
+			assert.Equal(t, content, object.Data)
+			assert.Equal(t, test.requests, server.Requests())
+		})
+	}
+}
+
+type failingReaderAt struct {
+	failAt int64
+}
+
+func (r failingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.failAt {
+		return 0, fmt.Errorf("disk on fire")
+	}
+	return len(p), nil
+}
+
+func TestClient_Upload_AbortsOnError(t *testing.T) {
+	server := s3test.NewServer()
+	defer server.Close()
+	server.AddBucket("my-bucket")
+	client := newTestClient(t, server)
+
+	err := client.Upload(context.Background(), "my-bucket", "large.bin", failingReaderAt{failAt: 1024}, 2048, s3.UploadOptions{PartSize: 1024, Concurrency: 1})
+	assert.ErrorContains(t, err, "cannot upload part 2")
+	assert.Contains(t, server.Requests(), "DELETE /my-bucket/large.bin?uploadId=1")
+	assert.Empty(t, server.Keys("my-bucket"))
+}
+
+func TestClient_Presign(t *testing.T) {
+	server := s3test.NewServer()
+	defer server.Close()
+	server.AddBucket("my-bucket")
+	client := newTestClient(t, server)
+
+	putURL, err := client.Presign(http.MethodPut, "my-bucket", "shared/report.pdf", time.Hour)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, putURL, strings.NewReader("report"))
+	require.NoError(t, err)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	getURL, err := client.Presign(http.MethodGet, "my-bucket", "shared/report.pdf", time.Hour)
+	require.NoError(t, err)
+	assert.Contains(t, getURL, "/my-bucket/shared/report.pdf?X-Amz-Algorithm=AWS4-HMAC-SHA256&")
+	assert.Contains(t, getURL, "&X-Amz-Expires=3600&")
+	res, err = http.Get(getURL)
+	require.NoError(t, err)
+	data, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	assert.Equal(t, "report", string(data))
+
+	_, err = client.Presign(http.MethodGet, "my-bucket", "shared/report.pdf", 8*24*time.Hour)
+	assert.EqualError(t, err, "expiration must be between one second and seven days")
+}
+
+func TestClient_BucketConfiguration(t *testing.T) {
+	server := s3test.NewServer()
+	defer server.Close()
+	server.AddBucket("my-bucket")
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	versioning, err := client.GetBucketVersioning(ctx, "my-bucket")
+	require.NoError(t, err)
+	assert.Empty(t, versioning)
+
+	require.NoError(t, client.PutBucketVersioning(ctx, "my-bucket", s3.VersioningEnabled))
+	versioning, err = client.GetBucketVersioning(ctx, "my-bucket")
+	require.NoError(t, err)
+	assert.Equal(t, s3.VersioningEnabled, versioning)
+
+	rules, err := client.GetBucketLifecycle(ctx, "my-bucket")
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+
+	expected := []s3.LifecycleRule{
+		{ID: "logs", Prefix: "logs/", Status: s3.LifecycleRuleEnabled, ExpirationDays: 30},
+		{ID: "versions", Status: s3.LifecycleRuleDisabled, NoncurrentVersionExpirationDays: 7},
+	}
+	require.NoError(t, client.PutBucketLifecycle(ctx, "my-bucket", expected))
+	rules, err = client.GetBucketLifecycle(ctx, "my-bucket")
+	require.NoError(t, err)
+	assert.Equal(t, expected, rules)
+
+	require.NoError(t, client.DeleteBucketLifecycle(ctx, "my-bucket"))
+	rules, err = client.GetBucketLifecycle(ctx, "my-bucket")
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+
+	policy, err := client.GetBucketPolicy(ctx, "my-bucket")
+	require.NoError(t, err)
+	assert.Empty(t, policy)
+
+	document := []byte(`{"Version":"2012-10-17","Statement":[]}`)
+	require.NoError(t, client.PutBucketPolicy(ctx, "my-bucket", document))
+	policy, err = client.GetBucketPolicy(ctx, "my-bucket")
+	require.NoError(t, err)
+	assert.JSONEq(t, string(document), string(policy))
+
+	require.NoError(t, client.DeleteBucketPolicy(ctx, "my-bucket"))
+	policy, err = client.GetBucketPolicy(ctx, "my-bucket")
+	require.NoError(t, err)
+	assert.Empty(t, policy)
+
+	_, err = client.GetBucketVersioning(ctx, "missing")
+	assert.True(t, s3.IsNotFound(err))
+}
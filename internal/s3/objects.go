@@ -0,0 +1,205 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Bucket contains the details returned by ListBuckets.
+type Bucket struct {
+	Name         string    `xml:"Name" json:"name"`
+	CreationDate time.Time `xml:"CreationDate" json:"creation_date"`
+}
+
+// Object contains the details of an object returned by ListObjects and HeadObject.
+type Object struct {
+	Key          string    `xml:"Key" json:"key"`
+	Size         int64     `xml:"Size" json:"size"`
+	LastModified time.Time `xml:"LastModified" json:"last_modified"`
+	ETag         string    `xml:"ETag" json:"etag"`
+}
+
+// Listing contains the objects and common prefixes returned by ListObjects.
+type Listing struct {
+	Objects  []Object `json:"objects"`
+	Prefixes []string `json:"prefixes"`
+}
+
+type listBucketsResult struct {
+	Buckets []Bucket `xml:"Buckets>Bucket"`
+}
+
+// ListBuckets returns the buckets the credentials have access to.
+func (c *Client) ListBuckets(ctx context.Context) ([]Bucket, error) {
+	var res listBucketsResult
+	if err := c.doXML(ctx, http.MethodGet, requestOptions{}, &res); err != nil {
+		return nil, err
+	}
+	return res.Buckets, nil
+}
+
+type listObjectsResult struct {
+	Contents       []Object `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// ListObjects returns all objects in the bucket that have given prefix. If delimiter is not empty, keys that contain the delimiter after the prefix are grouped into common prefixes.
+func (c *Client) ListObjects(ctx context.Context, bucket, prefix, delimiter string) (*Listing, error) {
+	listing := &Listing{Objects: []Object{}, Prefixes: []string{}}
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if delimiter != "" {
+			query.Set("delimiter", delimiter)
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		var res listObjectsResult
+		if err := c.doXML(ctx, http.MethodGet, requestOptions{bucket: bucket, query: query}, &res); err != nil {
+			return nil, err
+		}
+
+		for _, object := range res.Contents {
+			object.ETag = trimETag(object.ETag)
+			listing.Objects = append(listing.Objects, object)
+		}
+		for _, commonPrefix := range res.CommonPrefixes {
+			listing.Prefixes = append(listing.Prefixes, commonPrefix.Prefix)
+		}
+
+		if !res.IsTruncated || res.NextContinuationToken == "" {
+			return listing, nil
+		}
+		token = res.NextContinuationToken
+	}
+}
+
+// HeadObject returns the details of an object. Use IsNotFound to check whether the object exists.
+func (c *Client) HeadObject(ctx context.Context, bucket, key string) (*Object, error) {
+	res, err := c.do(ctx, http.MethodHead, requestOptions{bucket: bucket, key: key})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	return objectFromHeader(key, res.Header), nil
+}
+
+// GetObject returns the details and the content of an object. The caller is responsible for closing the returned reader.
+func (c *Client) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, *Object, error) {
+	res, err := c.do(ctx, http.MethodGet, requestOptions{bucket: bucket, key: key})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Body, objectFromHeader(key, res.Header), nil
+}
+
+func objectFromHeader(key string, header http.Header) *Object {
+	object := &Object{Key: key, ETag: trimETag(header.Get("ETag"))}
+	object.Size, _ = strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+	object.LastModified, _ = http.ParseTime(header.Get("Last-Modified"))
+	return object
+}
+
+// PutObject uploads size bytes from body as the content of the object.
+func (c *Client) PutObject(ctx context.Context, bucket, key string, body io.Reader, size int64) error {
+	return c.doXML(ctx, http.MethodPut, requestOptions{bucket: bucket, key: key, body: body, contentLength: size}, nil)
+}
+
+// DeleteObject deletes an object. Deleting an object that does not exist is not an error.
+func (c *Client) DeleteObject(ctx context.Context, bucket, key string) error {
+	return c.doXML(ctx, http.MethodDelete, requestOptions{bucket: bucket, key: key}, nil)
+}
+
+// CompletedPart identifies an uploaded part when completing a multipart upload.
+type CompletedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type createMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+// CreateMultipartUpload initiates a multipart upload and returns its upload ID.
+func (c *Client) CreateMultipartUpload(ctx context.Context, bucket, key string) (string, error) {
+	var res createMultipartUploadResult
+	if err := c.doXML(ctx, http.MethodPost, requestOptions{bucket: bucket, key: key, query: url.Values{"uploads": {""}}}, &res); err != nil {
+		return "", err
+	}
+	return res.UploadID, nil
+}
+
+// UploadPart uploads a part of a multipart upload and returns the ETag of the part.
+func (c *Client) UploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int, body io.Reader, size int64) (string, error) {
+	query := url.Values{"partNumber": {strconv.Itoa(partNumber)}, "uploadId": {uploadID}}
+	res, err := c.do(ctx, http.MethodPut, requestOptions{bucket: bucket, key: key, query: query, body: body, contentLength: size})
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return res.Header.Get("ETag"), nil
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []CompletedPart `xml:"Part"`
+}
+
+// CompleteMultipartUpload assembles the object from the uploaded parts.
+func (c *Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string, parts []CompletedPart) error {
+	body, err := xml.Marshal(completeMultipartUpload{Parts: parts})
+	if err != nil {
+		return err
+	}
+
+	// The response status is 200 even if completing the upload fails, check for error in the response body.
+	var res struct {
+		XMLName xml.Name
+		Error
+	}
+	query := url.Values{"uploadId": {uploadID}}
+	if err := c.doXML(ctx, http.MethodPost, requestOptions{bucket: bucket, key: key, query: query, body: bytes.NewReader(body), contentLength: int64(len(body))}, &res); err != nil {
+		return err
+	}
+	if res.XMLName.Local == "Error" {
+		res.StatusCode = http.StatusOK
+		return fmt.Errorf("cannot complete multipart upload: %w", &res.Error)
+	}
+	return nil
+}
+
+// AbortMultipartUpload aborts a multipart upload and deletes the uploaded parts.
+func (c *Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	return c.doXML(ctx, http.MethodDelete, requestOptions{bucket: bucket, key: key, query: url.Values{"uploadId": {uploadID}}}, nil)
+}
+
+// MaxPresignExpiration is the maximum validity period of a presigned URL.
+const MaxPresignExpiration = 7 * 24 * time.Hour
+
+// Presign returns a presigned URL that allows performing the request with given method to the object without credentials until the URL expires. No request is made to the service.
+func (c *Client) Presign(method, bucket, key string, expires time.Duration) (string, error) {
+	if expires < time.Second || expires > MaxPresignExpiration {
+		return "", fmt.Errorf("expiration must be between one second and seven days")
+	}
+	return presign(c.objectURL(bucket, key, nil), method, c.credentials, c.region, c.now(), expires), nil
+}
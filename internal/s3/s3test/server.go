@@ -0,0 +1,367 @@
+// Package s3test provides an in-memory S3 server for testing commands that use the s3 package.
+package s3test
+
+import (
+	"crypto/md5" //gosec:disable G501 -- S3 uses MD5 sums as ETags
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Object is an object stored in the server.
+type Object struct {
+	Data         []byte
+	LastModified time.Time
+}
+
+// Server is a minimal S3 stand-in that supports the requests used by the s3 package. Buckets must be created with AddBucket before objects can be uploaded into them.
+type Server struct {
+	*httptest.Server
+
+	// MaxKeys limits the number of keys returned in a single list objects response, to allow testing pagination.
+	MaxKeys int
+
+	mu       sync.Mutex
+	buckets  map[string]map[string]Object
+	configs  map[string]map[string][]byte
+	uploads  map[string]map[int][]byte
+	requests []string
+	nextID   int
+}
+
+// NewServer starts a new server. The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		MaxKeys: 1000,
+		buckets: make(map[string]map[string]Object),
+		configs: make(map[string]map[string][]byte),
+		uploads: make(map[string]map[int][]byte),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AddBucket creates an empty bucket.
+func (s *Server) AddBucket(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buckets[name] = make(map[string]Object)
+	s.configs[name] = make(map[string][]byte)
+}
+
+// PutObject adds or replaces an object.
+func (s *Server) PutObject(bucket, key string, data []byte, lastModified time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buckets[bucket][key] = Object{Data: data, LastModified: lastModified}
+}
+
+// Object returns the object with given key, if it exists.
+func (s *Server) Object(bucket, key string) (Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	object, ok := s.buckets[bucket][key]
+	return object, ok
+}
+
+// Keys returns the sorted keys of the objects in given bucket.
+func (s *Server) Keys(bucket string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := []string{}
+	for key := range s.buckets[bucket] {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Requests returns the method and path of the requests the server has received, e.g. "PUT /bucket/key?partNumber=1&uploadId=1".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	request := r.Method + " " + r.URL.Path
+	if r.URL.RawQuery != "" {
+		request += "?" + r.URL.RawQuery
+	}
+	s.requests = append(s.requests, request)
+
+	signed := strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=") || r.URL.Query().Has("X-Amz-Signature")
+	if !signed {
+		writeError(w, http.StatusForbidden, "AccessDenied", "Request is not signed")
+		return
+	}
+
+	bucketName, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucketName == "" {
+		s.listBuckets(w)
+		return
+	}
+
+	bucket, ok := s.buckets[bucketName]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+		return
+	}
+
+	query := r.URL.Query()
+	for _, subresource := range []string{"versioning", "lifecycle", "policy"} {
+		if key == "" && query.Has(subresource) {
+			s.handleBucketConfig(w, r, bucketName, subresource)
+			return
+		}
+	}
+
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		s.listObjects(w, bucket, query)
+	case query.Has("uploads") && r.Method == http.MethodPost:
+		s.nextID++
+		id := strconv.Itoa(s.nextID)
+		s.uploads[id] = make(map[int][]byte)
+		writeXML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			UploadID string   `xml:"UploadId"`
+		}{UploadID: id})
+	case query.Has("uploadId"):
+		s.handleMultipart(w, r, bucket, key)
+	case r.Method == http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		bucket[key] = Object{Data: data, LastModified: time.Now()}
+		w.Header().Set("ETag", etag(data))
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		object, ok := bucket[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.Data)))
+		w.Header().Set("Last-Modified", object.LastModified.UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", etag(object.Data))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(object.Data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(bucket, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented", "Request is not supported by the test server")
+	}
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter) {
+	type bucket struct {
+		Name         string
+		CreationDate time.Time
+	}
+	res := struct {
+		XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+		Buckets []bucket `xml:"Buckets>Bucket"`
+	}{}
+	for name := range s.buckets {
+		res.Buckets = append(res.Buckets, bucket{Name: name, CreationDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	}
+	slices.SortFunc(res.Buckets, func(a, b bucket) int { return strings.Compare(a.Name, b.Name) })
+	writeXML(w, res)
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, bucket map[string]Object, query url.Values) {
+	prefix, delimiter, token := query.Get("prefix"), query.Get("delimiter"), query.Get("continuation-token")
+
+	type content struct {
+		Key          string
+		Size         int
+		LastModified time.Time
+		ETag         string
+	}
+	type prefixEntry struct {
+		Prefix string
+	}
+	res := struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		Contents              []content
+		CommonPrefixes        []prefixEntry
+		IsTruncated           bool
+		NextContinuationToken string `xml:",omitempty"`
+	}{}
+
+	keys := []string{}
+	for key := range bucket {
+		if strings.HasPrefix(key, prefix) && key > token {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	lastPrefix := ""
+	for _, key := range keys {
+		commonPrefix := ""
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				commonPrefix = key[:len(prefix)+i+len(delimiter)]
+			}
+		}
+		// Keys that belong to the previous common prefix are not counted in the page size.
+		if commonPrefix == "" || commonPrefix != lastPrefix {
+			if len(res.Contents)+len(res.CommonPrefixes) >= s.MaxKeys {
+				res.IsTruncated = true
+				break
+			}
+		}
+		res.NextContinuationToken = key
+
+		switch {
+		case commonPrefix == "":
+			object := bucket[key]
+			res.Contents = append(res.Contents, content{Key: key, Size: len(object.Data), LastModified: object.LastModified.UTC(), ETag: etag(object.Data)})
+		case commonPrefix != lastPrefix:
+			lastPrefix = commonPrefix
+			res.CommonPrefixes = append(res.CommonPrefixes, prefixEntry{Prefix: commonPrefix})
+		}
+	}
+	if !res.IsTruncated {
+		res.NextContinuationToken = ""
+	}
+	writeXML(w, res)
+}
+
+func (s *Server) handleMultipart(w http.ResponseWriter, r *http.Request, bucket map[string]Object, key string) {
+	id := r.URL.Query().Get("uploadId")
+	parts, ok := s.uploads[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		number, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidArgument", "Invalid part number")
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		parts[number] = data
+		w.Header().Set("ETag", etag(data))
+	case http.MethodPost:
+		var req struct {
+			Parts []struct {
+				PartNumber int
+				ETag       string
+			} `xml:"Part"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "MalformedXML", err.Error())
+			return
+		}
+		var data []byte
+		for i, part := range req.Parts {
+			if part.PartNumber != i+1 || part.ETag != etag(parts[part.PartNumber]) {
+				writeError(w, http.StatusBadRequest, "InvalidPart", fmt.Sprintf("Part %d is invalid", part.PartNumber))
+				return
+			}
+			data = append(data, parts[part.PartNumber]...)
+		}
+		delete(s.uploads, id)
+		bucket[key] = Object{Data: data, LastModified: time.Now()}
+		writeXML(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Key     string
+		}{Key: key})
+	case http.MethodDelete:
+		delete(s.uploads, id)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleBucketConfig stores and returns bucket configuration documents as is. Only the Content-MD5 header of lifecycle configurations is validated.
+func (s *Server) handleBucketConfig(w http.ResponseWriter, r *http.Request, bucketName, subresource string) {
+	configs := s.configs[bucketName]
+
+	switch r.Method {
+	case http.MethodGet:
+		data, ok := configs[subresource]
+		switch {
+		case ok:
+			contentType := "application/xml"
+			if subresource == "policy" {
+				contentType = "application/json"
+			}
+			w.Header().Set("Content-Type", contentType)
+			_, _ = w.Write(data)
+		case subresource == "versioning":
+			writeXML(w, struct {
+				XMLName xml.Name `xml:"VersioningConfiguration"`
+			}{})
+		case subresource == "lifecycle":
+			writeError(w, http.StatusNotFound, "NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist")
+		default:
+			writeError(w, http.StatusNotFound, "NoSuchBucketPolicy", "The bucket policy does not exist")
+		}
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		if subresource == "lifecycle" {
+			sum := md5.Sum(data) //gosec:disable G401 -- S3 requires Content-MD5 header for lifecycle configuration
+			if r.Header.Get("Content-MD5") != base64.StdEncoding.EncodeToString(sum[:]) {
+				writeError(w, http.StatusBadRequest, "InvalidDigest", "The Content-MD5 you specified was invalid")
+				return
+			}
+		}
+		configs[subresource] = data
+	case http.MethodDelete:
+		delete(configs, subresource)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented", "Request is not supported by the test server")
+	}
+}
+
+func etag(data []byte) string {
+	sum := md5.Sum(data) //gosec:disable G401 -- S3 uses MD5 sums as ETags
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func writeXML(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{Code: code, Message: message})
+}
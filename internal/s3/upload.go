@@ -0,0 +1,82 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// DefaultPartSize is the part size used in multipart uploads, unless the object would have more than MaxParts parts.
+	DefaultPartSize int64 = 16 * 1024 * 1024
+	// MaxParts is the maximum number of parts in a multipart upload.
+	MaxParts = 10000
+
+	defaultPartConcurrency = 4
+)
+
+// UploadOptions configures how Upload splits the object into parts.
+type UploadOptions struct {
+	// PartSize is the size of each part in multipart upload. Objects smaller than this are uploaded with a single request. Defaults to DefaultPartSize.
+	PartSize int64
+	// Concurrency is the number of parts uploaded in parallel. Defaults to 4.
+	Concurrency int
+}
+
+// Upload uploads size bytes from source as the content of the object. Large objects are uploaded in parts, which are uploaded in parallel. If uploading any of the parts fails, the multipart upload is aborted.
+func (c *Client) Upload(ctx context.Context, bucket, key string, source io.ReaderAt, size int64, opts UploadOptions) error {
+	partSize := opts.PartSize
+	if partSize <= 0 {
+		partSize = DefaultPartSize
+	}
+	for size/partSize >= MaxParts {
+		partSize *= 2
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultPartConcurrency
+	}
+
+	if size <= partSize {
+		return c.PutObject(ctx, bucket, key, io.NewSectionReader(source, 0, size), size)
+	}
+
+	uploadID, err := c.CreateMultipartUpload(ctx, bucket, key)
+	if err != nil {
+		return err
+	}
+
+	partCount := int((size + partSize - 1) / partSize)
+	parts := make([]CompletedPart, partCount)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(concurrency)
+	for i := range partCount {
+		offset := int64(i) * partSize
+		length := min(partSize, size-offset)
+		eg.Go(func() error {
+			etag, err := c.UploadPart(egCtx, bucket, key, uploadID, i+1, io.NewSectionReader(source, offset, length), length)
+			if err != nil {
+				return fmt.Errorf("cannot upload part %d: %w", i+1, err)
+			}
+			parts[i] = CompletedPart{PartNumber: i + 1, ETag: etag}
+			return nil
+		})
+	}
+
+	err = eg.Wait()
+	if err == nil {
+		err = c.CompleteMultipartUpload(ctx, bucket, key, uploadID, parts)
+	}
+	if err != nil {
+		// Use a fresh context so that the upload is aborted also when the original context was cancelled.
+		if abortErr := c.AbortMultipartUpload(context.WithoutCancel(ctx), bucket, key, uploadID); abortErr != nil {
+			err = errors.Join(err, fmt.Errorf("cannot abort multipart upload: %w", abortErr))
+		}
+		return err
+	}
+	return nil
+}
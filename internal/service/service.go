@@ -15,6 +15,9 @@ type AllServices interface {
 	service.IPAddress
 	service.Account
 	service.ManagedDatabaseServiceManager
+	service.ManagedDatabaseUserManager
+	service.ManagedDatabaseLogicalDatabaseManager
+	service.ManagedDatabaseConnectionPoolManager
 	service.LoadBalancer
 	service.Kubernetes
 	service.ServerGroup